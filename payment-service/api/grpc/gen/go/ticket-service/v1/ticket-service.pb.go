@@ -0,0 +1,4072 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: ticket-service/v1/ticket-service.proto
+
+package ticket_servicev1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	money "google.golang.org/genproto/googleapis/type/money"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_NEWEST_FIRST SortOrder = 0
+	SortOrder_SORT_ORDER_OLDEST_FIRST SortOrder = 1
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_NEWEST_FIRST",
+		1: "SORT_ORDER_OLDEST_FIRST",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_NEWEST_FIRST": 0,
+		"SORT_ORDER_OLDEST_FIRST": 1,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_service_v1_ticket_service_proto_enumTypes[0].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_ticket_service_v1_ticket_service_proto_enumTypes[0]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{0}
+}
+
+type Draw struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DrawId        int32                  `protobuf:"varint,1,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	LotteryType   string                 `protobuf:"bytes,2,opt,name=lottery_type,json=lotteryType,proto3" json:"lottery_type,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	StartTime     string                 `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Draw) Reset() {
+	*x = Draw{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Draw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Draw) ProtoMessage() {}
+
+func (x *Draw) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Draw.ProtoReflect.Descriptor instead.
+func (*Draw) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Draw) GetDrawId() int32 {
+	if x != nil {
+		return x.DrawId
+	}
+	return 0
+}
+
+func (x *Draw) GetLotteryType() string {
+	if x != nil {
+		return x.LotteryType
+	}
+	return ""
+}
+
+func (x *Draw) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Draw) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *Draw) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+type Ticket struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TicketId  int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	DrawId    int32                  `protobuf:"varint,2,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	UserId    *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Numbers   []string               `protobuf:"bytes,4,rep,name=numbers,proto3" json:"numbers,omitempty"`
+	Status    string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// held_until - срок брони для билетов в статусе HELD; пустой, если бронь ждёт оплаты счёта
+	HeldUntil string `protobuf:"bytes,7,opt,name=held_until,json=heldUntil,proto3" json:"held_until,omitempty"`
+	// invoice_id - счёт, выставленный при покупке билета
+	InvoiceId *wrapperspb.Int64Value `protobuf:"bytes,8,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	// serial - серийный номер билета; выдаётся при оплате, до неё пустой
+	Serial        string `protobuf:"bytes,9,opt,name=serial,proto3" json:"serial,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ticket) Reset() {
+	*x = Ticket{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ticket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ticket) ProtoMessage() {}
+
+func (x *Ticket) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ticket.ProtoReflect.Descriptor instead.
+func (*Ticket) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Ticket) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *Ticket) GetDrawId() int32 {
+	if x != nil {
+		return x.DrawId
+	}
+	return 0
+}
+
+func (x *Ticket) GetUserId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *Ticket) GetNumbers() []string {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *Ticket) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Ticket) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Ticket) GetHeldUntil() string {
+	if x != nil {
+		return x.HeldUntil
+	}
+	return ""
+}
+
+func (x *Ticket) GetInvoiceId() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return nil
+}
+
+func (x *Ticket) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+type TicketWithDraw struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TicketId  int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	DrawId    int32                  `protobuf:"varint,2,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	UserId    int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Numbers   []string               `protobuf:"bytes,4,rep,name=numbers,proto3" json:"numbers,omitempty"`
+	Status    string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Draw      *Draw                  `protobuf:"bytes,7,opt,name=draw,proto3" json:"draw,omitempty"`
+	HeldUntil string                 `protobuf:"bytes,8,opt,name=held_until,json=heldUntil,proto3" json:"held_until,omitempty"`
+	// serial - серийный номер билета; выдаётся при оплате, до неё пустой
+	Serial string `protobuf:"bytes,9,opt,name=serial,proto3" json:"serial,omitempty"`
+	// syndicate - доля пользователя, если билет принадлежит его синдикату
+	Syndicate     *SyndicateShare `protobuf:"bytes,10,opt,name=syndicate,proto3" json:"syndicate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketWithDraw) Reset() {
+	*x = TicketWithDraw{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketWithDraw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketWithDraw) ProtoMessage() {}
+
+func (x *TicketWithDraw) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketWithDraw.ProtoReflect.Descriptor instead.
+func (*TicketWithDraw) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TicketWithDraw) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *TicketWithDraw) GetDrawId() int32 {
+	if x != nil {
+		return x.DrawId
+	}
+	return 0
+}
+
+func (x *TicketWithDraw) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TicketWithDraw) GetNumbers() []string {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *TicketWithDraw) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TicketWithDraw) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *TicketWithDraw) GetDraw() *Draw {
+	if x != nil {
+		return x.Draw
+	}
+	return nil
+}
+
+func (x *TicketWithDraw) GetHeldUntil() string {
+	if x != nil {
+		return x.HeldUntil
+	}
+	return ""
+}
+
+func (x *TicketWithDraw) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+func (x *TicketWithDraw) GetSyndicate() *SyndicateShare {
+	if x != nil {
+		return x.Syndicate
+	}
+	return nil
+}
+
+type VerifyTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Serial        string                 `protobuf:"bytes,1,opt,name=serial,proto3" json:"serial,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyTicketRequest) Reset() {
+	*x = VerifyTicketRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTicketRequest) ProtoMessage() {}
+
+func (x *VerifyTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTicketRequest.ProtoReflect.Descriptor instead.
+func (*VerifyTicketRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VerifyTicketRequest) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+type TicketVerification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Serial        string                 `protobuf:"bytes,1,opt,name=serial,proto3" json:"serial,omitempty"`
+	Draw          *Draw                  `protobuf:"bytes,2,opt,name=draw,proto3" json:"draw,omitempty"`
+	Numbers       []string               `protobuf:"bytes,3,rep,name=numbers,proto3" json:"numbers,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketVerification) Reset() {
+	*x = TicketVerification{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketVerification) ProtoMessage() {}
+
+func (x *TicketVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketVerification.ProtoReflect.Descriptor instead.
+func (*TicketVerification) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TicketVerification) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+func (x *TicketVerification) GetDraw() *Draw {
+	if x != nil {
+		return x.Draw
+	}
+	return nil
+}
+
+func (x *TicketVerification) GetNumbers() []string {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *TicketVerification) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TicketVerification) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketRequest) Reset() {
+	*x = GetTicketRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketRequest) ProtoMessage() {}
+
+func (x *GetTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketRequest.ProtoReflect.Descriptor instead.
+func (*GetTicketRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTicketRequest) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+type CreateTicketRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	DrawId int32                  `protobuf:"varint,1,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	// user_id игнорируется: билет покупается на пользователя из access-токена
+	UserId  int32    `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Numbers []string `protobuf:"bytes,3,rep,name=numbers,proto3" json:"numbers,omitempty"`
+	// favourite_id - сохранённый набор чисел пользователя; взаимоисключающ с numbers
+	FavouriteId   *wrapperspb.Int32Value `protobuf:"bytes,4,opt,name=favourite_id,json=favouriteId,proto3" json:"favourite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTicketRequest) Reset() {
+	*x = CreateTicketRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTicketRequest) ProtoMessage() {}
+
+func (x *CreateTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTicketRequest.ProtoReflect.Descriptor instead.
+func (*CreateTicketRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateTicketRequest) GetDrawId() int32 {
+	if x != nil {
+		return x.DrawId
+	}
+	return 0
+}
+
+func (x *CreateTicketRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateTicketRequest) GetNumbers() []string {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *CreateTicketRequest) GetFavouriteId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.FavouriteId
+	}
+	return nil
+}
+
+type ReserveTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveTicketRequest) Reset() {
+	*x = ReserveTicketRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveTicketRequest) ProtoMessage() {}
+
+func (x *ReserveTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveTicketRequest.ProtoReflect.Descriptor instead.
+func (*ReserveTicketRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReserveTicketRequest) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+type BookTicketRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TicketId int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	// user_id учитывается только в BookTicketInternal; BookTicket бронирует билет за пользователем из access-токена
+	UserId        int32 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookTicketRequest) Reset() {
+	*x = BookTicketRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookTicketRequest) ProtoMessage() {}
+
+func (x *BookTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookTicketRequest.ProtoReflect.Descriptor instead.
+func (*BookTicketRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BookTicketRequest) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *BookTicketRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type BookAnyTicketRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	DrawId int32                  `protobuf:"varint,1,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	// user_id игнорируется: билет бронируется за пользователем из access-токена
+	UserId           int32    `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PreferredNumbers []string `protobuf:"bytes,3,rep,name=preferred_numbers,json=preferredNumbers,proto3" json:"preferred_numbers,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BookAnyTicketRequest) Reset() {
+	*x = BookAnyTicketRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookAnyTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookAnyTicketRequest) ProtoMessage() {}
+
+func (x *BookAnyTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookAnyTicketRequest.ProtoReflect.Descriptor instead.
+func (*BookAnyTicketRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BookAnyTicketRequest) GetDrawId() int32 {
+	if x != nil {
+		return x.DrawId
+	}
+	return 0
+}
+
+func (x *BookAnyTicketRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BookAnyTicketRequest) GetPreferredNumbers() []string {
+	if x != nil {
+		return x.PreferredNumbers
+	}
+	return nil
+}
+
+type ReleaseTicketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketIds     []int32                `protobuf:"varint,1,rep,packed,name=ticket_ids,json=ticketIds,proto3" json:"ticket_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseTicketsRequest) Reset() {
+	*x = ReleaseTicketsRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseTicketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseTicketsRequest) ProtoMessage() {}
+
+func (x *ReleaseTicketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseTicketsRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseTicketsRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReleaseTicketsRequest) GetTicketIds() []int32 {
+	if x != nil {
+		return x.TicketIds
+	}
+	return nil
+}
+
+type ListUserTicketsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DrawId   *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	Statuses []string               `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	// created_from, created_to - границы даты покупки в RFC3339, created_to не включается
+	CreatedFrom   string    `protobuf:"bytes,4,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     string    `protobuf:"bytes,5,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	Order         SortOrder `protobuf:"varint,6,opt,name=order,proto3,enum=ticket_service.v1.SortOrder" json:"order,omitempty"`
+	PageSize      int32     `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string    `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserTicketsRequest) Reset() {
+	*x = ListUserTicketsRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserTicketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserTicketsRequest) ProtoMessage() {}
+
+func (x *ListUserTicketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserTicketsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserTicketsRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListUserTicketsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListUserTicketsRequest) GetDrawId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.DrawId
+	}
+	return nil
+}
+
+func (x *ListUserTicketsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListUserTicketsRequest) GetCreatedFrom() string {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return ""
+}
+
+func (x *ListUserTicketsRequest) GetCreatedTo() string {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return ""
+}
+
+func (x *ListUserTicketsRequest) GetOrder() SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return SortOrder_SORT_ORDER_NEWEST_FIRST
+}
+
+func (x *ListUserTicketsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUserTicketsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListUserTicketsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tickets       []*TicketWithDraw      `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserTicketsResponse) Reset() {
+	*x = ListUserTicketsResponse{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserTicketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserTicketsResponse) ProtoMessage() {}
+
+func (x *ListUserTicketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserTicketsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserTicketsResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListUserTicketsResponse) GetTickets() []*TicketWithDraw {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+func (x *ListUserTicketsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListAvailableTicketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DrawId        *wrapperspb.Int32Value `protobuf:"bytes,1,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	CreatedFrom   string                 `protobuf:"bytes,2,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     string                 `protobuf:"bytes,3,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	Order         SortOrder              `protobuf:"varint,4,opt,name=order,proto3,enum=ticket_service.v1.SortOrder" json:"order,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAvailableTicketsRequest) Reset() {
+	*x = ListAvailableTicketsRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAvailableTicketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAvailableTicketsRequest) ProtoMessage() {}
+
+func (x *ListAvailableTicketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAvailableTicketsRequest.ProtoReflect.Descriptor instead.
+func (*ListAvailableTicketsRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListAvailableTicketsRequest) GetDrawId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.DrawId
+	}
+	return nil
+}
+
+func (x *ListAvailableTicketsRequest) GetCreatedFrom() string {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return ""
+}
+
+func (x *ListAvailableTicketsRequest) GetCreatedTo() string {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return ""
+}
+
+func (x *ListAvailableTicketsRequest) GetOrder() SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return SortOrder_SORT_ORDER_NEWEST_FIRST
+}
+
+func (x *ListAvailableTicketsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAvailableTicketsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAvailableTicketsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tickets       []*Ticket              `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAvailableTicketsResponse) Reset() {
+	*x = ListAvailableTicketsResponse{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAvailableTicketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAvailableTicketsResponse) ProtoMessage() {}
+
+func (x *ListAvailableTicketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAvailableTicketsResponse.ProtoReflect.Descriptor instead.
+func (*ListAvailableTicketsResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListAvailableTicketsResponse) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+func (x *ListAvailableTicketsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SetWinningTicketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketIds     []int32                `protobuf:"varint,1,rep,packed,name=ticket_ids,json=ticketIds,proto3" json:"ticket_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWinningTicketsRequest) Reset() {
+	*x = SetWinningTicketsRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWinningTicketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWinningTicketsRequest) ProtoMessage() {}
+
+func (x *SetWinningTicketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWinningTicketsRequest.ProtoReflect.Descriptor instead.
+func (*SetWinningTicketsRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetWinningTicketsRequest) GetTicketIds() []int32 {
+	if x != nil {
+		return x.TicketIds
+	}
+	return nil
+}
+
+type SetWinningTicketsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tickets       []*Ticket              `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWinningTicketsResponse) Reset() {
+	*x = SetWinningTicketsResponse{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWinningTicketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWinningTicketsResponse) ProtoMessage() {}
+
+func (x *SetWinningTicketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWinningTicketsResponse.ProtoReflect.Descriptor instead.
+func (*SetWinningTicketsResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetWinningTicketsResponse) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type CheckResultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckResultRequest) Reset() {
+	*x = CheckResultRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckResultRequest) ProtoMessage() {}
+
+func (x *CheckResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckResultRequest.ProtoReflect.Descriptor instead.
+func (*CheckResultRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CheckResultRequest) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+type CheckResultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckResultResponse) Reset() {
+	*x = CheckResultResponse{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckResultResponse) ProtoMessage() {}
+
+func (x *CheckResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckResultResponse.ProtoReflect.Descriptor instead.
+func (*CheckResultResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CheckResultResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type Subscription struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId int32                  `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	UserId         int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LotteryType    string                 `protobuf:"bytes,3,opt,name=lottery_type,json=lotteryType,proto3" json:"lottery_type,omitempty"`
+	Numbers        []string               `protobuf:"bytes,4,rep,name=numbers,proto3" json:"numbers,omitempty"`
+	DrawsTotal     int32                  `protobuf:"varint,5,opt,name=draws_total,json=drawsTotal,proto3" json:"draws_total,omitempty"`
+	DrawsRemaining int32                  `protobuf:"varint,6,opt,name=draws_remaining,json=drawsRemaining,proto3" json:"draws_remaining,omitempty"`
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Subscription) GetSubscriptionId() int32 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *Subscription) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Subscription) GetLotteryType() string {
+	if x != nil {
+		return x.LotteryType
+	}
+	return ""
+}
+
+func (x *Subscription) GetNumbers() []string {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *Subscription) GetDrawsTotal() int32 {
+	if x != nil {
+		return x.DrawsTotal
+	}
+	return 0
+}
+
+func (x *Subscription) GetDrawsRemaining() int32 {
+	if x != nil {
+		return x.DrawsRemaining
+	}
+	return 0
+}
+
+func (x *Subscription) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Subscription) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Subscription) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id игнорируется: подписка оформляется на пользователя из access-токена
+	UserId        int32    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LotteryType   string   `protobuf:"bytes,2,opt,name=lottery_type,json=lotteryType,proto3" json:"lottery_type,omitempty"`
+	Numbers       []string `protobuf:"bytes,3,rep,name=numbers,proto3" json:"numbers,omitempty"`
+	DrawsCount    int32    `protobuf:"varint,4,opt,name=draws_count,json=drawsCount,proto3" json:"draws_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubscriptionRequest) Reset() {
+	*x = CreateSubscriptionRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateSubscriptionRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateSubscriptionRequest) GetLotteryType() string {
+	if x != nil {
+		return x.LotteryType
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetNumbers() []string {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *CreateSubscriptionRequest) GetDrawsCount() int32 {
+	if x != nil {
+		return x.DrawsCount
+	}
+	return 0
+}
+
+type ListUserSubscriptionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id - чьи подписки показать; 0 - свои, чужие видит только администратор
+	UserId        int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserSubscriptionsRequest) Reset() {
+	*x = ListUserSubscriptionsRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListUserSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListUserSubscriptionsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListUserSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*Subscription        `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserSubscriptionsResponse) Reset() {
+	*x = ListUserSubscriptionsResponse{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListUserSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListUserSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type CancelSubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId int32                  `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CancelSubscriptionRequest) Reset() {
+	*x = CancelSubscriptionRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSubscriptionRequest) ProtoMessage() {}
+
+func (x *CancelSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CancelSubscriptionRequest) GetSubscriptionId() int32 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+type ResumeSubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId int32                  `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResumeSubscriptionRequest) Reset() {
+	*x = ResumeSubscriptionRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSubscriptionRequest) ProtoMessage() {}
+
+func (x *ResumeSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ResumeSubscriptionRequest) GetSubscriptionId() int32 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+type Cart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartId        int32                  `protobuf:"varint,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	InvoiceId     *wrapperspb.Int64Value `protobuf:"bytes,4,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Tickets       []*Ticket              `protobuf:"bytes,5,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cart) Reset() {
+	*x = Cart{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cart) ProtoMessage() {}
+
+func (x *Cart) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cart.ProtoReflect.Descriptor instead.
+func (*Cart) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Cart) GetCartId() int32 {
+	if x != nil {
+		return x.CartId
+	}
+	return 0
+}
+
+func (x *Cart) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Cart) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Cart) GetInvoiceId() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return nil
+}
+
+func (x *Cart) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+func (x *Cart) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Cart) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// Во всех запросах корзины user_id игнорируется: корзина принадлежит пользователю из access-токена.
+type GetCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCartRequest) Reset() {
+	*x = GetCartRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartRequest) ProtoMessage() {}
+
+func (x *GetCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartRequest.ProtoReflect.Descriptor instead.
+func (*GetCartRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetCartRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type AddToCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TicketId      int32                  `protobuf:"varint,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToCartRequest) Reset() {
+	*x = AddToCartRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToCartRequest) ProtoMessage() {}
+
+func (x *AddToCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToCartRequest.ProtoReflect.Descriptor instead.
+func (*AddToCartRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AddToCartRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddToCartRequest) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+type RemoveFromCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TicketId      int32                  `protobuf:"varint,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromCartRequest) Reset() {
+	*x = RemoveFromCartRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromCartRequest) ProtoMessage() {}
+
+func (x *RemoveFromCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromCartRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromCartRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RemoveFromCartRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveFromCartRequest) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+type CheckoutCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutCartRequest) Reset() {
+	*x = CheckoutCartRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutCartRequest) ProtoMessage() {}
+
+func (x *CheckoutCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutCartRequest.ProtoReflect.Descriptor instead.
+func (*CheckoutCartRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CheckoutCartRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type Transfer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    int32                  `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	TicketId      int32                  `protobuf:"varint,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	FromUserId    int32                  `protobuf:"varint,3,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      int32                  `protobuf:"varint,4,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ResolvedAt    string                 `protobuf:"bytes,7,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transfer) ProtoMessage() {}
+
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Transfer) GetTransferId() int32 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+func (x *Transfer) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *Transfer) GetFromUserId() int32 {
+	if x != nil {
+		return x.FromUserId
+	}
+	return 0
+}
+
+func (x *Transfer) GetToUserId() int32 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
+}
+
+func (x *Transfer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Transfer) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Transfer) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+type TransferTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	ToUserId      int32                  `protobuf:"varint,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferTicketRequest) Reset() {
+	*x = TransferTicketRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferTicketRequest) ProtoMessage() {}
+
+func (x *TransferTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferTicketRequest.ProtoReflect.Descriptor instead.
+func (*TransferTicketRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TransferTicketRequest) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *TransferTicketRequest) GetToUserId() int32 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
+}
+
+type TransferActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    int32                  `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferActionRequest) Reset() {
+	*x = TransferActionRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferActionRequest) ProtoMessage() {}
+
+func (x *TransferActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferActionRequest.ProtoReflect.Descriptor instead.
+func (*TransferActionRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TransferActionRequest) GetTransferId() int32 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+type ListTransfersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 - передачи вызывающего пользователя; чужие передачи видит только ADMIN.
+	UserId        int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransfersRequest) Reset() {
+	*x = ListTransfersRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersRequest) ProtoMessage() {}
+
+func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListTransfersRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfers     []*Transfer            `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransfersResponse) Reset() {
+	*x = ListTransfersResponse{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersResponse) ProtoMessage() {}
+
+func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListTransfersResponse) GetTransfers() []*Transfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+type GetDrawSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DrawId        int32                  `protobuf:"varint,1,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDrawSummaryRequest) Reset() {
+	*x = GetDrawSummaryRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDrawSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDrawSummaryRequest) ProtoMessage() {}
+
+func (x *GetDrawSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDrawSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetDrawSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetDrawSummaryRequest) GetDrawId() int32 {
+	if x != nil {
+		return x.DrawId
+	}
+	return 0
+}
+
+type PrizeTier struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Matched int32                  `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	Winners int32                  `protobuf:"varint,2,opt,name=winners,proto3" json:"winners,omitempty"`
+	// prize - выигрыш одного билета разряда
+	Prize         *money.Money `protobuf:"bytes,3,opt,name=prize,proto3" json:"prize,omitempty"`
+	Total         *money.Money `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrizeTier) Reset() {
+	*x = PrizeTier{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrizeTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrizeTier) ProtoMessage() {}
+
+func (x *PrizeTier) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrizeTier.ProtoReflect.Descriptor instead.
+func (*PrizeTier) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PrizeTier) GetMatched() int32 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *PrizeTier) GetWinners() int32 {
+	if x != nil {
+		return x.Winners
+	}
+	return 0
+}
+
+func (x *PrizeTier) GetPrize() *money.Money {
+	if x != nil {
+		return x.Prize
+	}
+	return nil
+}
+
+func (x *PrizeTier) GetTotal() *money.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type DrawSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Draw          *Draw                  `protobuf:"bytes,1,opt,name=draw,proto3" json:"draw,omitempty"`
+	TicketsSold   int32                  `protobuf:"varint,2,opt,name=tickets_sold,json=ticketsSold,proto3" json:"tickets_sold,omitempty"`
+	Winners       int32                  `protobuf:"varint,3,opt,name=winners,proto3" json:"winners,omitempty"`
+	Tiers         []*PrizeTier           `protobuf:"bytes,4,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	TotalPrize    *money.Money           `protobuf:"bytes,5,opt,name=total_prize,json=totalPrize,proto3" json:"total_prize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrawSummary) Reset() {
+	*x = DrawSummary{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrawSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawSummary) ProtoMessage() {}
+
+func (x *DrawSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawSummary.ProtoReflect.Descriptor instead.
+func (*DrawSummary) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DrawSummary) GetDraw() *Draw {
+	if x != nil {
+		return x.Draw
+	}
+	return nil
+}
+
+func (x *DrawSummary) GetTicketsSold() int32 {
+	if x != nil {
+		return x.TicketsSold
+	}
+	return 0
+}
+
+func (x *DrawSummary) GetWinners() int32 {
+	if x != nil {
+		return x.Winners
+	}
+	return 0
+}
+
+func (x *DrawSummary) GetTiers() []*PrizeTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+func (x *DrawSummary) GetTotalPrize() *money.Money {
+	if x != nil {
+		return x.TotalPrize
+	}
+	return nil
+}
+
+type ListDrawWinnersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DrawId        int32                  `protobuf:"varint,1,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDrawWinnersRequest) Reset() {
+	*x = ListDrawWinnersRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDrawWinnersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDrawWinnersRequest) ProtoMessage() {}
+
+func (x *ListDrawWinnersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDrawWinnersRequest.ProtoReflect.Descriptor instead.
+func (*ListDrawWinnersRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListDrawWinnersRequest) GetDrawId() int32 {
+	if x != nil {
+		return x.DrawId
+	}
+	return 0
+}
+
+type Winner struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TicketId int32                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	UserId   *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Serial   string                 `protobuf:"bytes,3,opt,name=serial,proto3" json:"serial,omitempty"`
+	Numbers  []string               `protobuf:"bytes,4,rep,name=numbers,proto3" json:"numbers,omitempty"`
+	Matched  int32                  `protobuf:"varint,5,opt,name=matched,proto3" json:"matched,omitempty"`
+	Prize    *money.Money           `protobuf:"bytes,6,opt,name=prize,proto3" json:"prize,omitempty"`
+	// syndicate_id - синдикат, которому принадлежит билет; приз делится между участниками
+	SyndicateId *wrapperspb.Int32Value `protobuf:"bytes,7,opt,name=syndicate_id,json=syndicateId,proto3" json:"syndicate_id,omitempty"`
+	// syndicate_payouts - доли участников синдиката; пусто, пока приз не распределён
+	SyndicatePayouts []*SyndicatePayout `protobuf:"bytes,8,rep,name=syndicate_payouts,json=syndicatePayouts,proto3" json:"syndicate_payouts,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Winner) Reset() {
+	*x = Winner{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Winner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Winner) ProtoMessage() {}
+
+func (x *Winner) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Winner.ProtoReflect.Descriptor instead.
+func (*Winner) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Winner) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *Winner) GetUserId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *Winner) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+func (x *Winner) GetNumbers() []string {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *Winner) GetMatched() int32 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *Winner) GetPrize() *money.Money {
+	if x != nil {
+		return x.Prize
+	}
+	return nil
+}
+
+func (x *Winner) GetSyndicateId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.SyndicateId
+	}
+	return nil
+}
+
+func (x *Winner) GetSyndicatePayouts() []*SyndicatePayout {
+	if x != nil {
+		return x.SyndicatePayouts
+	}
+	return nil
+}
+
+type SyndicatePayout struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        *money.Money           `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyndicatePayout) Reset() {
+	*x = SyndicatePayout{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyndicatePayout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyndicatePayout) ProtoMessage() {}
+
+func (x *SyndicatePayout) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyndicatePayout.ProtoReflect.Descriptor instead.
+func (*SyndicatePayout) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SyndicatePayout) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SyndicatePayout) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type ListDrawWinnersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Winners       []*Winner              `protobuf:"bytes,1,rep,name=winners,proto3" json:"winners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDrawWinnersResponse) Reset() {
+	*x = ListDrawWinnersResponse{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDrawWinnersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDrawWinnersResponse) ProtoMessage() {}
+
+func (x *ListDrawWinnersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDrawWinnersResponse.ProtoReflect.Descriptor instead.
+func (*ListDrawWinnersResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListDrawWinnersResponse) GetWinners() []*Winner {
+	if x != nil {
+		return x.Winners
+	}
+	return nil
+}
+
+type GetNumberStatsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	LotteryType string                 `protobuf:"bytes,1,opt,name=lottery_type,json=lotteryType,proto3" json:"lottery_type,omitempty"`
+	// from, to - период розыгрыша тиражей в RFC3339; пустой from - с начала истории, пустой to - до текущего момента
+	From          string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNumberStatsRequest) Reset() {
+	*x = GetNumberStatsRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNumberStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNumberStatsRequest) ProtoMessage() {}
+
+func (x *GetNumberStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNumberStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetNumberStatsRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetNumberStatsRequest) GetLotteryType() string {
+	if x != nil {
+		return x.LotteryType
+	}
+	return ""
+}
+
+func (x *GetNumberStatsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetNumberStatsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type NumberStat struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Number int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// drawn - в скольких тиражах число выпало
+	Drawn int32 `protobuf:"varint,2,opt,name=drawn,proto3" json:"drawn,omitempty"`
+	// picks - сколько раз число выбрали в оплаченных билетах
+	Picks int32 `protobuf:"varint,3,opt,name=picks,proto3" json:"picks,omitempty"`
+	// current_gap - сколько тиражей подряд до конца периода число не выпадало
+	CurrentGap    int32 `protobuf:"varint,4,opt,name=current_gap,json=currentGap,proto3" json:"current_gap,omitempty"`
+	LongestGap    int32 `protobuf:"varint,5,opt,name=longest_gap,json=longestGap,proto3" json:"longest_gap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NumberStat) Reset() {
+	*x = NumberStat{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NumberStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumberStat) ProtoMessage() {}
+
+func (x *NumberStat) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumberStat.ProtoReflect.Descriptor instead.
+func (*NumberStat) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *NumberStat) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *NumberStat) GetDrawn() int32 {
+	if x != nil {
+		return x.Drawn
+	}
+	return 0
+}
+
+func (x *NumberStat) GetPicks() int32 {
+	if x != nil {
+		return x.Picks
+	}
+	return 0
+}
+
+func (x *NumberStat) GetCurrentGap() int32 {
+	if x != nil {
+		return x.CurrentGap
+	}
+	return 0
+}
+
+func (x *NumberStat) GetLongestGap() int32 {
+	if x != nil {
+		return x.LongestGap
+	}
+	return 0
+}
+
+type GetNumberStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LotteryType   string                 `protobuf:"bytes,1,opt,name=lottery_type,json=lotteryType,proto3" json:"lottery_type,omitempty"`
+	Draws         int32                  `protobuf:"varint,2,opt,name=draws,proto3" json:"draws,omitempty"`
+	Numbers       []*NumberStat          `protobuf:"bytes,3,rep,name=numbers,proto3" json:"numbers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNumberStatsResponse) Reset() {
+	*x = GetNumberStatsResponse{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNumberStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNumberStatsResponse) ProtoMessage() {}
+
+func (x *GetNumberStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNumberStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetNumberStatsResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetNumberStatsResponse) GetLotteryType() string {
+	if x != nil {
+		return x.LotteryType
+	}
+	return ""
+}
+
+func (x *GetNumberStatsResponse) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *GetNumberStatsResponse) GetNumbers() []*NumberStat {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+type Favourite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FavouriteId   int32                  `protobuf:"varint,1,opt,name=favourite_id,json=favouriteId,proto3" json:"favourite_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LotteryType   string                 `protobuf:"bytes,3,opt,name=lottery_type,json=lotteryType,proto3" json:"lottery_type,omitempty"`
+	Numbers       []string               `protobuf:"bytes,4,rep,name=numbers,proto3" json:"numbers,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Favourite) Reset() {
+	*x = Favourite{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Favourite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Favourite) ProtoMessage() {}
+
+func (x *Favourite) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Favourite.ProtoReflect.Descriptor instead.
+func (*Favourite) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Favourite) GetFavouriteId() int32 {
+	if x != nil {
+		return x.FavouriteId
+	}
+	return 0
+}
+
+func (x *Favourite) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Favourite) GetLotteryType() string {
+	if x != nil {
+		return x.LotteryType
+	}
+	return ""
+}
+
+func (x *Favourite) GetNumbers() []string {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *Favourite) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type SaveFavouriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LotteryType   string                 `protobuf:"bytes,2,opt,name=lottery_type,json=lotteryType,proto3" json:"lottery_type,omitempty"`
+	Numbers       []string               `protobuf:"bytes,3,rep,name=numbers,proto3" json:"numbers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveFavouriteRequest) Reset() {
+	*x = SaveFavouriteRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveFavouriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveFavouriteRequest) ProtoMessage() {}
+
+func (x *SaveFavouriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveFavouriteRequest.ProtoReflect.Descriptor instead.
+func (*SaveFavouriteRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SaveFavouriteRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveFavouriteRequest) GetLotteryType() string {
+	if x != nil {
+		return x.LotteryType
+	}
+	return ""
+}
+
+func (x *SaveFavouriteRequest) GetNumbers() []string {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+type ListFavouritesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Favourites    []*Favourite           `protobuf:"bytes,1,rep,name=favourites,proto3" json:"favourites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavouritesResponse) Reset() {
+	*x = ListFavouritesResponse{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavouritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavouritesResponse) ProtoMessage() {}
+
+func (x *ListFavouritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavouritesResponse.ProtoReflect.Descriptor instead.
+func (*ListFavouritesResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListFavouritesResponse) GetFavourites() []*Favourite {
+	if x != nil {
+		return x.Favourites
+	}
+	return nil
+}
+
+type DeleteFavouriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FavouriteId   int32                  `protobuf:"varint,1,opt,name=favourite_id,json=favouriteId,proto3" json:"favourite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFavouriteRequest) Reset() {
+	*x = DeleteFavouriteRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFavouriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFavouriteRequest) ProtoMessage() {}
+
+func (x *DeleteFavouriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFavouriteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFavouriteRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteFavouriteRequest) GetFavouriteId() int32 {
+	if x != nil {
+		return x.FavouriteId
+	}
+	return 0
+}
+
+type SyndicateMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Shares        int32                  `protobuf:"varint,2,opt,name=shares,proto3" json:"shares,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	InvitedAt     string                 `protobuf:"bytes,4,opt,name=invited_at,json=invitedAt,proto3" json:"invited_at,omitempty"`
+	JoinedAt      string                 `protobuf:"bytes,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyndicateMember) Reset() {
+	*x = SyndicateMember{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyndicateMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyndicateMember) ProtoMessage() {}
+
+func (x *SyndicateMember) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyndicateMember.ProtoReflect.Descriptor instead.
+func (*SyndicateMember) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SyndicateMember) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SyndicateMember) GetShares() int32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *SyndicateMember) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SyndicateMember) GetInvitedAt() string {
+	if x != nil {
+		return x.InvitedAt
+	}
+	return ""
+}
+
+func (x *SyndicateMember) GetJoinedAt() string {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return ""
+}
+
+type Syndicate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SyndicateId   int32                  `protobuf:"varint,1,opt,name=syndicate_id,json=syndicateId,proto3" json:"syndicate_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerId       int32                  `protobuf:"varint,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Members       []*SyndicateMember     `protobuf:"bytes,5,rep,name=members,proto3" json:"members,omitempty"`
+	TicketIds     []int32                `protobuf:"varint,6,rep,packed,name=ticket_ids,json=ticketIds,proto3" json:"ticket_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Syndicate) Reset() {
+	*x = Syndicate{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Syndicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Syndicate) ProtoMessage() {}
+
+func (x *Syndicate) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Syndicate.ProtoReflect.Descriptor instead.
+func (*Syndicate) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Syndicate) GetSyndicateId() int32 {
+	if x != nil {
+		return x.SyndicateId
+	}
+	return 0
+}
+
+func (x *Syndicate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Syndicate) GetOwnerId() int32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *Syndicate) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Syndicate) GetMembers() []*SyndicateMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Syndicate) GetTicketIds() []int32 {
+	if x != nil {
+		return x.TicketIds
+	}
+	return nil
+}
+
+type SyndicateShare struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	SyndicateId int32                  `protobuf:"varint,1,opt,name=syndicate_id,json=syndicateId,proto3" json:"syndicate_id,omitempty"`
+	Shares      int32                  `protobuf:"varint,2,opt,name=shares,proto3" json:"shares,omitempty"`
+	// total_shares - сумма долей всех участников синдиката
+	TotalShares int32 `protobuf:"varint,3,opt,name=total_shares,json=totalShares,proto3" json:"total_shares,omitempty"`
+	// payout - часть выигрыша пользователя; не задана, пока приз не распределён
+	Payout        *money.Money `protobuf:"bytes,4,opt,name=payout,proto3" json:"payout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyndicateShare) Reset() {
+	*x = SyndicateShare{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyndicateShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyndicateShare) ProtoMessage() {}
+
+func (x *SyndicateShare) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyndicateShare.ProtoReflect.Descriptor instead.
+func (*SyndicateShare) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SyndicateShare) GetSyndicateId() int32 {
+	if x != nil {
+		return x.SyndicateId
+	}
+	return 0
+}
+
+func (x *SyndicateShare) GetShares() int32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *SyndicateShare) GetTotalShares() int32 {
+	if x != nil {
+		return x.TotalShares
+	}
+	return 0
+}
+
+func (x *SyndicateShare) GetPayout() *money.Money {
+	if x != nil {
+		return x.Payout
+	}
+	return nil
+}
+
+type CreateSyndicateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// shares - доля владельца
+	Shares        int32 `protobuf:"varint,2,opt,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSyndicateRequest) Reset() {
+	*x = CreateSyndicateRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSyndicateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSyndicateRequest) ProtoMessage() {}
+
+func (x *CreateSyndicateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSyndicateRequest.ProtoReflect.Descriptor instead.
+func (*CreateSyndicateRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreateSyndicateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSyndicateRequest) GetShares() int32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+type SyndicateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SyndicateId   int32                  `protobuf:"varint,1,opt,name=syndicate_id,json=syndicateId,proto3" json:"syndicate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyndicateRequest) Reset() {
+	*x = SyndicateRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyndicateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyndicateRequest) ProtoMessage() {}
+
+func (x *SyndicateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyndicateRequest.ProtoReflect.Descriptor instead.
+func (*SyndicateRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SyndicateRequest) GetSyndicateId() int32 {
+	if x != nil {
+		return x.SyndicateId
+	}
+	return 0
+}
+
+type ListSyndicatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Syndicates    []*Syndicate           `protobuf:"bytes,1,rep,name=syndicates,proto3" json:"syndicates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSyndicatesResponse) Reset() {
+	*x = ListSyndicatesResponse{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSyndicatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSyndicatesResponse) ProtoMessage() {}
+
+func (x *ListSyndicatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSyndicatesResponse.ProtoReflect.Descriptor instead.
+func (*ListSyndicatesResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListSyndicatesResponse) GetSyndicates() []*Syndicate {
+	if x != nil {
+		return x.Syndicates
+	}
+	return nil
+}
+
+type InviteToSyndicateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SyndicateId   int32                  `protobuf:"varint,1,opt,name=syndicate_id,json=syndicateId,proto3" json:"syndicate_id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Shares        int32                  `protobuf:"varint,3,opt,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteToSyndicateRequest) Reset() {
+	*x = InviteToSyndicateRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteToSyndicateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToSyndicateRequest) ProtoMessage() {}
+
+func (x *InviteToSyndicateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToSyndicateRequest.ProtoReflect.Descriptor instead.
+func (*InviteToSyndicateRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *InviteToSyndicateRequest) GetSyndicateId() int32 {
+	if x != nil {
+		return x.SyndicateId
+	}
+	return 0
+}
+
+func (x *InviteToSyndicateRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *InviteToSyndicateRequest) GetShares() int32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+type AddSyndicateTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SyndicateId   int32                  `protobuf:"varint,1,opt,name=syndicate_id,json=syndicateId,proto3" json:"syndicate_id,omitempty"`
+	TicketId      int32                  `protobuf:"varint,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSyndicateTicketRequest) Reset() {
+	*x = AddSyndicateTicketRequest{}
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSyndicateTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSyndicateTicketRequest) ProtoMessage() {}
+
+func (x *AddSyndicateTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_service_v1_ticket_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSyndicateTicketRequest.ProtoReflect.Descriptor instead.
+func (*AddSyndicateTicketRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_service_v1_ticket_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AddSyndicateTicketRequest) GetSyndicateId() int32 {
+	if x != nil {
+		return x.SyndicateId
+	}
+	return 0
+}
+
+func (x *AddSyndicateTicketRequest) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+var File_ticket_service_v1_ticket_service_proto protoreflect.FileDescriptor
+
+const file_ticket_service_v1_ticket_service_proto_rawDesc = "" +
+	"\n" +
+	"&ticket-service/v1/ticket-service.proto\x12\x11ticket_service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x17google/type/money.proto\"\x94\x01\n" +
+	"\x04Draw\x12\x17\n" +
+	"\adraw_id\x18\x01 \x01(\x05R\x06drawId\x12!\n" +
+	"\flottery_type\x18\x02 \x01(\tR\vlotteryType\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x05 \x01(\tR\aendTime\"\xb8\x02\n" +
+	"\x06Ticket\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\x12\x17\n" +
+	"\adraw_id\x18\x02 \x01(\x05R\x06drawId\x124\n" +
+	"\auser_id\x18\x03 \x01(\v2\x1b.google.protobuf.Int32ValueR\x06userId\x12\x18\n" +
+	"\anumbers\x18\x04 \x03(\tR\anumbers\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"held_until\x18\a \x01(\tR\theldUntil\x12:\n" +
+	"\n" +
+	"invoice_id\x18\b \x01(\v2\x1b.google.protobuf.Int64ValueR\tinvoiceId\x12\x16\n" +
+	"\x06serial\x18\t \x01(\tR\x06serial\"\xd5\x02\n" +
+	"\x0eTicketWithDraw\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\x12\x17\n" +
+	"\adraw_id\x18\x02 \x01(\x05R\x06drawId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\x12\x18\n" +
+	"\anumbers\x18\x04 \x03(\tR\anumbers\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12+\n" +
+	"\x04draw\x18\a \x01(\v2\x17.ticket_service.v1.DrawR\x04draw\x12\x1d\n" +
+	"\n" +
+	"held_until\x18\b \x01(\tR\theldUntil\x12\x16\n" +
+	"\x06serial\x18\t \x01(\tR\x06serial\x12?\n" +
+	"\tsyndicate\x18\n" +
+	" \x01(\v2!.ticket_service.v1.SyndicateShareR\tsyndicate\"-\n" +
+	"\x13VerifyTicketRequest\x12\x16\n" +
+	"\x06serial\x18\x01 \x01(\tR\x06serial\"\xaa\x01\n" +
+	"\x12TicketVerification\x12\x16\n" +
+	"\x06serial\x18\x01 \x01(\tR\x06serial\x12+\n" +
+	"\x04draw\x18\x02 \x01(\v2\x17.ticket_service.v1.DrawR\x04draw\x12\x18\n" +
+	"\anumbers\x18\x03 \x03(\tR\anumbers\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"/\n" +
+	"\x10GetTicketRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\"\xa1\x01\n" +
+	"\x13CreateTicketRequest\x12\x17\n" +
+	"\adraw_id\x18\x01 \x01(\x05R\x06drawId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x18\n" +
+	"\anumbers\x18\x03 \x03(\tR\anumbers\x12>\n" +
+	"\ffavourite_id\x18\x04 \x01(\v2\x1b.google.protobuf.Int32ValueR\vfavouriteId\"3\n" +
+	"\x14ReserveTicketRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\"I\n" +
+	"\x11BookTicketRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"u\n" +
+	"\x14BookAnyTicketRequest\x12\x17\n" +
+	"\adraw_id\x18\x01 \x01(\x05R\x06drawId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12+\n" +
+	"\x11preferred_numbers\x18\x03 \x03(\tR\x10preferredNumbers\"6\n" +
+	"\x15ReleaseTicketsRequest\x12\x1d\n" +
+	"\n" +
+	"ticket_ids\x18\x01 \x03(\x05R\tticketIds\"\xb5\x02\n" +
+	"\x16ListUserTicketsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x124\n" +
+	"\adraw_id\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\x06drawId\x12\x1a\n" +
+	"\bstatuses\x18\x03 \x03(\tR\bstatuses\x12!\n" +
+	"\fcreated_from\x18\x04 \x01(\tR\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\x05 \x01(\tR\tcreatedTo\x122\n" +
+	"\x05order\x18\x06 \x01(\x0e2\x1c.ticket_service.v1.SortOrderR\x05order\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageToken\"~\n" +
+	"\x17ListUserTicketsResponse\x12;\n" +
+	"\atickets\x18\x01 \x03(\v2!.ticket_service.v1.TicketWithDrawR\atickets\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x85\x02\n" +
+	"\x1bListAvailableTicketsRequest\x124\n" +
+	"\adraw_id\x18\x01 \x01(\v2\x1b.google.protobuf.Int32ValueR\x06drawId\x12!\n" +
+	"\fcreated_from\x18\x02 \x01(\tR\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\x03 \x01(\tR\tcreatedTo\x122\n" +
+	"\x05order\x18\x04 \x01(\x0e2\x1c.ticket_service.v1.SortOrderR\x05order\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"{\n" +
+	"\x1cListAvailableTicketsResponse\x123\n" +
+	"\atickets\x18\x01 \x03(\v2\x19.ticket_service.v1.TicketR\atickets\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"9\n" +
+	"\x18SetWinningTicketsRequest\x12\x1d\n" +
+	"\n" +
+	"ticket_ids\x18\x01 \x03(\x05R\tticketIds\"P\n" +
+	"\x19SetWinningTicketsResponse\x123\n" +
+	"\atickets\x18\x01 \x03(\v2\x19.ticket_service.v1.TicketR\atickets\"1\n" +
+	"\x12CheckResultRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\"-\n" +
+	"\x13CheckResultResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\xad\x02\n" +
+	"\fSubscription\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\x05R\x0esubscriptionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12!\n" +
+	"\flottery_type\x18\x03 \x01(\tR\vlotteryType\x12\x18\n" +
+	"\anumbers\x18\x04 \x03(\tR\anumbers\x12\x1f\n" +
+	"\vdraws_total\x18\x05 \x01(\x05R\n" +
+	"drawsTotal\x12'\n" +
+	"\x0fdraws_remaining\x18\x06 \x01(\x05R\x0edrawsRemaining\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\"\x92\x01\n" +
+	"\x19CreateSubscriptionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12!\n" +
+	"\flottery_type\x18\x02 \x01(\tR\vlotteryType\x12\x18\n" +
+	"\anumbers\x18\x03 \x03(\tR\anumbers\x12\x1f\n" +
+	"\vdraws_count\x18\x04 \x01(\x05R\n" +
+	"drawsCount\"7\n" +
+	"\x1cListUserSubscriptionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"f\n" +
+	"\x1dListUserSubscriptionsResponse\x12E\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x1f.ticket_service.v1.SubscriptionR\rsubscriptions\"D\n" +
+	"\x19CancelSubscriptionRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\x05R\x0esubscriptionId\"D\n" +
+	"\x19ResumeSubscriptionRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\x05R\x0esubscriptionId\"\xff\x01\n" +
+	"\x04Cart\x12\x17\n" +
+	"\acart_id\x18\x01 \x01(\x05R\x06cartId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12:\n" +
+	"\n" +
+	"invoice_id\x18\x04 \x01(\v2\x1b.google.protobuf.Int64ValueR\tinvoiceId\x123\n" +
+	"\atickets\x18\x05 \x03(\v2\x19.ticket_service.v1.TicketR\atickets\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\")\n" +
+	"\x0eGetCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"H\n" +
+	"\x10AddToCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1b\n" +
+	"\tticket_id\x18\x02 \x01(\x05R\bticketId\"M\n" +
+	"\x15RemoveFromCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1b\n" +
+	"\tticket_id\x18\x02 \x01(\x05R\bticketId\".\n" +
+	"\x13CheckoutCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"\xe0\x01\n" +
+	"\bTransfer\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x05R\n" +
+	"transferId\x12\x1b\n" +
+	"\tticket_id\x18\x02 \x01(\x05R\bticketId\x12 \n" +
+	"\ffrom_user_id\x18\x03 \x01(\x05R\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x04 \x01(\x05R\btoUserId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1f\n" +
+	"\vresolved_at\x18\a \x01(\tR\n" +
+	"resolvedAt\"R\n" +
+	"\x15TransferTicketRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x02 \x01(\x05R\btoUserId\"8\n" +
+	"\x15TransferActionRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x05R\n" +
+	"transferId\"/\n" +
+	"\x14ListTransfersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"R\n" +
+	"\x15ListTransfersResponse\x129\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x1b.ticket_service.v1.TransferR\ttransfers\"0\n" +
+	"\x15GetDrawSummaryRequest\x12\x17\n" +
+	"\adraw_id\x18\x01 \x01(\x05R\x06drawId\"\x93\x01\n" +
+	"\tPrizeTier\x12\x18\n" +
+	"\amatched\x18\x01 \x01(\x05R\amatched\x12\x18\n" +
+	"\awinners\x18\x02 \x01(\x05R\awinners\x12(\n" +
+	"\x05prize\x18\x03 \x01(\v2\x12.google.type.MoneyR\x05prize\x12(\n" +
+	"\x05total\x18\x04 \x01(\v2\x12.google.type.MoneyR\x05total\"\xe0\x01\n" +
+	"\vDrawSummary\x12+\n" +
+	"\x04draw\x18\x01 \x01(\v2\x17.ticket_service.v1.DrawR\x04draw\x12!\n" +
+	"\ftickets_sold\x18\x02 \x01(\x05R\vticketsSold\x12\x18\n" +
+	"\awinners\x18\x03 \x01(\x05R\awinners\x122\n" +
+	"\x05tiers\x18\x04 \x03(\v2\x1c.ticket_service.v1.PrizeTierR\x05tiers\x123\n" +
+	"\vtotal_prize\x18\x05 \x01(\v2\x12.google.type.MoneyR\n" +
+	"totalPrize\"1\n" +
+	"\x16ListDrawWinnersRequest\x12\x17\n" +
+	"\adraw_id\x18\x01 \x01(\x05R\x06drawId\"\xe2\x02\n" +
+	"\x06Winner\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\x05R\bticketId\x124\n" +
+	"\auser_id\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\x06userId\x12\x16\n" +
+	"\x06serial\x18\x03 \x01(\tR\x06serial\x12\x18\n" +
+	"\anumbers\x18\x04 \x03(\tR\anumbers\x12\x18\n" +
+	"\amatched\x18\x05 \x01(\x05R\amatched\x12(\n" +
+	"\x05prize\x18\x06 \x01(\v2\x12.google.type.MoneyR\x05prize\x12>\n" +
+	"\fsyndicate_id\x18\a \x01(\v2\x1b.google.protobuf.Int32ValueR\vsyndicateId\x12O\n" +
+	"\x11syndicate_payouts\x18\b \x03(\v2\".ticket_service.v1.SyndicatePayoutR\x10syndicatePayouts\"V\n" +
+	"\x0fSyndicatePayout\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12*\n" +
+	"\x06amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x06amount\"N\n" +
+	"\x17ListDrawWinnersResponse\x123\n" +
+	"\awinners\x18\x01 \x03(\v2\x19.ticket_service.v1.WinnerR\awinners\"^\n" +
+	"\x15GetNumberStatsRequest\x12!\n" +
+	"\flottery_type\x18\x01 \x01(\tR\vlotteryType\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"\x92\x01\n" +
+	"\n" +
+	"NumberStat\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\x12\x14\n" +
+	"\x05drawn\x18\x02 \x01(\x05R\x05drawn\x12\x14\n" +
+	"\x05picks\x18\x03 \x01(\x05R\x05picks\x12\x1f\n" +
+	"\vcurrent_gap\x18\x04 \x01(\x05R\n" +
+	"currentGap\x12\x1f\n" +
+	"\vlongest_gap\x18\x05 \x01(\x05R\n" +
+	"longestGap\"\x8a\x01\n" +
+	"\x16GetNumberStatsResponse\x12!\n" +
+	"\flottery_type\x18\x01 \x01(\tR\vlotteryType\x12\x14\n" +
+	"\x05draws\x18\x02 \x01(\x05R\x05draws\x127\n" +
+	"\anumbers\x18\x03 \x03(\v2\x1d.ticket_service.v1.NumberStatR\anumbers\"\x9e\x01\n" +
+	"\tFavourite\x12!\n" +
+	"\ffavourite_id\x18\x01 \x01(\x05R\vfavouriteId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\flottery_type\x18\x03 \x01(\tR\vlotteryType\x12\x18\n" +
+	"\anumbers\x18\x04 \x03(\tR\anumbers\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"g\n" +
+	"\x14SaveFavouriteRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\flottery_type\x18\x02 \x01(\tR\vlotteryType\x12\x18\n" +
+	"\anumbers\x18\x03 \x03(\tR\anumbers\"V\n" +
+	"\x16ListFavouritesResponse\x12<\n" +
+	"\n" +
+	"favourites\x18\x01 \x03(\v2\x1c.ticket_service.v1.FavouriteR\n" +
+	"favourites\";\n" +
+	"\x16DeleteFavouriteRequest\x12!\n" +
+	"\ffavourite_id\x18\x01 \x01(\x05R\vfavouriteId\"\x96\x01\n" +
+	"\x0fSyndicateMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x16\n" +
+	"\x06shares\x18\x02 \x01(\x05R\x06shares\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"invited_at\x18\x04 \x01(\tR\tinvitedAt\x12\x1b\n" +
+	"\tjoined_at\x18\x05 \x01(\tR\bjoinedAt\"\xd9\x01\n" +
+	"\tSyndicate\x12!\n" +
+	"\fsyndicate_id\x18\x01 \x01(\x05R\vsyndicateId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\x05R\aownerId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12<\n" +
+	"\amembers\x18\x05 \x03(\v2\".ticket_service.v1.SyndicateMemberR\amembers\x12\x1d\n" +
+	"\n" +
+	"ticket_ids\x18\x06 \x03(\x05R\tticketIds\"\x9a\x01\n" +
+	"\x0eSyndicateShare\x12!\n" +
+	"\fsyndicate_id\x18\x01 \x01(\x05R\vsyndicateId\x12\x16\n" +
+	"\x06shares\x18\x02 \x01(\x05R\x06shares\x12!\n" +
+	"\ftotal_shares\x18\x03 \x01(\x05R\vtotalShares\x12*\n" +
+	"\x06payout\x18\x04 \x01(\v2\x12.google.type.MoneyR\x06payout\"D\n" +
+	"\x16CreateSyndicateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06shares\x18\x02 \x01(\x05R\x06shares\"5\n" +
+	"\x10SyndicateRequest\x12!\n" +
+	"\fsyndicate_id\x18\x01 \x01(\x05R\vsyndicateId\"V\n" +
+	"\x16ListSyndicatesResponse\x12<\n" +
+	"\n" +
+	"syndicates\x18\x01 \x03(\v2\x1c.ticket_service.v1.SyndicateR\n" +
+	"syndicates\"n\n" +
+	"\x18InviteToSyndicateRequest\x12!\n" +
+	"\fsyndicate_id\x18\x01 \x01(\x05R\vsyndicateId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x16\n" +
+	"\x06shares\x18\x03 \x01(\x05R\x06shares\"[\n" +
+	"\x19AddSyndicateTicketRequest\x12!\n" +
+	"\fsyndicate_id\x18\x01 \x01(\x05R\vsyndicateId\x12\x1b\n" +
+	"\tticket_id\x18\x02 \x01(\x05R\bticketId*E\n" +
+	"\tSortOrder\x12\x1b\n" +
+	"\x17SORT_ORDER_NEWEST_FIRST\x10\x00\x12\x1b\n" +
+	"\x17SORT_ORDER_OLDEST_FIRST\x10\x012\xe3&\n" +
+	"\rTicketService\x12m\n" +
+	"\tGetTicket\x12#.ticket_service.v1.GetTicketRequest\x1a\x19.ticket_service.v1.Ticket\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/tickets/{ticket_id}\x12\x83\x01\n" +
+	"\fVerifyTicket\x12&.ticket_service.v1.VerifyTicketRequest\x1a%.ticket_service.v1.TicketVerification\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/tickets/verify/{serial}\x12j\n" +
+	"\fCreateTicket\x12&.ticket_service.v1.CreateTicketRequest\x1a\x19.ticket_service.v1.Ticket\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/api/tickets\x12\x80\x01\n" +
+	"\rReserveTicket\x12'.ticket_service.v1.ReserveTicketRequest\x1a\x19.ticket_service.v1.Ticket\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/tickets/{ticket_id}/reserve\x12w\n" +
+	"\n" +
+	"BookTicket\x12$.ticket_service.v1.BookTicketRequest\x1a\x19.ticket_service.v1.Ticket\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/tickets/{ticket_id}/book\x12u\n" +
+	"\rBookAnyTicket\x12'.ticket_service.v1.BookAnyTicketRequest\x1a\x19.ticket_service.v1.Ticket\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/tickets/book-any\x12{\n" +
+	"\x12BookTicketInternal\x12$.ticket_service.v1.BookTicketRequest\x1a\x19.ticket_service.v1.Ticket\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/tickets/{ticket_id}/book\x12w\n" +
+	"\x16ReleaseTicketsInternal\x12(.ticket_service.v1.ReleaseTicketsRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/tickets/release\x12~\n" +
+	"\x0fListUserTickets\x12).ticket_service.v1.ListUserTicketsRequest\x1a*.ticket_service.v1.ListUserTicketsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/api/tickets\x12\x97\x01\n" +
+	"\x14ListAvailableTickets\x12..ticket_service.v1.ListAvailableTicketsRequest\x1a/.ticket_service.v1.ListAvailableTicketsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/tickets/available\x12\x8f\x01\n" +
+	"\x11SetWinningTickets\x12+.ticket_service.v1.SetWinningTicketsRequest\x1a,.ticket_service.v1.SetWinningTicketsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/tickets/winning\x12\x8b\x01\n" +
+	"\vCheckResult\x12%.ticket_service.v1.CheckResultRequest\x1a&.ticket_service.v1.CheckResultResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/tickets/{ticket_id}/check-result\x12\x82\x01\n" +
+	"\x12CreateSubscription\x12,.ticket_service.v1.CreateSubscriptionRequest\x1a\x1f.ticket_service.v1.Subscription\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/subscriptions\x12\x96\x01\n" +
+	"\x15ListUserSubscriptions\x12/.ticket_service.v1.ListUserSubscriptionsRequest\x1a0.ticket_service.v1.ListUserSubscriptionsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/subscriptions\x12\x9b\x01\n" +
+	"\x12CancelSubscription\x12,.ticket_service.v1.CancelSubscriptionRequest\x1a\x1f.ticket_service.v1.Subscription\"6\x82\xd3\xe4\x93\x020:\x01*\"+/api/subscriptions/{subscription_id}/cancel\x12\x9b\x01\n" +
+	"\x12ResumeSubscription\x12,.ticket_service.v1.ResumeSubscriptionRequest\x1a\x1f.ticket_service.v1.Subscription\"6\x82\xd3\xe4\x93\x020:\x01*\"+/api/subscriptions/{subscription_id}/resume\x12X\n" +
+	"\aGetCart\x12!.ticket_service.v1.GetCartRequest\x1a\x17.ticket_service.v1.Cart\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/api/cart\x12g\n" +
+	"\tAddToCart\x12#.ticket_service.v1.AddToCartRequest\x1a\x17.ticket_service.v1.Cart\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/cart/tickets\x12z\n" +
+	"\x0eRemoveFromCart\x12(.ticket_service.v1.RemoveFromCartRequest\x1a\x17.ticket_service.v1.Cart\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/cart/tickets/{ticket_id}\x12n\n" +
+	"\fCheckoutCart\x12&.ticket_service.v1.CheckoutCartRequest\x1a\x17.ticket_service.v1.Cart\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/cart/checkout\x12\x85\x01\n" +
+	"\x0eTransferTicket\x12(.ticket_service.v1.TransferTicketRequest\x1a\x1b.ticket_service.v1.Transfer\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/tickets/{ticket_id}/transfer\x12\x87\x01\n" +
+	"\x0eAcceptTransfer\x12(.ticket_service.v1.TransferActionRequest\x1a\x1b.ticket_service.v1.Transfer\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/transfers/{transfer_id}/accept\x12\x89\x01\n" +
+	"\x0fDeclineTransfer\x12(.ticket_service.v1.TransferActionRequest\x1a\x1b.ticket_service.v1.Transfer\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/transfers/{transfer_id}/decline\x12\x87\x01\n" +
+	"\x0eCancelTransfer\x12(.ticket_service.v1.TransferActionRequest\x1a\x1b.ticket_service.v1.Transfer\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/transfers/{transfer_id}/cancel\x12z\n" +
+	"\rListTransfers\x12'.ticket_service.v1.ListTransfersRequest\x1a(.ticket_service.v1.ListTransfersResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/transfers\x12\x80\x01\n" +
+	"\x0eGetDrawSummary\x12(.ticket_service.v1.GetDrawSummaryRequest\x1a\x1e.ticket_service.v1.DrawSummary\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/draws/{draw_id}/summary\x12\x81\x01\n" +
+	"\x0eGetNumberStats\x12(.ticket_service.v1.GetNumberStatsRequest\x1a).ticket_service.v1.GetNumberStatsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/stats/numbers\x12\x8e\x01\n" +
+	"\x0fListDrawWinners\x12).ticket_service.v1.ListDrawWinnersRequest\x1a*.ticket_service.v1.ListDrawWinnersResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/draws/{draw_id}/winners\x12r\n" +
+	"\rSaveFavourite\x12'.ticket_service.v1.SaveFavouriteRequest\x1a\x1c.ticket_service.v1.Favourite\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/favourites\x12l\n" +
+	"\x0eListFavourites\x12\x16.google.protobuf.Empty\x1a).ticket_service.v1.ListFavouritesResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/favourites\x12|\n" +
+	"\x0fDeleteFavourite\x12).ticket_service.v1.DeleteFavouriteRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 *\x1e/api/favourites/{favourite_id}\x12v\n" +
+	"\x0fCreateSyndicate\x12).ticket_service.v1.CreateSyndicateRequest\x1a\x1c.ticket_service.v1.Syndicate\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/syndicates\x12y\n" +
+	"\fGetSyndicate\x12#.ticket_service.v1.SyndicateRequest\x1a\x1c.ticket_service.v1.Syndicate\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/syndicates/{syndicate_id}\x12l\n" +
+	"\x0eListSyndicates\x12\x16.google.protobuf.Empty\x1a).ticket_service.v1.ListSyndicatesResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/syndicates\x12\x91\x01\n" +
+	"\x11InviteToSyndicate\x12+.ticket_service.v1.InviteToSyndicateRequest\x1a\x1c.ticket_service.v1.Syndicate\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/syndicates/{syndicate_id}/members\x12\x8c\x01\n" +
+	"\x15AcceptSyndicateInvite\x12#.ticket_service.v1.SyndicateRequest\x1a\x1c.ticket_service.v1.Syndicate\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/syndicates/{syndicate_id}/accept\x12\x8e\x01\n" +
+	"\x16DeclineSyndicateInvite\x12#.ticket_service.v1.SyndicateRequest\x1a\x1c.ticket_service.v1.Syndicate\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/syndicates/{syndicate_id}/decline\x12\x93\x01\n" +
+	"\x12AddSyndicateTicket\x12,.ticket_service.v1.AddSyndicateTicketRequest\x1a\x1c.ticket_service.v1.Syndicate\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/syndicates/{syndicate_id}/ticketsB\xd8\x01\n" +
+	"\x15com.ticket_service.v1B\x12TicketServiceProtoP\x01ZJgithub.com/MaxFando/lms/payment-service/ticket-service/v1;ticket_servicev1\xa2\x02\x03TXX\xaa\x02\x10TicketService.V1\xca\x02\x10TicketService\\V1\xe2\x02\x1cTicketService\\V1\\GPBMetadata\xea\x02\x11TicketService::V1b\x06proto3"
+
+var (
+	file_ticket_service_v1_ticket_service_proto_rawDescOnce sync.Once
+	file_ticket_service_v1_ticket_service_proto_rawDescData []byte
+)
+
+func file_ticket_service_v1_ticket_service_proto_rawDescGZIP() []byte {
+	file_ticket_service_v1_ticket_service_proto_rawDescOnce.Do(func() {
+		file_ticket_service_v1_ticket_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ticket_service_v1_ticket_service_proto_rawDesc), len(file_ticket_service_v1_ticket_service_proto_rawDesc)))
+	})
+	return file_ticket_service_v1_ticket_service_proto_rawDescData
+}
+
+var file_ticket_service_v1_ticket_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ticket_service_v1_ticket_service_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
+var file_ticket_service_v1_ticket_service_proto_goTypes = []any{
+	(SortOrder)(0),                        // 0: ticket_service.v1.SortOrder
+	(*Draw)(nil),                          // 1: ticket_service.v1.Draw
+	(*Ticket)(nil),                        // 2: ticket_service.v1.Ticket
+	(*TicketWithDraw)(nil),                // 3: ticket_service.v1.TicketWithDraw
+	(*VerifyTicketRequest)(nil),           // 4: ticket_service.v1.VerifyTicketRequest
+	(*TicketVerification)(nil),            // 5: ticket_service.v1.TicketVerification
+	(*GetTicketRequest)(nil),              // 6: ticket_service.v1.GetTicketRequest
+	(*CreateTicketRequest)(nil),           // 7: ticket_service.v1.CreateTicketRequest
+	(*ReserveTicketRequest)(nil),          // 8: ticket_service.v1.ReserveTicketRequest
+	(*BookTicketRequest)(nil),             // 9: ticket_service.v1.BookTicketRequest
+	(*BookAnyTicketRequest)(nil),          // 10: ticket_service.v1.BookAnyTicketRequest
+	(*ReleaseTicketsRequest)(nil),         // 11: ticket_service.v1.ReleaseTicketsRequest
+	(*ListUserTicketsRequest)(nil),        // 12: ticket_service.v1.ListUserTicketsRequest
+	(*ListUserTicketsResponse)(nil),       // 13: ticket_service.v1.ListUserTicketsResponse
+	(*ListAvailableTicketsRequest)(nil),   // 14: ticket_service.v1.ListAvailableTicketsRequest
+	(*ListAvailableTicketsResponse)(nil),  // 15: ticket_service.v1.ListAvailableTicketsResponse
+	(*SetWinningTicketsRequest)(nil),      // 16: ticket_service.v1.SetWinningTicketsRequest
+	(*SetWinningTicketsResponse)(nil),     // 17: ticket_service.v1.SetWinningTicketsResponse
+	(*CheckResultRequest)(nil),            // 18: ticket_service.v1.CheckResultRequest
+	(*CheckResultResponse)(nil),           // 19: ticket_service.v1.CheckResultResponse
+	(*Subscription)(nil),                  // 20: ticket_service.v1.Subscription
+	(*CreateSubscriptionRequest)(nil),     // 21: ticket_service.v1.CreateSubscriptionRequest
+	(*ListUserSubscriptionsRequest)(nil),  // 22: ticket_service.v1.ListUserSubscriptionsRequest
+	(*ListUserSubscriptionsResponse)(nil), // 23: ticket_service.v1.ListUserSubscriptionsResponse
+	(*CancelSubscriptionRequest)(nil),     // 24: ticket_service.v1.CancelSubscriptionRequest
+	(*ResumeSubscriptionRequest)(nil),     // 25: ticket_service.v1.ResumeSubscriptionRequest
+	(*Cart)(nil),                          // 26: ticket_service.v1.Cart
+	(*GetCartRequest)(nil),                // 27: ticket_service.v1.GetCartRequest
+	(*AddToCartRequest)(nil),              // 28: ticket_service.v1.AddToCartRequest
+	(*RemoveFromCartRequest)(nil),         // 29: ticket_service.v1.RemoveFromCartRequest
+	(*CheckoutCartRequest)(nil),           // 30: ticket_service.v1.CheckoutCartRequest
+	(*Transfer)(nil),                      // 31: ticket_service.v1.Transfer
+	(*TransferTicketRequest)(nil),         // 32: ticket_service.v1.TransferTicketRequest
+	(*TransferActionRequest)(nil),         // 33: ticket_service.v1.TransferActionRequest
+	(*ListTransfersRequest)(nil),          // 34: ticket_service.v1.ListTransfersRequest
+	(*ListTransfersResponse)(nil),         // 35: ticket_service.v1.ListTransfersResponse
+	(*GetDrawSummaryRequest)(nil),         // 36: ticket_service.v1.GetDrawSummaryRequest
+	(*PrizeTier)(nil),                     // 37: ticket_service.v1.PrizeTier
+	(*DrawSummary)(nil),                   // 38: ticket_service.v1.DrawSummary
+	(*ListDrawWinnersRequest)(nil),        // 39: ticket_service.v1.ListDrawWinnersRequest
+	(*Winner)(nil),                        // 40: ticket_service.v1.Winner
+	(*SyndicatePayout)(nil),               // 41: ticket_service.v1.SyndicatePayout
+	(*ListDrawWinnersResponse)(nil),       // 42: ticket_service.v1.ListDrawWinnersResponse
+	(*GetNumberStatsRequest)(nil),         // 43: ticket_service.v1.GetNumberStatsRequest
+	(*NumberStat)(nil),                    // 44: ticket_service.v1.NumberStat
+	(*GetNumberStatsResponse)(nil),        // 45: ticket_service.v1.GetNumberStatsResponse
+	(*Favourite)(nil),                     // 46: ticket_service.v1.Favourite
+	(*SaveFavouriteRequest)(nil),          // 47: ticket_service.v1.SaveFavouriteRequest
+	(*ListFavouritesResponse)(nil),        // 48: ticket_service.v1.ListFavouritesResponse
+	(*DeleteFavouriteRequest)(nil),        // 49: ticket_service.v1.DeleteFavouriteRequest
+	(*SyndicateMember)(nil),               // 50: ticket_service.v1.SyndicateMember
+	(*Syndicate)(nil),                     // 51: ticket_service.v1.Syndicate
+	(*SyndicateShare)(nil),                // 52: ticket_service.v1.SyndicateShare
+	(*CreateSyndicateRequest)(nil),        // 53: ticket_service.v1.CreateSyndicateRequest
+	(*SyndicateRequest)(nil),              // 54: ticket_service.v1.SyndicateRequest
+	(*ListSyndicatesResponse)(nil),        // 55: ticket_service.v1.ListSyndicatesResponse
+	(*InviteToSyndicateRequest)(nil),      // 56: ticket_service.v1.InviteToSyndicateRequest
+	(*AddSyndicateTicketRequest)(nil),     // 57: ticket_service.v1.AddSyndicateTicketRequest
+	(*wrapperspb.Int32Value)(nil),         // 58: google.protobuf.Int32Value
+	(*wrapperspb.Int64Value)(nil),         // 59: google.protobuf.Int64Value
+	(*money.Money)(nil),                   // 60: google.type.Money
+	(*emptypb.Empty)(nil),                 // 61: google.protobuf.Empty
+}
+var file_ticket_service_v1_ticket_service_proto_depIdxs = []int32{
+	58, // 0: ticket_service.v1.Ticket.user_id:type_name -> google.protobuf.Int32Value
+	59, // 1: ticket_service.v1.Ticket.invoice_id:type_name -> google.protobuf.Int64Value
+	1,  // 2: ticket_service.v1.TicketWithDraw.draw:type_name -> ticket_service.v1.Draw
+	52, // 3: ticket_service.v1.TicketWithDraw.syndicate:type_name -> ticket_service.v1.SyndicateShare
+	1,  // 4: ticket_service.v1.TicketVerification.draw:type_name -> ticket_service.v1.Draw
+	58, // 5: ticket_service.v1.CreateTicketRequest.favourite_id:type_name -> google.protobuf.Int32Value
+	58, // 6: ticket_service.v1.ListUserTicketsRequest.draw_id:type_name -> google.protobuf.Int32Value
+	0,  // 7: ticket_service.v1.ListUserTicketsRequest.order:type_name -> ticket_service.v1.SortOrder
+	3,  // 8: ticket_service.v1.ListUserTicketsResponse.tickets:type_name -> ticket_service.v1.TicketWithDraw
+	58, // 9: ticket_service.v1.ListAvailableTicketsRequest.draw_id:type_name -> google.protobuf.Int32Value
+	0,  // 10: ticket_service.v1.ListAvailableTicketsRequest.order:type_name -> ticket_service.v1.SortOrder
+	2,  // 11: ticket_service.v1.ListAvailableTicketsResponse.tickets:type_name -> ticket_service.v1.Ticket
+	2,  // 12: ticket_service.v1.SetWinningTicketsResponse.tickets:type_name -> ticket_service.v1.Ticket
+	20, // 13: ticket_service.v1.ListUserSubscriptionsResponse.subscriptions:type_name -> ticket_service.v1.Subscription
+	59, // 14: ticket_service.v1.Cart.invoice_id:type_name -> google.protobuf.Int64Value
+	2,  // 15: ticket_service.v1.Cart.tickets:type_name -> ticket_service.v1.Ticket
+	31, // 16: ticket_service.v1.ListTransfersResponse.transfers:type_name -> ticket_service.v1.Transfer
+	60, // 17: ticket_service.v1.PrizeTier.prize:type_name -> google.type.Money
+	60, // 18: ticket_service.v1.PrizeTier.total:type_name -> google.type.Money
+	1,  // 19: ticket_service.v1.DrawSummary.draw:type_name -> ticket_service.v1.Draw
+	37, // 20: ticket_service.v1.DrawSummary.tiers:type_name -> ticket_service.v1.PrizeTier
+	60, // 21: ticket_service.v1.DrawSummary.total_prize:type_name -> google.type.Money
+	58, // 22: ticket_service.v1.Winner.user_id:type_name -> google.protobuf.Int32Value
+	60, // 23: ticket_service.v1.Winner.prize:type_name -> google.type.Money
+	58, // 24: ticket_service.v1.Winner.syndicate_id:type_name -> google.protobuf.Int32Value
+	41, // 25: ticket_service.v1.Winner.syndicate_payouts:type_name -> ticket_service.v1.SyndicatePayout
+	60, // 26: ticket_service.v1.SyndicatePayout.amount:type_name -> google.type.Money
+	40, // 27: ticket_service.v1.ListDrawWinnersResponse.winners:type_name -> ticket_service.v1.Winner
+	44, // 28: ticket_service.v1.GetNumberStatsResponse.numbers:type_name -> ticket_service.v1.NumberStat
+	46, // 29: ticket_service.v1.ListFavouritesResponse.favourites:type_name -> ticket_service.v1.Favourite
+	50, // 30: ticket_service.v1.Syndicate.members:type_name -> ticket_service.v1.SyndicateMember
+	60, // 31: ticket_service.v1.SyndicateShare.payout:type_name -> google.type.Money
+	51, // 32: ticket_service.v1.ListSyndicatesResponse.syndicates:type_name -> ticket_service.v1.Syndicate
+	6,  // 33: ticket_service.v1.TicketService.GetTicket:input_type -> ticket_service.v1.GetTicketRequest
+	4,  // 34: ticket_service.v1.TicketService.VerifyTicket:input_type -> ticket_service.v1.VerifyTicketRequest
+	7,  // 35: ticket_service.v1.TicketService.CreateTicket:input_type -> ticket_service.v1.CreateTicketRequest
+	8,  // 36: ticket_service.v1.TicketService.ReserveTicket:input_type -> ticket_service.v1.ReserveTicketRequest
+	9,  // 37: ticket_service.v1.TicketService.BookTicket:input_type -> ticket_service.v1.BookTicketRequest
+	10, // 38: ticket_service.v1.TicketService.BookAnyTicket:input_type -> ticket_service.v1.BookAnyTicketRequest
+	9,  // 39: ticket_service.v1.TicketService.BookTicketInternal:input_type -> ticket_service.v1.BookTicketRequest
+	11, // 40: ticket_service.v1.TicketService.ReleaseTicketsInternal:input_type -> ticket_service.v1.ReleaseTicketsRequest
+	12, // 41: ticket_service.v1.TicketService.ListUserTickets:input_type -> ticket_service.v1.ListUserTicketsRequest
+	14, // 42: ticket_service.v1.TicketService.ListAvailableTickets:input_type -> ticket_service.v1.ListAvailableTicketsRequest
+	16, // 43: ticket_service.v1.TicketService.SetWinningTickets:input_type -> ticket_service.v1.SetWinningTicketsRequest
+	18, // 44: ticket_service.v1.TicketService.CheckResult:input_type -> ticket_service.v1.CheckResultRequest
+	21, // 45: ticket_service.v1.TicketService.CreateSubscription:input_type -> ticket_service.v1.CreateSubscriptionRequest
+	22, // 46: ticket_service.v1.TicketService.ListUserSubscriptions:input_type -> ticket_service.v1.ListUserSubscriptionsRequest
+	24, // 47: ticket_service.v1.TicketService.CancelSubscription:input_type -> ticket_service.v1.CancelSubscriptionRequest
+	25, // 48: ticket_service.v1.TicketService.ResumeSubscription:input_type -> ticket_service.v1.ResumeSubscriptionRequest
+	27, // 49: ticket_service.v1.TicketService.GetCart:input_type -> ticket_service.v1.GetCartRequest
+	28, // 50: ticket_service.v1.TicketService.AddToCart:input_type -> ticket_service.v1.AddToCartRequest
+	29, // 51: ticket_service.v1.TicketService.RemoveFromCart:input_type -> ticket_service.v1.RemoveFromCartRequest
+	30, // 52: ticket_service.v1.TicketService.CheckoutCart:input_type -> ticket_service.v1.CheckoutCartRequest
+	32, // 53: ticket_service.v1.TicketService.TransferTicket:input_type -> ticket_service.v1.TransferTicketRequest
+	33, // 54: ticket_service.v1.TicketService.AcceptTransfer:input_type -> ticket_service.v1.TransferActionRequest
+	33, // 55: ticket_service.v1.TicketService.DeclineTransfer:input_type -> ticket_service.v1.TransferActionRequest
+	33, // 56: ticket_service.v1.TicketService.CancelTransfer:input_type -> ticket_service.v1.TransferActionRequest
+	34, // 57: ticket_service.v1.TicketService.ListTransfers:input_type -> ticket_service.v1.ListTransfersRequest
+	36, // 58: ticket_service.v1.TicketService.GetDrawSummary:input_type -> ticket_service.v1.GetDrawSummaryRequest
+	43, // 59: ticket_service.v1.TicketService.GetNumberStats:input_type -> ticket_service.v1.GetNumberStatsRequest
+	39, // 60: ticket_service.v1.TicketService.ListDrawWinners:input_type -> ticket_service.v1.ListDrawWinnersRequest
+	47, // 61: ticket_service.v1.TicketService.SaveFavourite:input_type -> ticket_service.v1.SaveFavouriteRequest
+	61, // 62: ticket_service.v1.TicketService.ListFavourites:input_type -> google.protobuf.Empty
+	49, // 63: ticket_service.v1.TicketService.DeleteFavourite:input_type -> ticket_service.v1.DeleteFavouriteRequest
+	53, // 64: ticket_service.v1.TicketService.CreateSyndicate:input_type -> ticket_service.v1.CreateSyndicateRequest
+	54, // 65: ticket_service.v1.TicketService.GetSyndicate:input_type -> ticket_service.v1.SyndicateRequest
+	61, // 66: ticket_service.v1.TicketService.ListSyndicates:input_type -> google.protobuf.Empty
+	56, // 67: ticket_service.v1.TicketService.InviteToSyndicate:input_type -> ticket_service.v1.InviteToSyndicateRequest
+	54, // 68: ticket_service.v1.TicketService.AcceptSyndicateInvite:input_type -> ticket_service.v1.SyndicateRequest
+	54, // 69: ticket_service.v1.TicketService.DeclineSyndicateInvite:input_type -> ticket_service.v1.SyndicateRequest
+	57, // 70: ticket_service.v1.TicketService.AddSyndicateTicket:input_type -> ticket_service.v1.AddSyndicateTicketRequest
+	2,  // 71: ticket_service.v1.TicketService.GetTicket:output_type -> ticket_service.v1.Ticket
+	5,  // 72: ticket_service.v1.TicketService.VerifyTicket:output_type -> ticket_service.v1.TicketVerification
+	2,  // 73: ticket_service.v1.TicketService.CreateTicket:output_type -> ticket_service.v1.Ticket
+	2,  // 74: ticket_service.v1.TicketService.ReserveTicket:output_type -> ticket_service.v1.Ticket
+	2,  // 75: ticket_service.v1.TicketService.BookTicket:output_type -> ticket_service.v1.Ticket
+	2,  // 76: ticket_service.v1.TicketService.BookAnyTicket:output_type -> ticket_service.v1.Ticket
+	2,  // 77: ticket_service.v1.TicketService.BookTicketInternal:output_type -> ticket_service.v1.Ticket
+	61, // 78: ticket_service.v1.TicketService.ReleaseTicketsInternal:output_type -> google.protobuf.Empty
+	13, // 79: ticket_service.v1.TicketService.ListUserTickets:output_type -> ticket_service.v1.ListUserTicketsResponse
+	15, // 80: ticket_service.v1.TicketService.ListAvailableTickets:output_type -> ticket_service.v1.ListAvailableTicketsResponse
+	17, // 81: ticket_service.v1.TicketService.SetWinningTickets:output_type -> ticket_service.v1.SetWinningTicketsResponse
+	19, // 82: ticket_service.v1.TicketService.CheckResult:output_type -> ticket_service.v1.CheckResultResponse
+	20, // 83: ticket_service.v1.TicketService.CreateSubscription:output_type -> ticket_service.v1.Subscription
+	23, // 84: ticket_service.v1.TicketService.ListUserSubscriptions:output_type -> ticket_service.v1.ListUserSubscriptionsResponse
+	20, // 85: ticket_service.v1.TicketService.CancelSubscription:output_type -> ticket_service.v1.Subscription
+	20, // 86: ticket_service.v1.TicketService.ResumeSubscription:output_type -> ticket_service.v1.Subscription
+	26, // 87: ticket_service.v1.TicketService.GetCart:output_type -> ticket_service.v1.Cart
+	26, // 88: ticket_service.v1.TicketService.AddToCart:output_type -> ticket_service.v1.Cart
+	26, // 89: ticket_service.v1.TicketService.RemoveFromCart:output_type -> ticket_service.v1.Cart
+	26, // 90: ticket_service.v1.TicketService.CheckoutCart:output_type -> ticket_service.v1.Cart
+	31, // 91: ticket_service.v1.TicketService.TransferTicket:output_type -> ticket_service.v1.Transfer
+	31, // 92: ticket_service.v1.TicketService.AcceptTransfer:output_type -> ticket_service.v1.Transfer
+	31, // 93: ticket_service.v1.TicketService.DeclineTransfer:output_type -> ticket_service.v1.Transfer
+	31, // 94: ticket_service.v1.TicketService.CancelTransfer:output_type -> ticket_service.v1.Transfer
+	35, // 95: ticket_service.v1.TicketService.ListTransfers:output_type -> ticket_service.v1.ListTransfersResponse
+	38, // 96: ticket_service.v1.TicketService.GetDrawSummary:output_type -> ticket_service.v1.DrawSummary
+	45, // 97: ticket_service.v1.TicketService.GetNumberStats:output_type -> ticket_service.v1.GetNumberStatsResponse
+	42, // 98: ticket_service.v1.TicketService.ListDrawWinners:output_type -> ticket_service.v1.ListDrawWinnersResponse
+	46, // 99: ticket_service.v1.TicketService.SaveFavourite:output_type -> ticket_service.v1.Favourite
+	48, // 100: ticket_service.v1.TicketService.ListFavourites:output_type -> ticket_service.v1.ListFavouritesResponse
+	61, // 101: ticket_service.v1.TicketService.DeleteFavourite:output_type -> google.protobuf.Empty
+	51, // 102: ticket_service.v1.TicketService.CreateSyndicate:output_type -> ticket_service.v1.Syndicate
+	51, // 103: ticket_service.v1.TicketService.GetSyndicate:output_type -> ticket_service.v1.Syndicate
+	55, // 104: ticket_service.v1.TicketService.ListSyndicates:output_type -> ticket_service.v1.ListSyndicatesResponse
+	51, // 105: ticket_service.v1.TicketService.InviteToSyndicate:output_type -> ticket_service.v1.Syndicate
+	51, // 106: ticket_service.v1.TicketService.AcceptSyndicateInvite:output_type -> ticket_service.v1.Syndicate
+	51, // 107: ticket_service.v1.TicketService.DeclineSyndicateInvite:output_type -> ticket_service.v1.Syndicate
+	51, // 108: ticket_service.v1.TicketService.AddSyndicateTicket:output_type -> ticket_service.v1.Syndicate
+	71, // [71:109] is the sub-list for method output_type
+	33, // [33:71] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
+}
+
+func init() { file_ticket_service_v1_ticket_service_proto_init() }
+func file_ticket_service_v1_ticket_service_proto_init() {
+	if File_ticket_service_v1_ticket_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ticket_service_v1_ticket_service_proto_rawDesc), len(file_ticket_service_v1_ticket_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   57,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ticket_service_v1_ticket_service_proto_goTypes,
+		DependencyIndexes: file_ticket_service_v1_ticket_service_proto_depIdxs,
+		EnumInfos:         file_ticket_service_v1_ticket_service_proto_enumTypes,
+		MessageInfos:      file_ticket_service_v1_ticket_service_proto_msgTypes,
+	}.Build()
+	File_ticket_service_v1_ticket_service_proto = out.File
+	file_ticket_service_v1_ticket_service_proto_goTypes = nil
+	file_ticket_service_v1_ticket_service_proto_depIdxs = nil
+}
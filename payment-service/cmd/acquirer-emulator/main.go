@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/MaxFando/lms/payment-service/internal/client/payment/emulator"
+	"github.com/MaxFando/lms/platform/logger"
+)
+
+func main() {
+	addr := flag.String("addr", ":8090", "адрес HTTP-сервера эмулятора")
+	confirmDelay := flag.Duration("confirm-delay", 2*time.Second, "задержка подтверждения отложенных платежей")
+	flag.Parse()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	log := logger.NewLogger().With("app", "lms", "component", "acquirer-emulator")
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           emulator.New(emulator.Config{ConfirmDelay: *confirmDelay}),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Shutdown(context.Background())
+	}()
+
+	log.Info(ctx, "Эмулятор эквайера запущен", "addr", *addr)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Error(ctx, "Завершение работы эмулятора с ошибкой", "error", err)
+	}
+}
@@ -1,17 +1,55 @@
 package config
 
-import "github.com/spf13/viper"
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
 
 type Config struct {
-	ServiceName      string
-	Env              string
-	LogLevel         string
-	GRPCPort         string
-	DatabaseDSN      string
-	TracerDSN        string
-	RedisDSN         string
-	RedisChannelName string
-	TicketPrice      int64
+	ServiceName       string
+	Env               string
+	LogLevel          string
+	GRPCPort          string
+	DatabaseDSN       string
+	TracerDSN         string
+	RedisDSN          string
+	RedisChannelName  string
+	TicketServiceAddr string
+	JWTSecret         string
+
+	// PaymentGatewayURL - адрес эквайера; пустой адрес запускает встроенный эмулятор
+	PaymentGatewayURL          string
+	PaymentGatewayTimeout      time.Duration
+	PaymentGatewayPollInterval time.Duration
+	EmulatorConfirmDelay       time.Duration
+
+	// PaymentResumeAfter - через сколько незавершённый платёж считается прерванным
+	PaymentResumeAfter    time.Duration
+	PaymentResumeInterval time.Duration
+
+	IdempotencyKeyTTL time.Duration
+	// IdempotencyLockTimeout - через сколько незавершённый запрос можно повторить с тем же ключом.
+	// Не меньше minIdempotencyLockTimeout: ключ не перехватывается, пока платёж по нему может быть в полёте
+	IdempotencyLockTimeout time.Duration
+
+	// OverdueBatchSize - сколько просроченных счетов обрабатывается в одной транзакции
+	OverdueBatchSize int
+	// InvoiceEventsInterval - как часто публикуются события о счетах из outbox
+	InvoiceEventsInterval time.Duration
+
+	// RefundMaxAttempts - сколько раз возврат отправляется эквайеру, прежде чем считается несостоявшимся
+	RefundMaxAttempts   int
+	RefundRetryInterval time.Duration
+
+	// RedisTicketChannel - канал событий сервиса билетов, по которым выплачиваются выигрыши
+	RedisTicketChannel string
+	// PayoutAutoLimit - выигрыш не больше лимита зачисляется сразу, крупный ждёт подтверждения администратора
+	PayoutAutoLimit     int64
+	PayoutRetryInterval time.Duration
+	// PayoutReconcileWindow - за какой период тиражи сверяются с победителями сервиса билетов
+	PayoutReconcileWindow   time.Duration
+	PayoutReconcileInterval time.Duration
 }
 
 func Load() *Config {
@@ -20,15 +58,70 @@ func Load() *Config {
 	viper.AddConfigPath(".")
 	viper.AutomaticEnv()
 
-	return &Config{
-		ServiceName:      viper.GetString("SERVICE_NAME"),
-		Env:              viper.GetString("APP_ENV"),
-		LogLevel:         viper.GetString("LOG_LEVEL"),
-		GRPCPort:         viper.GetString("GRPC_PORT"),
-		DatabaseDSN:      viper.GetString("DATABASE_DSN"),
-		TracerDSN:        viper.GetString("TRACER_DSN"),
-		RedisDSN:         viper.GetString("REDIS_DSN"),
-		RedisChannelName: viper.GetString("REDIS_CHANNEL_NAME"),
-		TicketPrice:      viper.GetInt64("TICKET_PRICE"),
+	viper.SetDefault("PAYMENT_GATEWAY_TIMEOUT", 10*time.Second)
+	viper.SetDefault("PAYMENT_GATEWAY_POLL_INTERVAL", 500*time.Millisecond)
+	viper.SetDefault("PAYMENT_EMULATOR_CONFIRM_DELAY", 2*time.Second)
+	viper.SetDefault("PAYMENT_RESUME_AFTER", time.Minute)
+	viper.SetDefault("PAYMENT_RESUME_INTERVAL", time.Minute)
+	viper.SetDefault("IDEMPOTENCY_KEY_TTL", 24*time.Hour)
+	viper.SetDefault("IDEMPOTENCY_LOCK_TIMEOUT", 5*time.Minute)
+	viper.SetDefault("OVERDUE_BATCH_SIZE", 100)
+	viper.SetDefault("INVOICE_EVENTS_INTERVAL", time.Minute)
+	viper.SetDefault("REFUND_MAX_ATTEMPTS", 5)
+	viper.SetDefault("REFUND_RETRY_INTERVAL", time.Minute)
+	viper.SetDefault("REDIS_TICKET_CHANNEL", "ticket_channel")
+	viper.SetDefault("PAYOUT_AUTO_LIMIT", 10000)
+	viper.SetDefault("PAYOUT_RETRY_INTERVAL", time.Minute)
+	viper.SetDefault("PAYOUT_RECONCILE_WINDOW", 7*24*time.Hour)
+	viper.SetDefault("PAYOUT_RECONCILE_INTERVAL", time.Hour)
+
+	cfg := &Config{
+		ServiceName:       viper.GetString("SERVICE_NAME"),
+		Env:               viper.GetString("APP_ENV"),
+		LogLevel:          viper.GetString("LOG_LEVEL"),
+		GRPCPort:          viper.GetString("GRPC_PORT"),
+		DatabaseDSN:       viper.GetString("DATABASE_DSN"),
+		TracerDSN:         viper.GetString("TRACER_DSN"),
+		RedisDSN:          viper.GetString("REDIS_DSN"),
+		RedisChannelName:  viper.GetString("REDIS_CHANNEL_NAME"),
+		TicketServiceAddr: viper.GetString("TICKET_SERVICE_ADDR"),
+		JWTSecret:         viper.GetString("JWT_SECRET"),
+
+		PaymentGatewayURL:          viper.GetString("PAYMENT_GATEWAY_URL"),
+		PaymentGatewayTimeout:      viper.GetDuration("PAYMENT_GATEWAY_TIMEOUT"),
+		PaymentGatewayPollInterval: viper.GetDuration("PAYMENT_GATEWAY_POLL_INTERVAL"),
+		EmulatorConfirmDelay:       viper.GetDuration("PAYMENT_EMULATOR_CONFIRM_DELAY"),
+
+		PaymentResumeAfter:    viper.GetDuration("PAYMENT_RESUME_AFTER"),
+		PaymentResumeInterval: viper.GetDuration("PAYMENT_RESUME_INTERVAL"),
+
+		IdempotencyKeyTTL:      viper.GetDuration("IDEMPOTENCY_KEY_TTL"),
+		IdempotencyLockTimeout: viper.GetDuration("IDEMPOTENCY_LOCK_TIMEOUT"),
+
+		OverdueBatchSize:      viper.GetInt("OVERDUE_BATCH_SIZE"),
+		InvoiceEventsInterval: viper.GetDuration("INVOICE_EVENTS_INTERVAL"),
+
+		RefundMaxAttempts:   viper.GetInt("REFUND_MAX_ATTEMPTS"),
+		RefundRetryInterval: viper.GetDuration("REFUND_RETRY_INTERVAL"),
+
+		RedisTicketChannel:  viper.GetString("REDIS_TICKET_CHANNEL"),
+		PayoutAutoLimit:     viper.GetInt64("PAYOUT_AUTO_LIMIT"),
+		PayoutRetryInterval: viper.GetDuration("PAYOUT_RETRY_INTERVAL"),
+
+		PayoutReconcileWindow:   viper.GetDuration("PAYOUT_RECONCILE_WINDOW"),
+		PayoutReconcileInterval: viper.GetDuration("PAYOUT_RECONCILE_INTERVAL"),
 	}
+
+	if lock := cfg.minIdempotencyLockTimeout(); cfg.IdempotencyLockTimeout < lock {
+		cfg.IdempotencyLockTimeout = lock
+	}
+
+	return cfg
+}
+
+// minIdempotencyLockTimeout - сколько может идти платёж: авторизация и списание ограничены
+// PaymentGatewayTimeout каждое, а прерванный платёж ResumePayments подхватывает через
+// PaymentResumeAfter и доводит в ближайший запуск
+func (c *Config) minIdempotencyLockTimeout() time.Duration {
+	return 2*c.PaymentGatewayTimeout + c.PaymentResumeAfter + c.PaymentResumeInterval
 }
@@ -8,27 +8,36 @@ import (
 
 	"github.com/MaxFando/lms/payment-service/config"
 	"github.com/MaxFando/lms/payment-service/internal/client/payment"
+	"github.com/MaxFando/lms/payment-service/internal/client/payment/emulator"
 	"github.com/MaxFando/lms/payment-service/internal/client/ticket"
+	"github.com/MaxFando/lms/payment-service/internal/events"
 	"github.com/MaxFando/lms/payment-service/internal/repository/postgres"
 	"github.com/MaxFando/lms/payment-service/internal/repository/redis"
 	"github.com/MaxFando/lms/payment-service/internal/server"
 	v1 "github.com/MaxFando/lms/payment-service/internal/server/service/v1"
 	"github.com/MaxFando/lms/payment-service/internal/service"
-	"github.com/MaxFando/lms/payment-service/pkg/scheduler"
+	"github.com/MaxFando/lms/platform/auth"
 	"github.com/MaxFando/lms/platform/closer"
 	"github.com/MaxFando/lms/platform/logger"
+	"github.com/MaxFando/lms/platform/scheduler"
 	"github.com/MaxFando/lms/platform/sqlext"
 	"github.com/MaxFando/lms/platform/tracer"
 	"github.com/jmoiron/sqlx"
+	goredis "github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type App struct {
-	logger    logger.Logger
-	config    *config.Config
-	database  *sqlx.DB
-	publisher *redis.Publisher
-	service   *service.Service
-	srv       *server.Server
+	logger     logger.Logger
+	config     *config.Config
+	database   *sqlx.DB
+	publisher  *redis.Publisher
+	subscriber *goredis.Client
+	ticketConn *grpc.ClientConn
+	payer      *payment.Client
+	service    *service.Service
+	srv        *server.Server
 }
 
 func New(cfg *config.Config) *App {
@@ -59,6 +68,18 @@ func (a *App) Init(ctx context.Context) error {
 		return fmt.Errorf("ошибка при инициализации подключения к продюсеру: %w", err)
 	}
 
+	if err := a.initSubscriberConnection(ctx); err != nil {
+		return fmt.Errorf("ошибка при инициализации подключения к событиям сервиса билетов: %w", err)
+	}
+
+	if err := a.initTicketServiceConnection(ctx); err != nil {
+		return fmt.Errorf("ошибка при инициализации подключения к сервису билетов: %w", err)
+	}
+
+	if err := a.initPaymentGateway(ctx); err != nil {
+		return fmt.Errorf("ошибка при инициализации платёжного шлюза: %w", err)
+	}
+
 	if err := a.initLogicProviders(ctx); err != nil {
 		return fmt.Errorf("ошибка при инициализации сервиса бизнес логики: %w", err)
 	}
@@ -70,7 +91,8 @@ func (a *App) Init(ctx context.Context) error {
 
 func (a *App) Run(ctx context.Context) error {
 	serviceServer := v1.NewServer(a.service)
-	srv := server.NewServer(a.logger, serviceServer)
+	idempotency := postgres.NewIdempotencyRepository(a.database, a.config.IdempotencyKeyTTL, a.config.IdempotencyLockTimeout)
+	srv := server.NewServer(a.logger, serviceServer, a.config.JWTSecret, idempotency)
 
 	go func() {
 		srv.Serve(ctx)
@@ -83,6 +105,40 @@ func (a *App) Run(ctx context.Context) error {
 		errChan <- scheduler.Schedule(ctx, a.service.ProcessInvoices, time.Hour)
 	}()
 
+	go func() {
+		errChan <- scheduler.Schedule(ctx, a.service.PublishInvoiceEvents, a.config.InvoiceEventsInterval)
+	}()
+
+	go func() {
+		errChan <- scheduler.Schedule(ctx, a.service.ResumePayments, a.config.PaymentResumeInterval)
+	}()
+
+	go func() {
+		errChan <- scheduler.Schedule(ctx, a.service.RetryRefunds, a.config.RefundRetryInterval)
+	}()
+
+	go func() {
+		errChan <- scheduler.Schedule(ctx, a.service.RetryPayouts, a.config.PayoutRetryInterval)
+	}()
+
+	go func() {
+		errChan <- scheduler.Schedule(ctx, a.service.ReconcilePayouts, a.config.PayoutReconcileInterval)
+	}()
+
+	ticketHandler := events.NewTicketEventHandler(a.subscriber, a.service, a.config.RedisTicketChannel)
+	go func() {
+		errChan <- ticketHandler.Run(ctx)
+	}()
+
+	go func() {
+		errChan <- scheduler.Schedule(ctx, func(ctx context.Context) error {
+			if err := idempotency.DeleteExpired(ctx); err != nil {
+				a.logger.Error(ctx, "Ошибка при удалении устаревших ключей идемпотентности", "error", err)
+			}
+			return nil
+		}, time.Hour)
+	}()
+
 	select {
 	case s := <-srv.Notify():
 		return fmt.Errorf("ошибка сервера: %w", s)
@@ -177,10 +233,80 @@ func (a *App) initPublisherConnection(ctx context.Context) error {
 	return nil
 }
 
+func (a *App) initSubscriberConnection(ctx context.Context) error {
+	opt, err := goredis.ParseURL(a.config.RedisDSN)
+	if err != nil {
+		return fmt.Errorf("ошибка при разборе адреса Redis: %w", err)
+	}
+
+	rdb := goredis.NewClient(opt)
+	a.subscriber = rdb
+	closer.Add(func() error {
+		if err := rdb.Close(); err != nil {
+			return fmt.Errorf("ошибка при закрытии подключения к событиям сервиса билетов: %w", err)
+		}
+
+		return nil
+	})
+
+	a.logger.Info(ctx, "Подключение к событиям сервиса билетов успешно установлено")
+
+	return nil
+}
+
+func (a *App) initTicketServiceConnection(ctx context.Context) error {
+	conn, err := grpc.NewClient(a.config.TicketServiceAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(auth.NewServiceCredentials(a.config.JWTSecret, "payment-service")),
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка при создании клиента сервиса билетов: %w", err)
+	}
+
+	a.ticketConn = conn
+	closer.Add(func() error {
+		if err := conn.Close(); err != nil {
+			return fmt.Errorf("ошибка при закрытии подключения к сервису билетов: %w", err)
+		}
+
+		return nil
+	})
+
+	a.logger.Info(ctx, "Клиент сервиса билетов инициализирован")
+
+	return nil
+}
+
+func (a *App) initPaymentGateway(ctx context.Context) error {
+	gatewayURL := a.config.PaymentGatewayURL
+	if gatewayURL == "" {
+		srv, url, err := emulator.Start("127.0.0.1:0", emulator.Config{ConfirmDelay: a.config.EmulatorConfirmDelay})
+		if err != nil {
+			return fmt.Errorf("ошибка при запуске эмулятора эквайера: %w", err)
+		}
+		closer.Add(func() error {
+			return srv.Shutdown(ctx)
+		})
+
+		gatewayURL = url
+		a.logger.Info(ctx, "Адрес эквайера не задан, платежи проводит встроенный эмулятор", "url", gatewayURL)
+	}
+
+	a.payer = payment.New(payment.Config{
+		BaseURL:      gatewayURL,
+		Timeout:      a.config.PaymentGatewayTimeout,
+		PollInterval: a.config.PaymentGatewayPollInterval,
+	})
+
+	a.logger.Info(ctx, "Платёжный шлюз инициализирован")
+
+	return nil
+}
+
 func (a *App) initLogicProviders(_ context.Context) error {
 	a.service = service.New(
-		ticket.New(),
-		payment.New(),
+		ticket.New(a.ticketConn),
+		a.payer,
 		postgres.New(a.database),
 		a.publisher,
 		a.config,
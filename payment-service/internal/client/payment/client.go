@@ -1,38 +1,190 @@
 package payment
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
-	"math/rand"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/MaxFando/lms/payment-service/internal/entity"
+	"github.com/shopspring/decimal"
 )
 
+// errPaymentNotFound - эквайер не знает запрошенный платёж
+var errPaymentNotFound = errors.New("payment not found")
+
+type Config struct {
+	// BaseURL - адрес API эквайера
+	BaseURL string
+	// Timeout ограничивает платёж целиком, включая ожидание отложенного подтверждения
+	Timeout      time.Duration
+	PollInterval time.Duration
+}
+
+// Client - адаптер платёжного шлюза, работающий по HTTP-протоколу эквайера;
+// локально тот же протокол реализует пакет emulator
 type Client struct {
+	baseURL      string
+	http         *http.Client
+	timeout      time.Duration
+	pollInterval time.Duration
 }
 
-func New() *Client {
-	return &Client{}
+func New(cfg Config) *Client {
+	return &Client{
+		baseURL:      cfg.BaseURL,
+		http:         &http.Client{},
+		timeout:      cfg.Timeout,
+		pollInterval: cfg.PollInterval,
+	}
 }
 
-func (c *Client) Pay(_ context.Context, card *entity.Card) (int64, error) {
+// Authorize блокирует amount на карте и возвращает идентификатор платежа эквайера.
+// reference - идентификатор платежа на нашей стороне: повтор с тем же reference не заблокирует
+// сумму второй раз, а потерянную авторизацию можно найти через FindAuthorization.
+// Отказ эквайера оборачивает entity.ErrPaymentDeclined с кодом отказа, отсутствие
+// ответа или подтверждения за Timeout - entity.ErrPaymentTimeout.
+func (c *Client) Authorize(ctx context.Context, reference string, card *entity.Card, amount decimal.Decimal) (int64, error) {
 	if card == nil {
 		return 0, errors.New("card is nil")
 	}
 
-	transactionID := rand.Int63()
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var resp PaymentResponse
+	err := c.do(ctx, http.MethodPost, "/v1/payments", PaymentRequest{
+		CardNumber: card.Number,
+		ExpDate:    card.ExpDate,
+		CVV:        card.CVV,
+		Amount:     amount.StringFixed(2),
+		Reference:  reference,
+	}, &resp)
+	if err != nil {
+		return 0, err
+	}
+
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+	for resp.Status == StatusPending {
+		select {
+		case <-ctx.Done():
+			return 0, fmt.Errorf("payment %d is still pending: %w", resp.ID, entity.ErrPaymentTimeout)
+		case <-ticker.C:
+		}
+		if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/v1/payments/%d", resp.ID), nil, &resp); err != nil {
+			return 0, err
+		}
+	}
+
+	switch resp.Status {
+	case StatusAuthorized:
+		return resp.ID, nil
+	case StatusDeclined:
+		return 0, fmt.Errorf("%w: %s", entity.ErrPaymentDeclined, resp.DeclineCode)
+	default:
+		return 0, fmt.Errorf("unexpected payment status %q", resp.Status)
+	}
+}
+
+// FindAuthorization ищет платёж по reference и возвращает его идентификатор, если сумма заблокирована.
+// Если платежа нет или в нём отказано - entity.ErrAuthorizationNotFound, если эквайер ещё
+// подтверждает авторизацию - entity.ErrPaymentTimeout.
+func (c *Client) FindAuthorization(ctx context.Context, reference string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var resp PaymentResponse
+	err := c.do(ctx, http.MethodGet, "/v1/payments?reference="+url.QueryEscape(reference), nil, &resp)
+	if errors.Is(err, errPaymentNotFound) {
+		return 0, fmt.Errorf("payment %s: %w", reference, entity.ErrAuthorizationNotFound)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	switch resp.Status {
+	case StatusAuthorized:
+		return resp.ID, nil
+	case StatusPending:
+		return 0, fmt.Errorf("payment %d is still pending: %w", resp.ID, entity.ErrPaymentTimeout)
+	default:
+		return 0, fmt.Errorf("payment %d is %s: %w", resp.ID, resp.Status, entity.ErrAuthorizationNotFound)
+	}
+}
+
+// Capture списывает авторизованную сумму
+func (c *Client) Capture(ctx context.Context, transactionID int64) error {
+	return c.action(ctx, transactionID, "capture")
+}
 
-	if card.CVV == "123" || rand.Intn(10) < 8 {
-		return transactionID, nil
+// Void отменяет авторизацию, не списывая деньги
+func (c *Client) Void(ctx context.Context, transactionID int64) error {
+	return c.action(ctx, transactionID, "void")
+}
+
+// Refund возвращает amount из списанной суммы. reference - идентификатор возврата на нашей
+// стороне: повтор с тем же reference не вернёт деньги второй раз.
+func (c *Client) Refund(ctx context.Context, transactionID int64, reference string, amount decimal.Decimal) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var resp RefundResponse
+	err := c.do(ctx, http.MethodPost, fmt.Sprintf("/v1/payments/%d/refunds", transactionID), RefundRequest{
+		Amount:    amount.StringFixed(2),
+		Reference: reference,
+	}, &resp)
+	if err != nil {
+		return 0, err
 	}
+	return resp.ID, nil
+}
 
-	return 0, errors.New("payment failure")
+func (c *Client) action(ctx context.Context, transactionID int64, action string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var resp PaymentResponse
+	return c.do(ctx, http.MethodPost, fmt.Sprintf("/v1/payments/%d/%s", transactionID, action), nil, &resp)
 }
 
-func (c *Client) Refund(_ context.Context, _ int64) error {
-	if rand.Intn(10) < 8 {
-		return nil
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	var payload bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&payload).Encode(body); err != nil {
+			return fmt.Errorf("encode request: %w", err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, &payload)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("%s %s: %w", method, path, entity.ErrPaymentTimeout)
+		}
+		return fmt.Errorf("%s %s: %w", method, path, err)
 	}
+	defer resp.Body.Close()
 
-	return errors.New("payment failure")
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%s %s: %w", method, path, errPaymentNotFound)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var e ErrorResponse
+		_ = json.NewDecoder(resp.Body).Decode(&e)
+		return fmt.Errorf("%s %s: gateway responded %d: %s", method, path, resp.StatusCode, e.Error)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
 }
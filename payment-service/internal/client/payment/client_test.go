@@ -0,0 +1,176 @@
+package payment_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MaxFando/lms/payment-service/internal/client/payment"
+	"github.com/MaxFando/lms/payment-service/internal/client/payment/emulator"
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient(t *testing.T, cfg emulator.Config) *payment.Client {
+	t.Helper()
+
+	srv := httptest.NewServer(emulator.New(cfg))
+	t.Cleanup(srv.Close)
+
+	return payment.New(payment.Config{
+		BaseURL:      srv.URL,
+		Timeout:      200 * time.Millisecond,
+		PollInterval: 5 * time.Millisecond,
+	})
+}
+
+var amount = decimal.NewFromInt(200)
+
+func card(number string) *entity.Card {
+	return &entity.Card{Number: number, ExpDate: "12/99", CVV: "321"}
+}
+
+func TestClient_Authorize(t *testing.T) {
+	tests := []struct {
+		name    string
+		number  string
+		wantErr error
+		code    string
+	}{
+		{name: "approved", number: "4242424242424242"},
+		{name: "declined", number: "4000000000000002", wantErr: entity.ErrPaymentDeclined, code: "card_declined"},
+		{name: "insufficient funds", number: "4000000000009995", wantErr: entity.ErrPaymentDeclined, code: "insufficient_funds"},
+		{name: "timeout", number: "4000000000000119", wantErr: entity.ErrPaymentTimeout},
+		{name: "delayed approval", number: "4000000000003220"},
+		{name: "delayed decline", number: "4000000000003238", wantErr: entity.ErrPaymentDeclined, code: "card_declined"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newClient(t, emulator.Config{ConfirmDelay: 20 * time.Millisecond})
+
+			id, err := client.Authorize(context.Background(), "payment-1", card(tt.number), amount)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Contains(t, err.Error(), tt.code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Positive(t, id)
+		})
+	}
+}
+
+func TestClient_AuthorizePendingTooLong(t *testing.T) {
+	client := newClient(t, emulator.Config{ConfirmDelay: time.Hour})
+
+	_, err := client.Authorize(context.Background(), "payment-1", card("4000000000003220"), amount)
+	require.ErrorIs(t, err, entity.ErrPaymentTimeout)
+}
+
+func TestClient_CustomCard(t *testing.T) {
+	client := newClient(t, emulator.Config{Cards: map[string]emulator.Behaviour{
+		"5555555555554444": {Outcome: emulator.OutcomeDecline, DeclineCode: "do_not_honor"},
+	}})
+
+	_, err := client.Authorize(context.Background(), "payment-1", card("5555555555554444"), amount)
+	require.ErrorIs(t, err, entity.ErrPaymentDeclined)
+	assert.Contains(t, err.Error(), "do_not_honor")
+}
+
+func TestClient_CaptureAndRefund(t *testing.T) {
+	client := newClient(t, emulator.Config{})
+	ctx := context.Background()
+
+	id, err := client.Authorize(ctx, "payment-1", card("4242424242424242"), amount)
+	require.NoError(t, err)
+
+	_, err = client.Refund(ctx, id, "r1", amount)
+	assert.Error(t, err, "authorization is not captured yet")
+	require.NoError(t, client.Capture(ctx, id))
+	require.NoError(t, client.Capture(ctx, id), "capture is idempotent")
+	assert.Error(t, client.Void(ctx, id), "captured payment cannot be voided")
+
+	_, err = client.Refund(ctx, id+100, "r1", amount)
+	assert.Error(t, err, "unknown payment")
+
+	first, err := client.Refund(ctx, id, "r1", decimal.NewFromInt(150))
+	require.NoError(t, err)
+	again, err := client.Refund(ctx, id, "r1", decimal.NewFromInt(150))
+	require.NoError(t, err)
+	assert.Equal(t, first, again, "refund is idempotent by reference")
+
+	_, err = client.Refund(ctx, id, "r2", decimal.NewFromInt(51))
+	assert.Error(t, err, "refund exceeds captured amount")
+	_, err = client.Refund(ctx, id, "r2", decimal.NewFromInt(50))
+	require.NoError(t, err)
+}
+
+func TestClient_RefundFails(t *testing.T) {
+	client := newClient(t, emulator.Config{})
+	ctx := context.Background()
+
+	id, err := client.Authorize(ctx, "payment-1", card("4000000000005126"), amount)
+	require.NoError(t, err)
+	require.NoError(t, client.Capture(ctx, id))
+
+	_, err = client.Refund(ctx, id, "r1", amount)
+	assert.Error(t, err)
+}
+
+func TestClient_Void(t *testing.T) {
+	client := newClient(t, emulator.Config{})
+	ctx := context.Background()
+
+	id, err := client.Authorize(ctx, "payment-1", card("4242424242424242"), amount)
+	require.NoError(t, err)
+
+	require.NoError(t, client.Void(ctx, id))
+	require.NoError(t, client.Void(ctx, id), "void is idempotent")
+	assert.Error(t, client.Capture(ctx, id), "voided payment cannot be captured")
+}
+
+func TestClient_AuthorizeIdempotent(t *testing.T) {
+	client := newClient(t, emulator.Config{})
+	ctx := context.Background()
+
+	first, err := client.Authorize(ctx, "payment-1", card("4242424242424242"), amount)
+	require.NoError(t, err)
+	again, err := client.Authorize(ctx, "payment-1", card("4242424242424242"), amount)
+	require.NoError(t, err)
+	assert.Equal(t, first, again, "authorization is idempotent by reference")
+
+	other, err := client.Authorize(ctx, "payment-2", card("4242424242424242"), amount)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, other)
+}
+
+func TestClient_FindAuthorization(t *testing.T) {
+	client := newClient(t, emulator.Config{ConfirmDelay: time.Hour})
+	ctx := context.Background()
+
+	_, err := client.Authorize(ctx, "lost", card("4000000000000119"), amount)
+	require.ErrorIs(t, err, entity.ErrPaymentTimeout)
+
+	id, err := client.FindAuthorization(ctx, "lost")
+	require.NoError(t, err, "timed out authorization is found by reference")
+	require.NoError(t, client.Void(ctx, id))
+
+	_, err = client.FindAuthorization(ctx, "lost")
+	assert.ErrorIs(t, err, entity.ErrAuthorizationNotFound, "voided authorization holds nothing")
+
+	_, err = client.FindAuthorization(ctx, "unknown")
+	assert.ErrorIs(t, err, entity.ErrAuthorizationNotFound)
+
+	_, err = client.Authorize(ctx, "declined", card("4000000000000002"), amount)
+	require.ErrorIs(t, err, entity.ErrPaymentDeclined)
+	_, err = client.FindAuthorization(ctx, "declined")
+	assert.ErrorIs(t, err, entity.ErrAuthorizationNotFound)
+
+	_, err = client.Authorize(ctx, "pending", card("4000000000003220"), amount)
+	require.ErrorIs(t, err, entity.ErrPaymentTimeout)
+	_, err = client.FindAuthorization(ctx, "pending")
+	assert.ErrorIs(t, err, entity.ErrPaymentTimeout, "authorization is still pending")
+}
@@ -0,0 +1,300 @@
+// Package emulator - локальный эквайер для разработки и тестов. Исход платежа
+// детерминированно определяется номером тестовой карты, остальные карты одобряются.
+package emulator
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/MaxFando/lms/payment-service/internal/client/payment"
+	"github.com/shopspring/decimal"
+)
+
+type Outcome string
+
+const (
+	OutcomeApprove Outcome = "approve"
+	OutcomeDecline Outcome = "decline"
+	// OutcomeTimeout - эквайер авторизует платёж, но не отвечает, пока клиент не оборвёт запрос
+	OutcomeTimeout Outcome = "timeout"
+	// OutcomeDelayed - авторизация сначала в обработке и подтверждается через Config.ConfirmDelay
+	OutcomeDelayed Outcome = "delayed"
+)
+
+type Behaviour struct {
+	Outcome Outcome
+	// DeclineCode - код отказа; для OutcomeDelayed означает отказ после ожидания
+	DeclineCode string
+	// FailRefunds - эквайер отвечает ошибкой на любой возврат по платежу
+	FailRefunds bool
+}
+
+// TestCards - тестовые карты эмулятора
+var TestCards = map[string]Behaviour{
+	"4000000000000002": {Outcome: OutcomeDecline, DeclineCode: "card_declined"},
+	"4000000000009995": {Outcome: OutcomeDecline, DeclineCode: "insufficient_funds"},
+	"4000000000000069": {Outcome: OutcomeDecline, DeclineCode: "expired_card"},
+	"4000000000000127": {Outcome: OutcomeDecline, DeclineCode: "incorrect_cvc"},
+	"4000000000000119": {Outcome: OutcomeTimeout},
+	"4000000000003220": {Outcome: OutcomeDelayed},
+	"4000000000003238": {Outcome: OutcomeDelayed, DeclineCode: "card_declined"},
+	"4000000000005126": {Outcome: OutcomeApprove, FailRefunds: true},
+}
+
+type Config struct {
+	ConfirmDelay time.Duration
+	// Cards дополняет и переопределяет TestCards
+	Cards map[string]Behaviour
+}
+
+type transaction struct {
+	payment.PaymentResponse
+	final       payment.PaymentResponse
+	confirmAt   time.Time
+	amount      decimal.Decimal
+	refunded    decimal.Decimal
+	failRefunds bool
+	refunds     map[string]payment.RefundResponse
+}
+
+type Emulator struct {
+	cards        map[string]Behaviour
+	confirmDelay time.Duration
+	mux          *http.ServeMux
+
+	mu         sync.Mutex
+	nextID     int64
+	payments   map[int64]*transaction
+	references map[string]*transaction
+	refundID   int64
+
+	nowFunc func() time.Time
+}
+
+func New(cfg Config) *Emulator {
+	cards := make(map[string]Behaviour, len(TestCards)+len(cfg.Cards))
+	for number, b := range TestCards {
+		cards[number] = b
+	}
+	for number, b := range cfg.Cards {
+		cards[number] = b
+	}
+
+	e := &Emulator{
+		cards:        cards,
+		confirmDelay: cfg.ConfirmDelay,
+		mux:          http.NewServeMux(),
+		payments:     make(map[int64]*transaction),
+		references:   make(map[string]*transaction),
+		nowFunc:      time.Now,
+	}
+	e.mux.HandleFunc("POST /v1/payments", e.handlePay)
+	e.mux.HandleFunc("GET /v1/payments", e.handleFind)
+	e.mux.HandleFunc("GET /v1/payments/{id}", e.handleGet)
+	e.mux.HandleFunc("POST /v1/payments/{id}/capture", e.transition(payment.StatusAuthorized, payment.StatusCaptured))
+	e.mux.HandleFunc("POST /v1/payments/{id}/void", e.transition(payment.StatusAuthorized, payment.StatusVoided))
+	e.mux.HandleFunc("POST /v1/payments/{id}/refunds", e.handleRefund)
+	return e
+}
+
+func (e *Emulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.mux.ServeHTTP(w, r)
+}
+
+// handlePay авторизует платёж; повтор с тем же reference возвращает уже созданный платёж
+func (e *Emulator) handlePay(w http.ResponseWriter, r *http.Request) {
+	var req payment.PaymentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Reference == "" {
+		writeJSON(w, http.StatusBadRequest, payment.ErrorResponse{Error: "invalid payment request"})
+		return
+	}
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil || !amount.IsPositive() {
+		writeJSON(w, http.StatusBadRequest, payment.ErrorResponse{Error: "invalid amount"})
+		return
+	}
+
+	b, ok := e.cards[req.CardNumber]
+	if !ok {
+		b = Behaviour{Outcome: OutcomeApprove}
+	}
+
+	e.mu.Lock()
+	if p, ok := e.references[req.Reference]; ok {
+		resp := p.PaymentResponse
+		e.mu.Unlock()
+		writeJSON(w, http.StatusOK, resp)
+		return
+	}
+	e.nextID++
+	p := &transaction{
+		PaymentResponse: payment.PaymentResponse{ID: e.nextID},
+		amount:          amount,
+		failRefunds:     b.FailRefunds,
+		refunds:         make(map[string]payment.RefundResponse),
+	}
+	switch b.Outcome {
+	case OutcomeDecline:
+		p.Status, p.DeclineCode = payment.StatusDeclined, b.DeclineCode
+	case OutcomeDelayed:
+		p.Status = payment.StatusPending
+		p.final = payment.PaymentResponse{ID: p.ID, Status: payment.StatusAuthorized}
+		if b.DeclineCode != "" {
+			p.final = payment.PaymentResponse{ID: p.ID, Status: payment.StatusDeclined, DeclineCode: b.DeclineCode}
+		}
+		p.confirmAt = e.nowFunc().Add(e.confirmDelay)
+	default:
+		p.Status = payment.StatusAuthorized
+	}
+	e.payments[p.ID] = p
+	e.references[req.Reference] = p
+	resp := p.PaymentResponse
+	e.mu.Unlock()
+
+	if b.Outcome == OutcomeTimeout {
+		<-r.Context().Done()
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// handleFind находит платёж по reference из запроса
+func (e *Emulator) handleFind(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	p, ok := e.references[r.URL.Query().Get("reference")]
+	if !ok {
+		writeJSON(w, http.StatusNotFound, payment.ErrorResponse{Error: "payment not found"})
+		return
+	}
+	e.confirm(p)
+	writeJSON(w, http.StatusOK, p.PaymentResponse)
+}
+
+func (e *Emulator) handleGet(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	p, ok := e.lookup(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, p.PaymentResponse)
+}
+
+// transition переводит платёж из from в to; повторный перевод в to идемпотентен
+func (e *Emulator) transition(from, to string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+
+		p, ok := e.lookup(w, r)
+		if !ok {
+			return
+		}
+		switch p.Status {
+		case to:
+		case from:
+			p.Status = to
+		default:
+			writeJSON(w, http.StatusConflict, payment.ErrorResponse{Error: "payment is " + p.Status})
+			return
+		}
+		writeJSON(w, http.StatusOK, p.PaymentResponse)
+	}
+}
+
+// handleRefund возвращает часть списанной суммы; платёж становится refunded, когда возвращена вся сумма
+func (e *Emulator) handleRefund(w http.ResponseWriter, r *http.Request) {
+	var req payment.RefundRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Reference == "" {
+		writeJSON(w, http.StatusBadRequest, payment.ErrorResponse{Error: "invalid refund request"})
+		return
+	}
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil || !amount.IsPositive() {
+		writeJSON(w, http.StatusBadRequest, payment.ErrorResponse{Error: "invalid amount"})
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	p, ok := e.lookup(w, r)
+	if !ok {
+		return
+	}
+	if refund, ok := p.refunds[req.Reference]; ok {
+		writeJSON(w, http.StatusOK, refund)
+		return
+	}
+	if p.failRefunds {
+		writeJSON(w, http.StatusBadGateway, payment.ErrorResponse{Error: "refund processing failed"})
+		return
+	}
+	if p.Status != payment.StatusCaptured {
+		writeJSON(w, http.StatusConflict, payment.ErrorResponse{Error: "payment is " + p.Status})
+		return
+	}
+	if p.refunded.Add(amount).GreaterThan(p.amount) {
+		writeJSON(w, http.StatusConflict, payment.ErrorResponse{Error: "refund exceeds captured amount"})
+		return
+	}
+
+	e.refundID++
+	refund := payment.RefundResponse{ID: e.refundID, PaymentID: p.ID, Amount: amount.StringFixed(2)}
+	p.refunds[req.Reference] = refund
+	p.refunded = p.refunded.Add(amount)
+	if p.refunded.Equal(p.amount) {
+		p.Status = payment.StatusRefunded
+	}
+	writeJSON(w, http.StatusOK, refund)
+}
+
+// lookup находит платёж из пути запроса и подтверждает отложенный, если его время пришло; вызывается под e.mu
+func (e *Emulator) lookup(w http.ResponseWriter, r *http.Request) (*transaction, bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, payment.ErrorResponse{Error: "invalid payment id"})
+		return nil, false
+	}
+	p, ok := e.payments[id]
+	if !ok {
+		writeJSON(w, http.StatusNotFound, payment.ErrorResponse{Error: "payment not found"})
+		return nil, false
+	}
+	e.confirm(p)
+	return p, true
+}
+
+// confirm подтверждает отложенный платёж, если его время пришло; вызывается под e.mu
+func (e *Emulator) confirm(p *transaction) {
+	if p.Status == payment.StatusPending && !e.nowFunc().Before(p.confirmAt) {
+		p.PaymentResponse = p.final
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// Start запускает эмулятор в фоне на addr и возвращает сервер и его базовый URL
+func Start(addr string, cfg Config) (*http.Server, string, error) {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, "", err
+	}
+
+	srv := &http.Server{Handler: New(cfg), ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		_ = srv.Serve(l)
+	}()
+	return srv, "http://" + l.Addr().String(), nil
+}
@@ -0,0 +1,56 @@
+package payment
+
+// HTTP-протокол эквайера:
+//   POST /v1/payments              - авторизация (блокировка суммы на карте)
+//   GET  /v1/payments?reference=   - состояние платежа по reference
+//   GET  /v1/payments/{id}         - состояние платежа
+//   POST /v1/payments/{id}/capture - списание авторизованной суммы
+//   POST /v1/payments/{id}/void    - отмена авторизации
+//   POST /v1/payments/{id}/refunds - возврат всей или части списанной суммы
+// Авторизация идемпотентна по reference: повтор с тем же reference возвращает уже созданный платёж,
+// а если ответ на авторизацию потерян, платёж можно найти по reference и отменить.
+// capture и void идемпотентны: повтор для уже списанного или отменённого платежа успешен.
+// Возврат идемпотентен по reference: повтор с тем же reference возвращает уже проведённый возврат.
+
+// Статусы платежа в протоколе эквайера
+const (
+	StatusPending    = "pending"
+	StatusAuthorized = "authorized"
+	StatusDeclined   = "declined"
+	StatusCaptured   = "captured"
+	StatusVoided     = "voided"
+	StatusRefunded   = "refunded"
+)
+
+// PaymentRequest - тело POST /v1/payments
+type PaymentRequest struct {
+	CardNumber string `json:"card_number"`
+	ExpDate    string `json:"exp_date"`
+	CVV        string `json:"cvv"`
+	Amount     string `json:"amount"`
+	Reference  string `json:"reference"`
+}
+
+// PaymentResponse - состояние платежа в ответах эквайера
+type PaymentResponse struct {
+	ID          int64  `json:"id"`
+	Status      string `json:"status"`
+	DeclineCode string `json:"decline_code,omitempty"`
+}
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// RefundRequest - тело POST /v1/payments/{id}/refunds
+type RefundRequest struct {
+	Amount    string `json:"amount"`
+	Reference string `json:"reference"`
+}
+
+// RefundResponse - проведённый эквайером возврат
+type RefundResponse struct {
+	ID        int64  `json:"id"`
+	PaymentID int64  `json:"payment_id"`
+	Amount    string `json:"amount"`
+}
@@ -2,19 +2,96 @@ package ticket
 
 import (
 	"context"
+	"fmt"
 
+	ticketservicev1 "github.com/MaxFando/lms/payment-service/api/grpc/gen/go/ticket-service/v1"
 	"github.com/MaxFando/lms/payment-service/internal/entity"
+	"github.com/shopspring/decimal"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc"
 )
 
 type Client struct {
+	api ticketservicev1.TicketServiceClient
 }
 
-func New() *Client {
-	return &Client{}
+func New(conn grpc.ClientConnInterface) *Client {
+	return &Client{
+		api: ticketservicev1.NewTicketServiceClient(conn),
+	}
 }
 
-func (c *Client) BookTicket(_ context.Context, _ int64, ticketID int64) (*entity.Ticket, error) {
+// BookTicket бронирует свободный билет за пользователем под выставляемый счёт
+func (c *Client) BookTicket(ctx context.Context, userID int64, ticketID int64) (*entity.Ticket, error) {
+	resp, err := c.api.BookTicketInternal(ctx, &ticketservicev1.BookTicketRequest{
+		TicketId: int32(ticketID),
+		UserId:   int32(userID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("book ticket: %w", err)
+	}
+
 	return &entity.Ticket{
-		ID: ticketID,
+		ID: int64(resp.GetTicketId()),
 	}, nil
 }
+
+// ReleaseTickets снимает бронь с билетов, счёт на которые выставить не удалось
+func (c *Client) ReleaseTickets(ctx context.Context, ticketIDs []int64) error {
+	ids := make([]int32, len(ticketIDs))
+	for i, id := range ticketIDs {
+		ids[i] = int32(id)
+	}
+
+	_, err := c.api.ReleaseTicketsInternal(ctx, &ticketservicev1.ReleaseTicketsRequest{
+		TicketIds: ids,
+	})
+	if err != nil {
+		return fmt.Errorf("release tickets: %w", err)
+	}
+
+	return nil
+}
+
+// ListDrawWinners возвращает выплаты, причитающиеся по выигравшим билетам тиража. Приз билета синдиката
+// делится на доли участников; пока он не распределён, выплат по билету нет
+func (c *Client) ListDrawWinners(ctx context.Context, drawID int64) ([]*entity.Payout, error) {
+	resp, err := c.api.ListDrawWinners(ctx, &ticketservicev1.ListDrawWinnersRequest{
+		DrawId: int32(drawID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list draw winners: %w", err)
+	}
+
+	var payouts []*entity.Payout
+	for _, w := range resp.GetWinners() {
+		if w.GetSyndicateId() == nil {
+			if w.GetUserId() == nil {
+				continue
+			}
+			payouts = append(payouts, &entity.Payout{
+				TicketID: int64(w.GetTicketId()),
+				UserID:   int64(w.GetUserId().GetValue()),
+				DrawID:   &drawID,
+				Amount:   moneyToDecimal(w.GetPrize()),
+			})
+			continue
+		}
+
+		syndicateID := int64(w.GetSyndicateId().GetValue())
+		for _, p := range w.GetSyndicatePayouts() {
+			payouts = append(payouts, &entity.Payout{
+				TicketID:    int64(w.GetTicketId()),
+				UserID:      int64(p.GetUserId()),
+				SyndicateID: &syndicateID,
+				Amount:      moneyToDecimal(p.GetAmount()),
+			})
+		}
+	}
+
+	return payouts, nil
+}
+
+func moneyToDecimal(m *money.Money) decimal.Decimal {
+	return decimal.NewFromInt(m.GetUnits()).Add(decimal.New(int64(m.GetNanos()), -9))
+}
@@ -0,0 +1,46 @@
+package entity
+
+import "errors"
+
+var (
+	ErrInvalidTickets    = errors.New("invalid ticket list")
+	ErrInvoiceNotFound   = errors.New("invoice not found")
+	ErrInvalidFilter     = errors.New("invalid filter")
+	ErrInvalidPageToken  = errors.New("invalid page token")
+	ErrInvoiceNotPending = errors.New("invoice is not pending")
+	ErrInvoiceNotOwned   = errors.New("invoice is not owned by user")
+	ErrPaymentDeclined   = errors.New("payment declined")
+	ErrPaymentTimeout    = errors.New("payment gateway timeout")
+	// ErrAuthorizationNotFound - у эквайера нет платежа с таким reference или сумма по нему не заблокирована
+	ErrAuthorizationNotFound = errors.New("authorization not found at gateway")
+	// ErrPaymentStateChanged - платёж уже перевёл в другую фазу параллельный обработчик
+	ErrPaymentStateChanged  = errors.New("payment state changed concurrently")
+	ErrInvoiceNotRefundable = errors.New("invoice is not paid")
+	ErrInvalidRefundAmount  = errors.New("invalid refund amount")
+	// ErrDrawClosed - тираж билетов счёта уже не ACTIVE: билеты могли выиграть, возврат невозможен
+	ErrDrawClosed = errors.New("draw is no longer active")
+	// ErrRefundStateChanged - возврат уже завершил параллельный обработчик
+	ErrRefundStateChanged = errors.New("refund state changed concurrently")
+	ErrUnbalancedEntry    = errors.New("unbalanced journal entry")
+	ErrInsufficientFunds  = errors.New("insufficient wallet funds")
+	ErrInvalidAmount      = errors.New("invalid amount")
+	// ErrDuplicateEntry - проводка с таким видом и Reference уже проведена
+	ErrDuplicateEntry = errors.New("journal entry already posted")
+	ErrPayoutNotFound = errors.New("payout not found")
+	// ErrPayoutNotAwaiting - выплата не ждёт подтверждения: уже выплачена или зачисляется автоматически
+	ErrPayoutNotAwaiting = errors.New("payout is not awaiting approval")
+	ErrPriceNotFound     = errors.New("no price for ticket")
+	ErrMixedCurrency     = errors.New("tickets are sold in different currencies")
+	ErrInvalidPrice      = errors.New("invalid price")
+	ErrInvalidPromotion  = errors.New("invalid promotion")
+	// ErrCurrencyMismatch - сумма операции не в валюте счёта или кошелька
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+	ErrInvalidVoucher   = errors.New("invalid voucher")
+	ErrVoucherNotFound  = errors.New("voucher not found")
+	ErrVoucherExpired   = errors.New("voucher expired")
+	// ErrVoucherExhausted - код погашен максимальное число раз
+	ErrVoucherExhausted = errors.New("voucher usage limit reached")
+	// ErrVoucherUserLimit - пользователь уже погасил код максимальное для одного пользователя число раз
+	ErrVoucherUserLimit     = errors.New("voucher per-user limit reached")
+	ErrVoucherNotApplicable = errors.New("voucher is not applicable to invoice")
+)
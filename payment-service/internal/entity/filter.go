@@ -0,0 +1,40 @@
+package entity
+
+import "time"
+
+// Cursor - позиция последней записи страницы в порядке (время, id) от новых к старым
+type Cursor struct {
+	Time time.Time `json:"t"`
+	ID   int64     `json:"i"`
+}
+
+// InvoiceFilter - условия выборки и страница списка счетов
+type InvoiceFilter struct {
+	OwnerID *int64
+	// Statuses, From, To - фильтр по статусу и времени выставления счёта, To не включается
+	Statuses []InvoiceStatus
+	From     *time.Time
+	To       *time.Time
+	Limit    int
+	After    *Cursor
+}
+
+// PaymentFilter - условия выборки и страница списка платежей
+type PaymentFilter struct {
+	OwnerID   *int64
+	InvoiceID *int64
+	// Statuses, From, To - фильтр по статусу и времени платежа, To не включается
+	Statuses []PaymentStatus
+	From     *time.Time
+	To       *time.Time
+	Limit    int
+	After    *Cursor
+}
+
+// PayoutFilter - условия выборки и страница списка выплат
+type PayoutFilter struct {
+	UserID   *int64
+	Statuses []PayoutStatus
+	Limit    int
+	After    *Cursor
+}
@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+type IdempotencyStatus string
+
+const (
+	IdempotencyInProgress IdempotencyStatus = "IN_PROGRESS"
+	IdempotencyCompleted  IdempotencyStatus = "COMPLETED"
+)
+
+// IdempotencyRecord - сохранённый результат запроса с ключом идемпотентности
+type IdempotencyRecord struct {
+	Method       string            `db:"method"`
+	UserID       int64             `db:"user_id"`
+	Key          string            `db:"key"`
+	Fingerprint  string            `db:"fingerprint"`
+	Status       IdempotencyStatus `db:"status"`
+	ResponseType string            `db:"response_type"`
+	Response     []byte            `db:"response"`
+	LockedAt     time.Time         `db:"locked_at"`
+	ExpiresAt    time.Time         `db:"expires_at"`
+}
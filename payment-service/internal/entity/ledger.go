@@ -0,0 +1,118 @@
+package entity
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type AccountKind string
+
+// Кошелёк игрока - единственный счёт, баланс которого не может уйти в минус. Системные счета
+// отражают внешнюю сторону операции: деньги, пришедшие с карт, выручку и призовой фонд.
+const (
+	AccountKindWallet    AccountKind = "USER_WALLET"
+	AccountKindCard      AccountKind = "CARD_SETTLEMENT"
+	AccountKindRevenue   AccountKind = "REVENUE"
+	AccountKindPrizeFund AccountKind = "PRIZE_FUND"
+)
+
+type Account struct {
+	ID        int64           `json:"id" db:"id"`
+	OwnerID   *int64          `json:"owner_id" db:"owner_id"`
+	Kind      AccountKind     `json:"kind" db:"kind"`
+	Currency  string          `json:"currency" db:"currency"`
+	Balance   decimal.Decimal `json:"balance" db:"balance"`
+	CreatedAt time.Time       `json:"created_at" db:"created_at"`
+}
+
+type EntryKind string
+
+const (
+	EntryKindTopUp          EntryKind = "TOP_UP"
+	EntryKindInvoicePayment EntryKind = "INVOICE_PAYMENT"
+	EntryKindRefund         EntryKind = "REFUND"
+	EntryKindWinnings       EntryKind = "WINNINGS"
+)
+
+// TopUp - пополнение кошелька с карты; проходит те же фазы, что и платёж по счёту,
+// но вместо оплаты счёта сумма зачисляется на кошелёк
+type TopUp struct {
+	ID        int64           `json:"id" db:"id"`
+	UserID    int64           `json:"user_id" db:"user_id"`
+	Amount    decimal.Decimal `json:"amount" db:"amount"`
+	Status    PaymentStatus   `json:"status" db:"status"`
+	GatewayID *int64          `json:"gateway_id" db:"gateway_id"`
+	Reason    string          `json:"reason" db:"reason"`
+	CreatedAt time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at" db:"updated_at"`
+}
+
+// Reference - идентификатор пополнения у эквайера и в проводке зачисления
+func (t *TopUp) Reference() string {
+	return "topup-" + strconv.FormatInt(t.ID, 10)
+}
+
+// Posting - изменение баланса одного счёта в проводке: положительная сумма увеличивает баланс
+type Posting struct {
+	AccountID int64           `json:"account_id" db:"account_id"`
+	Amount    decimal.Decimal `json:"amount" db:"amount"`
+}
+
+// JournalEntry - проводка двойной записи; сумма всех её изменений равна нулю
+type JournalEntry struct {
+	ID        int64     `json:"id" db:"id"`
+	Kind      EntryKind `json:"kind" db:"kind"`
+	InvoiceID *int64    `json:"invoice_id" db:"invoice_id"`
+	// Reference - внешний идентификатор операции; проводка одного вида с тем же Reference не повторяется
+	Reference string    `json:"reference" db:"reference"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	Postings  []Posting `json:"postings" db:"-"`
+}
+
+// Transfer - проводка, переносящая amount со счёта from на счёт to
+func Transfer(kind EntryKind, reference string, from, to int64, amount decimal.Decimal) *JournalEntry {
+	return &JournalEntry{
+		Kind:      kind,
+		Reference: reference,
+		Postings: []Posting{
+			{AccountID: from, Amount: amount.Neg()},
+			{AccountID: to, Amount: amount},
+		},
+	}
+}
+
+// Validate проверяет, что проводка сбалансирована и не содержит пустых изменений
+func (e *JournalEntry) Validate() error {
+	if len(e.Postings) < 2 {
+		return fmt.Errorf("%w: entry needs at least two postings", ErrUnbalancedEntry)
+	}
+
+	sum := decimal.Zero
+	for _, p := range e.Postings {
+		if p.Amount.IsZero() {
+			return fmt.Errorf("%w: zero posting to account %d", ErrUnbalancedEntry, p.AccountID)
+		}
+		if !p.Amount.Equal(p.Amount.Round(2)) {
+			return fmt.Errorf("%w: posting %s has fractional kopecks", ErrUnbalancedEntry, p.Amount)
+		}
+		sum = sum.Add(p.Amount)
+	}
+	if !sum.IsZero() {
+		return fmt.Errorf("%w: postings sum to %s", ErrUnbalancedEntry, sum)
+	}
+
+	return nil
+}
+
+// WalletTransaction - проводка с точки зрения кошелька: Amount положителен для зачислений
+type WalletTransaction struct {
+	EntryID   int64           `json:"entry_id" db:"entry_id"`
+	Kind      EntryKind       `json:"kind" db:"kind"`
+	Amount    decimal.Decimal `json:"amount" db:"amount"`
+	InvoiceID *int64          `json:"invoice_id" db:"invoice_id"`
+	Reference string          `json:"reference" db:"reference"`
+	CreatedAt time.Time       `json:"created_at" db:"created_at"`
+}
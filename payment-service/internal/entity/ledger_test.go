@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransfer(t *testing.T) {
+	entry := Transfer(EntryKindTopUp, "gateway-1", 1, 2, decimal.RequireFromString("150.50"))
+
+	require.NoError(t, entry.Validate())
+	require.Len(t, entry.Postings, 2)
+	assert.True(t, entry.Postings[0].Amount.Equal(decimal.RequireFromString("-150.50")))
+	assert.True(t, entry.Postings[1].Amount.Equal(decimal.RequireFromString("150.50")))
+}
+
+func TestJournalEntry_Validate(t *testing.T) {
+	posting := func(account int64, amount string) Posting {
+		return Posting{AccountID: account, Amount: decimal.RequireFromString(amount)}
+	}
+
+	tests := []struct {
+		name     string
+		postings []Posting
+		wantErr  bool
+	}{
+		{name: "balanced split", postings: []Posting{posting(1, "-100"), posting(2, "70"), posting(3, "30")}},
+		{name: "single posting", postings: []Posting{posting(1, "100")}, wantErr: true},
+		{name: "unbalanced", postings: []Posting{posting(1, "-100"), posting(2, "99.99")}, wantErr: true},
+		{name: "zero posting", postings: []Posting{posting(1, "0"), posting(2, "0")}, wantErr: true},
+		{name: "fractional kopecks", postings: []Posting{posting(1, "-0.005"), posting(2, "0.005")}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&JournalEntry{Kind: EntryKindTopUp, Postings: tt.postings}).Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrUnbalancedEntry)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
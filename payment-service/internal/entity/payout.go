@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type PayoutStatus string
+
+// Выигрыш до лимита автоматической выплаты создаётся в PENDING и сразу зачисляется на кошелёк;
+// крупный ждёт подтверждения администратора в AWAITING_APPROVAL. Зачисленная выплата - PAID.
+const (
+	PayoutStatusPending          PayoutStatus = "PENDING"
+	PayoutStatusAwaitingApproval PayoutStatus = "AWAITING_APPROVAL"
+	PayoutStatusPaid             PayoutStatus = "PAID"
+)
+
+func (s PayoutStatus) Valid() bool {
+	switch s {
+	case PayoutStatusPending, PayoutStatusAwaitingApproval, PayoutStatusPaid:
+		return true
+	}
+	return false
+}
+
+type PayoutAction string
+
+const (
+	PayoutActionCreated      PayoutAction = "CREATED"
+	PayoutActionCredited     PayoutAction = "CREDITED"
+	PayoutActionCreditFailed PayoutAction = "CREDIT_FAILED"
+	PayoutActionApproved     PayoutAction = "APPROVED"
+)
+
+// Payout - выплата выигрыша по билету; у синдиката на билет приходится по выплате на участника
+type Payout struct {
+	ID          int64           `json:"id" db:"id"`
+	TicketID    int64           `json:"ticket_id" db:"ticket_id"`
+	UserID      int64           `json:"user_id" db:"user_id"`
+	SyndicateID *int64          `json:"syndicate_id" db:"syndicate_id"`
+	DrawID      *int64          `json:"draw_id" db:"draw_id"`
+	Amount      decimal.Decimal `json:"amount" db:"amount"`
+	Status      PayoutStatus    `json:"status" db:"status"`
+	EntryID     *int64          `json:"entry_id" db:"entry_id"`
+	CreatedAt   time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at" db:"updated_at"`
+
+	Audit []*PayoutAudit `json:"audit" db:"-"`
+}
+
+// Reference - ссылка проводки зачисления, по ней выплата не проводится дважды
+func (p *Payout) Reference() string {
+	return "payout-" + strconv.FormatInt(p.ID, 10)
+}
+
+// PayoutAudit - запись журнала выплаты; ActorID пуст для действий самого сервиса
+type PayoutAudit struct {
+	ID         int64        `json:"id" db:"id"`
+	PayoutID   int64        `json:"payout_id" db:"payout_id"`
+	Action     PayoutAction `json:"action" db:"action"`
+	ActorID    *int64       `json:"actor_id" db:"actor_id"`
+	FromStatus PayoutStatus `json:"from_status" db:"from_status"`
+	ToStatus   PayoutStatus `json:"to_status" db:"to_status"`
+	Note       string       `json:"note" db:"note"`
+	CreatedAt  time.Time    `json:"created_at" db:"created_at"`
+}
@@ -0,0 +1,271 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultCurrency - валюта кошельков и проводок леджера
+const DefaultCurrency = "RUB"
+
+// Price - цена билета тиража DrawID, лотереи LotteryType или, если оба пусты, любого билета.
+// Действует с ValidFrom до ValidTo; из подходящих цен берётся самая точная, затем самая новая.
+type Price struct {
+	ID          int64           `json:"id" db:"id"`
+	LotteryType *string         `json:"lottery_type" db:"lottery_type"`
+	DrawID      *int64          `json:"draw_id" db:"draw_id"`
+	Amount      decimal.Decimal `json:"amount" db:"amount"`
+	Currency    string          `json:"currency" db:"currency"`
+	ValidFrom   time.Time       `json:"valid_from" db:"valid_from"`
+	ValidTo     *time.Time      `json:"valid_to" db:"valid_to"`
+	CreatedAt   time.Time       `json:"created_at" db:"created_at"`
+}
+
+func (p *Price) Validate() error {
+	if !p.Amount.IsPositive() || !p.Amount.Equal(p.Amount.Round(2)) {
+		return fmt.Errorf("%w: amount %s", ErrInvalidPrice, p.Amount.String())
+	}
+	if len(p.Currency) != 3 {
+		return fmt.Errorf("%w: currency %q", ErrInvalidPrice, p.Currency)
+	}
+	if p.ValidTo != nil && !p.ValidTo.After(p.ValidFrom) {
+		return fmt.Errorf("%w: empty validity period", ErrInvalidPrice)
+	}
+	return nil
+}
+
+func (p *Price) activeAt(now time.Time) bool {
+	return !now.Before(p.ValidFrom) && (p.ValidTo == nil || now.Before(*p.ValidTo))
+}
+
+// specificity - насколько точно цена подходит билету: 2 - тираж, 1 - лотерея, 0 - любой билет, -1 - не подходит
+func (p *Price) specificity(t Ticket) int {
+	switch {
+	case p.DrawID != nil:
+		if *p.DrawID == t.DrawID {
+			return 2
+		}
+		return -1
+	case p.LotteryType != nil:
+		if *p.LotteryType == t.LotteryType {
+			return 1
+		}
+		return -1
+	default:
+		return 0
+	}
+}
+
+type PromotionKind string
+
+// BUNDLE - из каждых Buy билетов оплачиваются Pay, бесплатными считаются самые дешёвые;
+// PERCENT - скидка Percent процентов на подходящие билеты.
+const (
+	PromotionKindBundle  PromotionKind = "BUNDLE"
+	PromotionKindPercent PromotionKind = "PERCENT"
+)
+
+// Promotion - акция на билеты тиража DrawID, лотереи LotteryType или на все билеты, действует с StartsAt до EndsAt
+type Promotion struct {
+	ID          int64           `json:"id" db:"id"`
+	Name        string          `json:"name" db:"name"`
+	Kind        PromotionKind   `json:"kind" db:"kind"`
+	LotteryType *string         `json:"lottery_type" db:"lottery_type"`
+	DrawID      *int64          `json:"draw_id" db:"draw_id"`
+	Buy         int             `json:"buy" db:"buy"`
+	Pay         int             `json:"pay" db:"pay"`
+	Percent     decimal.Decimal `json:"percent" db:"percent"`
+	StartsAt    time.Time       `json:"starts_at" db:"starts_at"`
+	EndsAt      time.Time       `json:"ends_at" db:"ends_at"`
+	CreatedAt   time.Time       `json:"created_at" db:"created_at"`
+}
+
+func (p *Promotion) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidPromotion)
+	}
+	if !p.EndsAt.After(p.StartsAt) {
+		return fmt.Errorf("%w: empty period", ErrInvalidPromotion)
+	}
+
+	switch p.Kind {
+	case PromotionKindBundle:
+		if p.Pay <= 0 || p.Buy <= p.Pay {
+			return fmt.Errorf("%w: buy %d pay %d", ErrInvalidPromotion, p.Buy, p.Pay)
+		}
+	case PromotionKindPercent:
+		if !p.Percent.IsPositive() || !p.Percent.LessThan(decimal.NewFromInt(100)) {
+			return fmt.Errorf("%w: percent %s", ErrInvalidPromotion, p.Percent.String())
+		}
+	default:
+		return fmt.Errorf("%w: kind %q", ErrInvalidPromotion, p.Kind)
+	}
+	return nil
+}
+
+func (p *Promotion) activeAt(now time.Time) bool {
+	return !now.Before(p.StartsAt) && now.Before(p.EndsAt)
+}
+
+func (p *Promotion) covers(t Ticket) bool {
+	switch {
+	case p.DrawID != nil:
+		return *p.DrawID == t.DrawID
+	case p.LotteryType != nil:
+		return *p.LotteryType == t.LotteryType
+	default:
+		return true
+	}
+}
+
+// discount - скидка акции на билеты с ценами prices
+func (p *Promotion) discount(prices []decimal.Decimal) decimal.Decimal {
+	switch p.Kind {
+	case PromotionKindBundle:
+		if p.Buy <= 0 {
+			return decimal.Zero
+		}
+		free := len(prices) / p.Buy * (p.Buy - p.Pay)
+		sorted := append([]decimal.Decimal(nil), prices...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i].LessThan(sorted[j]) })
+		return decimal.Sum(decimal.Zero, sorted[:free]...)
+	case PromotionKindPercent:
+		total := decimal.Sum(decimal.Zero, prices...)
+		return total.Mul(p.Percent).Div(decimal.NewFromInt(100)).Round(2)
+	default:
+		return decimal.Zero
+	}
+}
+
+// PriceLine - билеты счёта, посчитанные по одной цене
+type PriceLine struct {
+	PriceID     int64           `json:"price_id"`
+	DrawID      int64           `json:"draw_id"`
+	LotteryType string          `json:"lottery_type"`
+	Quantity    int             `json:"quantity"`
+	UnitPrice   decimal.Decimal `json:"unit_price"`
+}
+
+// Pricing - как посчитана сумма счёта: цены билетов и применённая акция
+type Pricing struct {
+	Currency    string          `json:"currency"`
+	Lines       []PriceLine     `json:"lines"`
+	Subtotal    decimal.Decimal `json:"subtotal"`
+	PromotionID *int64          `json:"promotion_id,omitempty"`
+	Promotion   string          `json:"promotion,omitempty"`
+	Discount    decimal.Decimal `json:"discount"`
+	// Voucher, VoucherDiscount - погашенный промокод и скидка по нему после акции
+	Voucher         string          `json:"voucher,omitempty"`
+	VoucherDiscount decimal.Decimal `json:"voucher_discount"`
+	Total           decimal.Decimal `json:"total"`
+}
+
+// ApplyVoucher уменьшает итог на скидку по промокоду
+func (p *Pricing) ApplyVoucher(code string, discount decimal.Decimal) {
+	p.Voucher = code
+	p.VoucherDiscount = discount
+	p.Total = p.Subtotal.Sub(p.Discount).Sub(discount)
+}
+
+func (p *Pricing) Scan(value interface{}) error {
+	bytes, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("invalid type for Pricing: %T", value)
+	}
+	return json.Unmarshal(bytes, p)
+}
+
+func (p Pricing) Value() (driver.Value, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Pricing: %w", err)
+	}
+	return string(data), nil
+}
+
+// Quote считает сумму за билеты по ценам, действующим в now, и применяет самую выгодную из действующих акций.
+// Все билеты счёта должны продаваться в одной валюте.
+func Quote(tickets Tickets, prices []*Price, promotions []*Promotion, now time.Time) (*Pricing, error) {
+	if len(tickets) == 0 {
+		return nil, ErrInvalidTickets
+	}
+
+	pricing := &Pricing{}
+	unitPrices := make([]decimal.Decimal, len(tickets))
+	lines := make(map[[2]int64]int)
+	for i, t := range tickets {
+		price := priceFor(t, prices, now)
+		if price == nil {
+			return nil, fmt.Errorf("ticket %d of draw %d: %w", t.ID, t.DrawID, ErrPriceNotFound)
+		}
+		if pricing.Currency == "" {
+			pricing.Currency = price.Currency
+		}
+		if price.Currency != pricing.Currency {
+			return nil, fmt.Errorf("%w: %s and %s", ErrMixedCurrency, pricing.Currency, price.Currency)
+		}
+
+		unitPrices[i] = price.Amount
+		pricing.Subtotal = pricing.Subtotal.Add(price.Amount)
+
+		key := [2]int64{price.ID, t.DrawID}
+		if n, ok := lines[key]; ok {
+			pricing.Lines[n].Quantity++
+			continue
+		}
+		lines[key] = len(pricing.Lines)
+		pricing.Lines = append(pricing.Lines, PriceLine{
+			PriceID:     price.ID,
+			DrawID:      t.DrawID,
+			LotteryType: t.LotteryType,
+			Quantity:    1,
+			UnitPrice:   price.Amount,
+		})
+	}
+
+	for _, promo := range promotions {
+		if !promo.activeAt(now) {
+			continue
+		}
+		var covered []decimal.Decimal
+		for i, t := range tickets {
+			if promo.covers(t) {
+				covered = append(covered, unitPrices[i])
+			}
+		}
+		if d := promo.discount(covered); d.GreaterThan(pricing.Discount) {
+			pricing.Discount = d
+			pricing.PromotionID = &promo.ID
+			pricing.Promotion = promo.Name
+		}
+	}
+
+	pricing.Total = pricing.Subtotal.Sub(pricing.Discount)
+	return pricing, nil
+}
+
+func priceFor(t Ticket, prices []*Price, now time.Time) *Price {
+	var (
+		best      *Price
+		bestScore = -1
+	)
+	for _, p := range prices {
+		if !p.activeAt(now) {
+			continue
+		}
+		score := p.specificity(t)
+		if score < 0 || score < bestScore {
+			continue
+		}
+		if score == bestScore && (p.ValidFrom.Before(best.ValidFrom) || p.ValidFrom.Equal(best.ValidFrom) && p.ID < best.ID) {
+			continue
+		}
+		best, bestScore = p, score
+	}
+	return best
+}
@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuote(t *testing.T) {
+	now := time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC)
+	lottery, draw := "6x45", int64(7)
+	yesterday, tomorrow := now.Add(-24*time.Hour), now.Add(24*time.Hour)
+
+	prices := []*Price{
+		{ID: 1, Amount: decimal.NewFromInt(100), Currency: "RUB", ValidFrom: yesterday},
+		{ID: 2, LotteryType: &lottery, Amount: decimal.NewFromInt(150), Currency: "RUB", ValidFrom: yesterday},
+		{ID: 3, DrawID: &draw, Amount: decimal.NewFromInt(200), Currency: "RUB", ValidFrom: yesterday},
+		// будущая цена тиража ещё не действует
+		{ID: 4, DrawID: &draw, Amount: decimal.NewFromInt(500), Currency: "RUB", ValidFrom: tomorrow},
+	}
+	bundle := &Promotion{ID: 10, Name: "3 по цене 2", Kind: PromotionKindBundle, LotteryType: &lottery, Buy: 3, Pay: 2, StartsAt: yesterday, EndsAt: tomorrow}
+	percent := &Promotion{ID: 11, Name: "минус 10%", Kind: PromotionKindPercent, Percent: decimal.NewFromInt(10), StartsAt: yesterday, EndsAt: tomorrow}
+	expired := &Promotion{ID: 12, Name: "прошлая", Kind: PromotionKindPercent, Percent: decimal.NewFromInt(50), StartsAt: yesterday.Add(-time.Hour), EndsAt: yesterday}
+
+	tests := []struct {
+		name       string
+		tickets    Tickets
+		promotions []*Promotion
+		total      string
+		discount   string
+		promotion  *int64
+		lines      int
+	}{
+		{
+			name:    "most specific price wins",
+			tickets: Tickets{{ID: 1, DrawID: 1, LotteryType: "5x36"}, {ID: 2, DrawID: 2, LotteryType: lottery}, {ID: 3, DrawID: draw, LotteryType: lottery}},
+			total:   "450", discount: "0", lines: 3,
+		},
+		{
+			name:       "bundle frees the cheapest ticket",
+			tickets:    Tickets{{ID: 1, DrawID: 2, LotteryType: lottery}, {ID: 2, DrawID: 2, LotteryType: lottery}, {ID: 3, DrawID: draw, LotteryType: lottery}},
+			promotions: []*Promotion{bundle, percent, expired},
+			total:      "350", discount: "150", promotion: &bundle.ID, lines: 2,
+		},
+		{
+			name:       "best promotion is chosen",
+			tickets:    Tickets{{ID: 1, DrawID: 2, LotteryType: lottery}, {ID: 2, DrawID: 1, LotteryType: "5x36"}},
+			promotions: []*Promotion{bundle, percent, expired},
+			total:      "225", discount: "25", promotion: &percent.ID, lines: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pricing, err := Quote(tt.tickets, prices, tt.promotions, now)
+			require.NoError(t, err)
+
+			assert.Equal(t, "RUB", pricing.Currency)
+			assert.True(t, pricing.Total.Equal(decimal.RequireFromString(tt.total)), "total %s", pricing.Total)
+			assert.True(t, pricing.Discount.Equal(decimal.RequireFromString(tt.discount)), "discount %s", pricing.Discount)
+			assert.Equal(t, tt.promotion, pricing.PromotionID)
+			assert.Len(t, pricing.Lines, tt.lines)
+		})
+	}
+}
+
+func TestQuote_Errors(t *testing.T) {
+	now := time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC)
+	lottery := "6x45"
+	prices := []*Price{
+		{ID: 1, LotteryType: &lottery, Amount: decimal.NewFromInt(100), Currency: "RUB", ValidFrom: now.Add(-time.Hour)},
+		{ID: 2, Amount: decimal.NewFromInt(2), Currency: "USD", ValidFrom: now.Add(-time.Hour)},
+	}
+
+	_, err := Quote(Tickets{{ID: 1, LotteryType: lottery}, {ID: 2, LotteryType: "5x36"}}, prices, nil, now)
+	assert.ErrorIs(t, err, ErrMixedCurrency)
+
+	_, err = Quote(Tickets{{ID: 1, LotteryType: lottery}}, prices[:1], nil, now.Add(-2*time.Hour))
+	assert.ErrorIs(t, err, ErrPriceNotFound)
+}
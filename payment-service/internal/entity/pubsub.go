@@ -5,4 +5,21 @@ type EventType string
 const (
 	EventTypeInvoiceOverdue EventType = "invoice_overdue"
 	EventTypeInvoiceFailure EventType = "invoice_failure"
+	EventTypeInvoicePaid    EventType = "invoice_paid"
+	// EventTypeInvoiceRefunded - по счёту вернули всю сумму
+	EventTypeInvoiceRefunded EventType = "invoice_refunded"
+
+	// события сервиса билетов, по которым выплачиваются выигрыши
+	EventTypeTicketWon       EventType = "ticket_won"
+	EventTypeSyndicatePayout EventType = "syndicate_payout"
+	// EventTypeTicketsUndelivered - билеты оплаченного счёта не удалось выдать, за них нужно вернуть деньги
+	EventTypeTicketsUndelivered EventType = "tickets_undelivered"
 )
+
+// InvoiceEvent - событие о счёте в outbox: пишется в одной транзакции со сменой статуса счёта
+// и публикуется после коммита
+type InvoiceEvent struct {
+	ID        int64     `db:"id"`
+	InvoiceID int64     `db:"invoice_id"`
+	Type      EventType `db:"event_type"`
+}
@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type RefundStatus string
+
+// Возврат создаётся в PENDING и повторяется, пока эквайер не подтвердит его (SUCCEEDED)
+// или не кончатся попытки (FAILED).
+const (
+	RefundStatusPending   RefundStatus = "PENDING"
+	RefundStatusSucceeded RefundStatus = "SUCCEEDED"
+	RefundStatusFailed    RefundStatus = "FAILED"
+)
+
+type Refund struct {
+	ID        int64           `json:"id" db:"id"`
+	InvoiceID int64           `json:"invoice_id" db:"invoice_id"`
+	PaymentID int64           `json:"payment_id" db:"payment_id"`
+	Amount    decimal.Decimal `json:"amount" db:"amount"`
+	Reason    string          `json:"reason" db:"reason"`
+	Status    RefundStatus    `json:"status" db:"status"`
+	GatewayID *int64          `json:"gateway_id" db:"gateway_id"`
+	Attempts  int             `json:"attempts" db:"attempts"`
+	LastError string          `json:"last_error" db:"last_error"`
+	CreatedAt time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at" db:"updated_at"`
+	// Currency - валюта счёта возврата, в таблице не хранится
+	Currency string `json:"currency" db:"-"`
+}
+
+// Reference - идентификатор возврата для эквайера, по нему эквайер не проводит возврат дважды
+func (r *Refund) Reference() string {
+	return "refund-" + strconv.FormatInt(r.ID, 10)
+}
+
+// RefundedStatus - статус счёта на amount после возвратов на refunded
+func RefundedStatus(amount, refunded decimal.Decimal) InvoiceStatus {
+	switch {
+	case !refunded.IsPositive():
+		return InvoiceStatusPaid
+	case refunded.LessThan(amount):
+		return InvoiceStatusPartiallyRefunded
+	default:
+		return InvoiceStatusRefunded
+	}
+}
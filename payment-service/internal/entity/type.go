@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -14,53 +15,122 @@ import (
 type InvoiceStatus string
 
 const (
-	InvoiceStatusPending InvoiceStatus = "PENDING"
-	InvoiceStatusPaid    InvoiceStatus = "PAID"
-	InvoiceStatusOverdue InvoiceStatus = "OVERDUE"
+	InvoiceStatusPending   InvoiceStatus = "PENDING"
+	InvoiceStatusPaid      InvoiceStatus = "PAID"
+	InvoiceStatusOverdue   InvoiceStatus = "OVERDUE"
+	InvoiceStatusCancelled InvoiceStatus = "CANCELLED"
+	// InvoiceStatusPartiallyRefunded - по оплаченному счёту вернули часть суммы
+	InvoiceStatusPartiallyRefunded InvoiceStatus = "PARTIALLY_REFUNDED"
+	InvoiceStatusRefunded          InvoiceStatus = "REFUNDED"
 )
 
+func (s InvoiceStatus) Valid() bool {
+	switch s {
+	case InvoiceStatusPending, InvoiceStatusPaid, InvoiceStatusOverdue, InvoiceStatusCancelled,
+		InvoiceStatusPartiallyRefunded, InvoiceStatusRefunded:
+		return true
+	}
+	return false
+}
+
 type Invoice struct {
 	ID           int64           `json:"id" db:"id"`
-	Ticket       *Ticket         `json:"ticket_data" db:"ticket_data"`
+	Tickets      Tickets         `json:"ticket_data" db:"ticket_data"`
 	OwnerID      int64           `json:"owner_id" db:"owner_id"`
 	Amount       decimal.Decimal `json:"amount" db:"amount"`
 	Status       InvoiceStatus   `json:"status" db:"status"`
 	RegisterTime time.Time       `json:"register_time" db:"register_time"`
 	DueDate      time.Time       `json:"due_date" db:"due_date"`
+	Currency     string          `json:"currency" db:"currency"`
+	Pricing      Pricing         `json:"pricing" db:"pricing"`
 }
 
 type Ticket struct {
-	ID int64 `json:"id" db:"id"`
+	ID          int64  `json:"id" db:"id"`
+	DrawID      int64  `json:"draw_id,omitempty" db:"draw_id"`
+	LotteryType string `json:"lottery_type,omitempty" db:"lottery_type"`
 }
 
-func (t *Ticket) Scan(value interface{}) error {
+// Tickets - билеты, оплачиваемые одним счётом
+type Tickets []Ticket
+
+func (t *Tickets) Scan(value interface{}) error {
 	bytes, ok := value.([]byte)
 	if !ok {
-		return fmt.Errorf("invalid type for Ticket: %T", value)
+		return fmt.Errorf("invalid type for Tickets: %T", value)
 	}
 	return json.Unmarshal(bytes, t)
 }
 
-func (t *Ticket) Value() (driver.Value, error) {
+func (t Tickets) Value() (driver.Value, error) {
 	data, err := json.Marshal(t)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal Ticket: %w", err)
+		return nil, fmt.Errorf("failed to marshal Tickets: %w", err)
 	}
 	return string(data), nil
 }
 
+func (t Tickets) IDs() []int64 {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ids
+}
+
 type PaymentStatus string
 
+// Платёж проходит фазы AUTHORIZING -> AUTHORIZED -> CAPTURING -> PAID: деньги
+// списываются только после того, как счёт помечен оплаченным в базе.
+// Отказ эквайера - REJECTED, отменённая авторизация - VOIDED.
+const (
+	PaymentStatusAuthorizing PaymentStatus = "AUTHORIZING"
+	PaymentStatusAuthorized  PaymentStatus = "AUTHORIZED"
+	PaymentStatusCapturing   PaymentStatus = "CAPTURING"
+	PaymentStatusPaid        PaymentStatus = "PAID"
+	PaymentStatusRejected    PaymentStatus = "REJECTED"
+	PaymentStatusVoided      PaymentStatus = "VOIDED"
+)
+
+func (s PaymentStatus) Valid() bool {
+	switch s {
+	case PaymentStatusAuthorizing, PaymentStatusAuthorized, PaymentStatusCapturing,
+		PaymentStatusPaid, PaymentStatusRejected, PaymentStatusVoided:
+		return true
+	}
+	return false
+}
+
+type PaymentMethod string
+
 const (
-	PaymentStatusPaid     PaymentStatus = "PAID"
-	PaymentStatusRejected PaymentStatus = "REJECTED"
+	PaymentMethodCard   PaymentMethod = "CARD"
+	PaymentMethodWallet PaymentMethod = "WALLET"
 )
 
 type Payment struct {
-	ID          int64         `json:"id" db:"id"`
-	InvoiceID   int64         `json:"invoice_id" db:"invoice_id"`
-	Status      PaymentStatus `json:"status" db:"status"`
-	PaymentTime time.Time     `json:"payment_time" db:"payment_time"`
+	ID          int64           `json:"id" db:"id"`
+	InvoiceID   int64           `json:"invoice_id" db:"invoice_id"`
+	Status      PaymentStatus   `json:"status" db:"status"`
+	Method      PaymentMethod   `json:"method" db:"method"`
+	PaymentTime time.Time       `json:"payment_time" db:"payment_time"`
+	GatewayID   *int64          `json:"gateway_id" db:"gateway_id"`
+	Amount      decimal.Decimal `json:"amount" db:"amount"`
+	Reason      string          `json:"reason" db:"reason"`
+	UpdatedAt   time.Time       `json:"updated_at" db:"updated_at"`
+}
+
+// Reference - идентификатор платежа на нашей стороне, по которому эквайер находит его авторизацию
+func (p *Payment) Reference() string {
+	return "payment-" + strconv.FormatInt(p.ID, 10)
+}
+
+// PaymentDetails - платёж вместе с владельцем и билетами его счёта
+type PaymentDetails struct {
+	Payment
+	OwnerID  int64   `json:"owner_id" db:"owner_id"`
+	Tickets  Tickets `json:"ticket_data" db:"ticket_data"`
+	Currency string  `json:"currency" db:"currency"`
 }
 
 type Card struct {
@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type VoucherKind string
+
+// PERCENT - скидка Percent процентов от суммы счёта, FIXED - скидка Amount в валюте Currency
+const (
+	VoucherKindPercent VoucherKind = "PERCENT"
+	VoucherKindFixed   VoucherKind = "FIXED"
+)
+
+// Voucher - промокод на скидку при выставлении счёта. Нулевые MaxRedemptions и PerUserLimit - без ограничений.
+// Redeemed - сколько раз код погашен, не считая погашений по просроченным и отменённым счетам.
+type Voucher struct {
+	ID             int64           `json:"id" db:"id"`
+	Code           string          `json:"code" db:"code"`
+	Kind           VoucherKind     `json:"kind" db:"kind"`
+	Percent        decimal.Decimal `json:"percent" db:"percent"`
+	Amount         decimal.Decimal `json:"amount" db:"amount"`
+	Currency       string          `json:"currency" db:"currency"`
+	MaxRedemptions int             `json:"max_redemptions" db:"max_redemptions"`
+	PerUserLimit   int             `json:"per_user_limit" db:"per_user_limit"`
+	Redeemed       int             `json:"redeemed" db:"redeemed"`
+	ExpiresAt      time.Time       `json:"expires_at" db:"expires_at"`
+	CreatedAt      time.Time       `json:"created_at" db:"created_at"`
+}
+
+// NormalizeVoucherCode приводит введённый код к виду, в котором он хранится
+func NormalizeVoucherCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func (v *Voucher) Validate(now time.Time) error {
+	if len(v.Code) < 4 || len(v.Code) > 32 || strings.ContainsAny(v.Code, " \t\n") {
+		return fmt.Errorf("%w: code %q", ErrInvalidVoucher, v.Code)
+	}
+	if !v.ExpiresAt.After(now) {
+		return fmt.Errorf("%w: already expired", ErrInvalidVoucher)
+	}
+	if v.MaxRedemptions < 0 || v.PerUserLimit < 0 {
+		return fmt.Errorf("%w: negative limit", ErrInvalidVoucher)
+	}
+
+	switch v.Kind {
+	case VoucherKindPercent:
+		if !v.Percent.IsPositive() || !v.Percent.LessThan(decimal.NewFromInt(100)) {
+			return fmt.Errorf("%w: percent %s", ErrInvalidVoucher, v.Percent.String())
+		}
+	case VoucherKindFixed:
+		if !v.Amount.IsPositive() || !v.Amount.Equal(v.Amount.Round(2)) {
+			return fmt.Errorf("%w: amount %s", ErrInvalidVoucher, v.Amount.String())
+		}
+		if len(v.Currency) != 3 {
+			return fmt.Errorf("%w: currency %q", ErrInvalidVoucher, v.Currency)
+		}
+	default:
+		return fmt.Errorf("%w: kind %q", ErrInvalidVoucher, v.Kind)
+	}
+	return nil
+}
+
+// Discount - скидка по коду на счёт total в валюте currency; счёт не может стать бесплатным
+func (v *Voucher) Discount(total decimal.Decimal, currency string) (decimal.Decimal, error) {
+	var discount decimal.Decimal
+	switch v.Kind {
+	case VoucherKindPercent:
+		discount = total.Mul(v.Percent).Div(decimal.NewFromInt(100)).Round(2)
+	case VoucherKindFixed:
+		if v.Currency != currency {
+			return decimal.Zero, fmt.Errorf("voucher in %s, invoice in %s: %w", v.Currency, currency, ErrCurrencyMismatch)
+		}
+		discount = v.Amount
+	default:
+		return decimal.Zero, fmt.Errorf("%w: kind %q", ErrInvalidVoucher, v.Kind)
+	}
+
+	if !discount.LessThan(total) {
+		return decimal.Zero, fmt.Errorf("%w: discount %s covers invoice %s", ErrVoucherNotApplicable, discount.StringFixed(2), total.StringFixed(2))
+	}
+	return discount, nil
+}
+
+// VoucherRedemption - погашение кода счётом; ReleasedAt задан, если счёт просрочен или отменён и код вернули
+type VoucherRedemption struct {
+	ID         int64           `json:"id" db:"id"`
+	VoucherID  int64           `json:"voucher_id" db:"voucher_id"`
+	UserID     int64           `json:"user_id" db:"user_id"`
+	InvoiceID  int64           `json:"invoice_id" db:"invoice_id"`
+	Discount   decimal.Decimal `json:"discount" db:"discount"`
+	CreatedAt  time.Time       `json:"created_at" db:"created_at"`
+	ReleasedAt *time.Time      `json:"released_at" db:"released_at"`
+}
@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVoucher_Discount(t *testing.T) {
+	percent := &Voucher{Kind: VoucherKindPercent, Percent: decimal.RequireFromString("15")}
+	fixed := &Voucher{Kind: VoucherKindFixed, Amount: decimal.NewFromInt(100), Currency: "RUB"}
+
+	tests := []struct {
+		name     string
+		voucher  *Voucher
+		total    string
+		currency string
+		want     string
+		wantErr  error
+	}{
+		{name: "percent rounds to kopecks", voucher: percent, total: "333.33", currency: "RUB", want: "50"},
+		{name: "fixed", voucher: fixed, total: "250", currency: "RUB", want: "100"},
+		{name: "fixed in another currency", voucher: fixed, total: "250", currency: "USD", wantErr: ErrCurrencyMismatch},
+		{name: "fixed covers invoice", voucher: fixed, total: "100", currency: "RUB", wantErr: ErrVoucherNotApplicable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.voucher.Discount(decimal.RequireFromString(tt.total), tt.currency)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, got.Equal(decimal.RequireFromString(tt.want)), "discount %s", got)
+		})
+	}
+}
+
+func TestVoucher_Validate(t *testing.T) {
+	now := time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC)
+	valid := func() *Voucher {
+		return &Voucher{Code: NormalizeVoucherCode(" summer25 "), Kind: VoucherKindPercent, Percent: decimal.NewFromInt(25), ExpiresAt: now.Add(time.Hour)}
+	}
+
+	require.NoError(t, valid().Validate(now))
+	assert.Equal(t, "SUMMER25", valid().Code)
+
+	expired := valid()
+	expired.ExpiresAt = now
+	assert.ErrorIs(t, expired.Validate(now), ErrInvalidVoucher)
+
+	full := valid()
+	full.Percent = decimal.NewFromInt(100)
+	assert.ErrorIs(t, full.Validate(now), ErrInvalidVoucher)
+
+	noCurrency := valid()
+	noCurrency.Kind, noCurrency.Amount = VoucherKindFixed, decimal.NewFromInt(50)
+	assert.ErrorIs(t, noCurrency.Validate(now), ErrInvalidVoucher)
+}
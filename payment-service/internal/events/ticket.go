@@ -0,0 +1,81 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+	"github.com/MaxFando/lms/payment-service/internal/service"
+	"github.com/MaxFando/lms/platform/logger"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+)
+
+// TicketEventHandler выплачивает выигрыши по событиям расчёта тиража из сервиса билетов
+// и возвращает деньги за оплаченные билеты, которые сервис билетов не смог выдать
+type TicketEventHandler struct {
+	redisClient *redis.Client
+	service     *service.Service
+	channel     string
+	log         logger.Logger
+}
+
+func NewTicketEventHandler(rdb *redis.Client, svc *service.Service, channel string) *TicketEventHandler {
+	return &TicketEventHandler{
+		redisClient: rdb,
+		service:     svc,
+		channel:     channel,
+		log:         logger.NewLogger().With("app", "lms", "component", "payment-service", "layer", "ticket_events"),
+	}
+}
+
+func (h *TicketEventHandler) Run(ctx context.Context) error {
+	pubsub := h.redisClient.Subscribe(ctx, h.channel)
+	defer pubsub.Close()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg := <-pubsub.Channel():
+			var ev struct {
+				Type        entity.EventType `json:"type"`
+				TicketID    int64            `json:"ticket_id"`
+				DrawID      int64            `json:"draw_id"`
+				SyndicateID int64            `json:"syndicate_id"`
+				UserID      int64            `json:"user_id"`
+				Amount      decimal.Decimal  `json:"amount"`
+				InvoiceID   int64            `json:"invoice_id"`
+				TicketIDs   []int64          `json:"ticket_ids"`
+			}
+			if err := json.Unmarshal([]byte(msg.Payload), &ev); err != nil {
+				h.log.Error(ctx, "failed to decode ticket event", "error", err)
+				continue
+			}
+
+			var win *entity.Payout
+			switch ev.Type {
+			case entity.EventTypeTicketWon:
+				win = &entity.Payout{TicketID: ev.TicketID, UserID: ev.UserID, DrawID: &ev.DrawID, Amount: ev.Amount}
+			case entity.EventTypeSyndicatePayout:
+				win = &entity.Payout{TicketID: ev.TicketID, UserID: ev.UserID, SyndicateID: &ev.SyndicateID, Amount: ev.Amount}
+			case entity.EventTypeTicketsUndelivered:
+				if err := h.service.RefundUndelivered(ctx, ev.InvoiceID, ev.TicketIDs); err != nil {
+					h.log.Error(ctx, "failed to refund undelivered tickets", "invoice_id", ev.InvoiceID, "ticket_ids", ev.TicketIDs, "error", err)
+				}
+				continue
+			default:
+				h.log.Debug(ctx, "skipping ticket event", "type", ev.Type, "ticket_id", ev.TicketID)
+				continue
+			}
+
+			// доля синдиката меньше копейки округляется до нуля - выплачивать нечего
+			if !win.Amount.IsPositive() {
+				h.log.Debug(ctx, "skipping zero win", "type", ev.Type, "ticket_id", ev.TicketID, "user_id", ev.UserID)
+				continue
+			}
+			if err := h.service.HandleWin(ctx, win); err != nil {
+				h.log.Error(ctx, "failed to handle win", "type", ev.Type, "ticket_id", ev.TicketID, "user_id", ev.UserID, "error", err)
+			}
+		}
+	}
+}
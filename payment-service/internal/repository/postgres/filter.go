@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+)
+
+// listQuery собирает условия WHERE, сортировку и LIMIT постраничного списка
+type listQuery struct {
+	conds []string
+	args  []any
+}
+
+func (q *listQuery) add(cond string, arg any) {
+	q.args = append(q.args, arg)
+	q.conds = append(q.conds, fmt.Sprintf(cond, len(q.args)))
+}
+
+// tail возвращает WHERE, ORDER BY и LIMIT; записи идут от новых к старым по (timeCol, idCol)
+func (q *listQuery) tail(timeCol, idCol string, after *entity.Cursor, limit int) string {
+	if after != nil {
+		q.args = append(q.args, after.Time, after.ID)
+		q.conds = append(q.conds, fmt.Sprintf("(%s, %s) < ($%d, $%d)", timeCol, idCol, len(q.args)-1, len(q.args)))
+	}
+
+	var b strings.Builder
+	if len(q.conds) > 0 {
+		b.WriteString(" WHERE ")
+		b.WriteString(strings.Join(q.conds, " AND "))
+	}
+	fmt.Fprintf(&b, " ORDER BY %s DESC, %s DESC", timeCol, idCol)
+	if limit > 0 {
+		q.args = append(q.args, limit)
+		fmt.Fprintf(&b, " LIMIT $%d", len(q.args))
+	}
+	return b.String()
+}
+
+// formatStatusesArray - литерал text[] для сравнения статусов через ANY
+func formatStatusesArray[S ~string](statuses []S) string {
+	parts := make([]string, len(statuses))
+	for i, st := range statuses {
+		parts[i] = string(st)
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+// formatIDsArray - литерал int[] для сравнения идентификаторов через ANY
+func formatIDsArray(ids []int64) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.FormatInt(id, 10)
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
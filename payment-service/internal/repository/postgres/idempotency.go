@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+	"github.com/MaxFando/lms/payment-service/pkg/sqlxtransaction"
+	"github.com/jmoiron/sqlx"
+)
+
+type IdempotencyRepository struct {
+	sqlxtransaction.SQLX
+
+	ttl         time.Duration
+	lockTimeout time.Duration
+}
+
+// NewIdempotencyRepository - ключи хранятся ttl; незавершённый запрос, не ответивший за lockTimeout,
+// можно повторить с тем же ключом
+func NewIdempotencyRepository(db *sqlx.DB, ttl, lockTimeout time.Duration) *IdempotencyRepository {
+	return &IdempotencyRepository{
+		SQLX:        sqlxtransaction.NewSQLX(db),
+		ttl:         ttl,
+		lockTimeout: lockTimeout,
+	}
+}
+
+// Acquire занимает ключ пользователя userID под запрос. Если ключ уже занят живым запросом или хранит
+// готовый ответ, возвращается существующая запись, иначе - nil.
+func (r *IdempotencyRepository) Acquire(ctx context.Context, method string, userID int64, key, fingerprint string) (*entity.IdempotencyRecord, error) {
+	query := `
+		INSERT INTO payment.idempotency_keys AS k (method, user_id, key, fingerprint, status, locked_at, expires_at)
+		VALUES ($1, $2, $3, $4, 'IN_PROGRESS', $5, $6)
+		ON CONFLICT (method, user_id, key) DO UPDATE
+		SET fingerprint = EXCLUDED.fingerprint,
+		    status = 'IN_PROGRESS',
+		    response_type = '',
+		    response = NULL,
+		    locked_at = EXCLUDED.locked_at,
+		    expires_at = EXCLUDED.expires_at
+		WHERE k.expires_at < EXCLUDED.locked_at
+		   OR (k.status = 'IN_PROGRESS' AND k.locked_at < $7 AND k.fingerprint = EXCLUDED.fingerprint)
+		RETURNING method
+	`
+	now := time.Now()
+
+	var acquired string
+	err := r.GetContext(ctx, &acquired, query, method, userID, key, fingerprint, now, now.Add(r.ttl), now.Add(-r.lockTimeout))
+	if err == nil {
+		return nil, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("acquire idempotency key: %w", err)
+	}
+
+	var record entity.IdempotencyRecord
+	err = r.GetContext(ctx, &record, `
+		SELECT method, user_id, key, fingerprint, status, response_type, response, locked_at, expires_at
+		FROM payment.idempotency_keys
+		WHERE method = $1 AND user_id = $2 AND key = $3
+	`, method, userID, key)
+	if err != nil {
+		return nil, fmt.Errorf("get idempotency key: %w", err)
+	}
+
+	return &record, nil
+}
+
+// Complete сохраняет ответ запроса для повторов
+func (r *IdempotencyRepository) Complete(ctx context.Context, method string, userID int64, key, responseType string, response []byte) error {
+	query := `
+		UPDATE payment.idempotency_keys
+		SET status = 'COMPLETED', response_type = $4, response = $5
+		WHERE method = $1 AND user_id = $2 AND key = $3
+	`
+	if _, err := r.ExecContext(ctx, query, method, userID, key, responseType, response); err != nil {
+		return fmt.Errorf("complete idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+// Release освобождает ключ запроса, завершившегося ошибкой, чтобы его можно было повторить
+func (r *IdempotencyRepository) Release(ctx context.Context, method string, userID int64, key string) error {
+	query := `
+		DELETE FROM payment.idempotency_keys
+		WHERE method = $1 AND user_id = $2 AND key = $3 AND status = 'IN_PROGRESS'
+	`
+	if _, err := r.ExecContext(ctx, query, method, userID, key); err != nil {
+		return fmt.Errorf("release idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context) error {
+	query := `DELETE FROM payment.idempotency_keys WHERE expires_at < NOW()`
+	if _, err := r.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("delete expired idempotency keys: %w", err)
+	}
+
+	return nil
+}
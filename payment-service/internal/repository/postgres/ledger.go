@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+)
+
+const accountColumns = `id, owner_id, kind, currency, balance, created_at`
+
+// Wallet возвращает кошелёк пользователя, создавая его при первом обращении
+func (r *PaymentRepository) Wallet(ctx context.Context, ownerID int64) (*entity.Account, error) {
+	insert := `
+		INSERT INTO payment.accounts (owner_id, kind)
+		VALUES ($1, 'USER_WALLET')
+		ON CONFLICT (owner_id, currency) WHERE kind = 'USER_WALLET' DO NOTHING
+	`
+	if _, err := r.ExecContext(ctx, insert, ownerID); err != nil {
+		return nil, fmt.Errorf("create wallet: %w", err)
+	}
+
+	query := `
+		SELECT ` + accountColumns + `
+		FROM payment.accounts
+		WHERE owner_id = $1 AND kind = 'USER_WALLET'
+	`
+	var account entity.Account
+	if err := r.GetContext(ctx, &account, query, ownerID); err != nil {
+		return nil, fmt.Errorf("get wallet: %w", err)
+	}
+
+	return &account, nil
+}
+
+func (r *PaymentRepository) SystemAccount(ctx context.Context, kind entity.AccountKind) (*entity.Account, error) {
+	query := `
+		SELECT ` + accountColumns + `
+		FROM payment.accounts
+		WHERE kind = $1 AND owner_id IS NULL
+	`
+	var account entity.Account
+	if err := r.GetContext(ctx, &account, query, kind); err != nil {
+		return nil, fmt.Errorf("get %s account: %w", kind, err)
+	}
+
+	return &account, nil
+}
+
+// PostEntry проводит сбалансированную проводку и меняет балансы её счетов; вызывается в транзакции.
+// Списание, уводящее кошелёк в минус, - ErrInsufficientFunds, повтор Reference - ErrDuplicateEntry.
+func (r *PaymentRepository) PostEntry(ctx context.Context, entry *entity.JournalEntry) (int64, error) {
+	if err := entry.Validate(); err != nil {
+		return 0, err
+	}
+
+	insert := `
+		INSERT INTO payment.journal_entries (kind, invoice_id, reference)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (kind, reference) WHERE reference <> '' DO NOTHING
+		RETURNING id
+	`
+	var id int64
+	if err := r.GetContext(ctx, &id, insert, entry.Kind, entry.InvoiceID, entry.Reference); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, fmt.Errorf("%s %s: %w", entry.Kind, entry.Reference, entity.ErrDuplicateEntry)
+		}
+		return 0, fmt.Errorf("create journal entry: %w", err)
+	}
+
+	// счета блокируются в порядке id, чтобы встречные проводки не взаимоблокировались
+	postings := append([]entity.Posting(nil), entry.Postings...)
+	sort.Slice(postings, func(i, j int) bool { return postings[i].AccountID < postings[j].AccountID })
+
+	for _, p := range postings {
+		_, err := r.ExecContext(ctx, `
+			INSERT INTO payment.postings (entry_id, account_id, amount)
+			VALUES ($1, $2, $3)
+		`, id, p.AccountID, p.Amount)
+		if err != nil {
+			return 0, fmt.Errorf("create posting: %w", err)
+		}
+
+		res, err := r.ExecContext(ctx, `
+			UPDATE payment.accounts
+			SET balance = balance + $2
+			WHERE id = $1 AND (kind <> 'USER_WALLET' OR balance + $2 >= 0)
+		`, p.AccountID, p.Amount)
+		if err != nil {
+			return 0, fmt.Errorf("update balance: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("update balance: %w", err)
+		}
+		if n == 0 {
+			return 0, fmt.Errorf("account %d: %w", p.AccountID, entity.ErrInsufficientFunds)
+		}
+	}
+
+	return id, nil
+}
+
+const walletTransactionColumns = `e.id AS entry_id, e.kind, p.amount, e.invoice_id, e.reference, e.created_at`
+
+// WalletTransaction возвращает проводку kind с reference со стороны счёта accountID
+func (r *PaymentRepository) WalletTransaction(ctx context.Context, accountID int64, kind entity.EntryKind, reference string) (*entity.WalletTransaction, error) {
+	query := `
+		SELECT ` + walletTransactionColumns + `
+		FROM payment.journal_entries e
+		JOIN payment.postings p ON p.entry_id = e.id
+		WHERE p.account_id = $1 AND e.kind = $2 AND e.reference = $3
+	`
+	var tx entity.WalletTransaction
+	if err := r.GetContext(ctx, &tx, query, accountID, kind, reference); err != nil {
+		return nil, fmt.Errorf("get wallet transaction: %w", err)
+	}
+
+	return &tx, nil
+}
+
+// ListWalletTransactions возвращает проводки по счёту от новых к старым
+func (r *PaymentRepository) ListWalletTransactions(ctx context.Context, accountID int64, limit int, after *entity.Cursor) ([]*entity.WalletTransaction, error) {
+	var q listQuery
+	q.add("p.account_id = $%d", accountID)
+
+	query := `
+		SELECT ` + walletTransactionColumns + `
+		FROM payment.journal_entries e
+		JOIN payment.postings p ON p.entry_id = e.id` + q.tail("e.created_at", "e.id", after, limit)
+	var txs []*entity.WalletTransaction
+	if err := r.SelectContext(ctx, &txs, query, q.args...); err != nil {
+		return nil, fmt.Errorf("list wallet transactions: %w", err)
+	}
+
+	return txs, nil
+}
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+)
+
+// EnqueueInvoiceEvents записывает события о счетах в outbox; вызывается в транзакции, меняющей счета
+func (r *PaymentRepository) EnqueueInvoiceEvents(ctx context.Context, invoiceIDs []int64, eventType entity.EventType) error {
+	if len(invoiceIDs) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO payment.invoice_outbox (invoice_id, event_type)
+		SELECT unnest($1::int[]), $2
+	`
+	if _, err := r.ExecContext(ctx, query, formatIDsArray(invoiceIDs), eventType); err != nil {
+		return fmt.Errorf("enqueue invoice events: %w", err)
+	}
+
+	return nil
+}
+
+// LockInvoiceEvents блокирует до конца транзакции до limit неопубликованных событий.
+// События, которые публикует соседняя реплика, пропускаются.
+func (r *PaymentRepository) LockInvoiceEvents(ctx context.Context, limit int) ([]*entity.InvoiceEvent, error) {
+	query := `
+		SELECT id, invoice_id, event_type
+		FROM payment.invoice_outbox
+		WHERE published_at IS NULL
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`
+	var events []*entity.InvoiceEvent
+	if err := r.SelectContext(ctx, &events, query, limit); err != nil {
+		return nil, fmt.Errorf("lock invoice events: %w", err)
+	}
+
+	return events, nil
+}
+
+func (r *PaymentRepository) MarkInvoiceEventsPublished(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := `UPDATE payment.invoice_outbox SET published_at = NOW() WHERE id = ANY($1::int[])`
+	if _, err := r.ExecContext(ctx, query, formatIDsArray(ids)); err != nil {
+		return fmt.Errorf("mark invoice events published: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+)
+
+const payoutColumns = `id, ticket_id, user_id, syndicate_id, draw_id, amount, status, entry_id, created_at, updated_at`
+
+// CreatePayout создаёт выплату; повторное событие о том же выигрыше возвращает created = false
+func (r *PaymentRepository) CreatePayout(ctx context.Context, payout *entity.Payout) (*entity.Payout, bool, error) {
+	query := `
+		INSERT INTO payment.payouts (ticket_id, user_id, syndicate_id, draw_id, amount, status)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (ticket_id, user_id) DO NOTHING
+		RETURNING ` + payoutColumns
+	var created entity.Payout
+	err := r.GetContext(ctx, &created, query,
+		payout.TicketID,
+		payout.UserID,
+		payout.SyndicateID,
+		payout.DrawID,
+		payout.Amount,
+		payout.Status,
+	)
+	if err == nil {
+		return &created, true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, false, fmt.Errorf("create payout: %w", err)
+	}
+
+	existing, err := r.getPayout(ctx, `WHERE ticket_id = $1 AND user_id = $2`, payout.TicketID, payout.UserID)
+	if err != nil {
+		return nil, false, err
+	}
+	return existing, false, nil
+}
+
+func (r *PaymentRepository) GetPayoutByID(ctx context.Context, id int64) (*entity.Payout, error) {
+	return r.getPayout(ctx, `WHERE id = $1`, id)
+}
+
+// LockPayout блокирует выплату до конца транзакции
+func (r *PaymentRepository) LockPayout(ctx context.Context, id int64) (*entity.Payout, error) {
+	return r.getPayout(ctx, `WHERE id = $1 FOR UPDATE`, id)
+}
+
+func (r *PaymentRepository) getPayout(ctx context.Context, where string, args ...any) (*entity.Payout, error) {
+	query := `SELECT ` + payoutColumns + ` FROM payment.payouts ` + where
+	var payout entity.Payout
+	if err := r.GetContext(ctx, &payout, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrPayoutNotFound
+		}
+		return nil, fmt.Errorf("get payout: %w", err)
+	}
+
+	return &payout, nil
+}
+
+// PayPayout помечает заблокированную выплату зачисленной проводкой entryID
+func (r *PaymentRepository) PayPayout(ctx context.Context, id int64, entryID int64) error {
+	query := `
+		UPDATE payment.payouts
+		SET status = 'PAID', entry_id = $2, updated_at = NOW()
+		WHERE id = $1
+	`
+	if _, err := r.ExecContext(ctx, query, id, entryID); err != nil {
+		return fmt.Errorf("pay payout: %w", err)
+	}
+
+	return nil
+}
+
+// TouchPayout отодвигает следующий повтор зачисления выплаты
+func (r *PaymentRepository) TouchPayout(ctx context.Context, id int64) error {
+	if _, err := r.ExecContext(ctx, `UPDATE payment.payouts SET updated_at = NOW() WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("touch payout: %w", err)
+	}
+
+	return nil
+}
+
+// GetInvoicedDraws возвращает тиражи билетов из счетов, выставленных начиная с since
+func (r *PaymentRepository) GetInvoicedDraws(ctx context.Context, since time.Time) ([]int64, error) {
+	query := `
+		SELECT DISTINCT (t ->> 'draw_id')::int
+		FROM payment.invoices i
+		CROSS JOIN LATERAL jsonb_array_elements(i.ticket_data) t
+		WHERE i.register_time >= $1 AND t ? 'draw_id'
+		ORDER BY 1
+	`
+	var draws []int64
+	if err := r.SelectContext(ctx, &draws, query, since); err != nil {
+		return nil, fmt.Errorf("get invoiced draws: %w", err)
+	}
+
+	return draws, nil
+}
+
+// GetPendingPayouts возвращает автоматические выплаты, не зачисленные с before
+func (r *PaymentRepository) GetPendingPayouts(ctx context.Context, before time.Time) ([]*entity.Payout, error) {
+	query := `
+		SELECT ` + payoutColumns + `
+		FROM payment.payouts
+		WHERE status = 'PENDING' AND updated_at < $1
+		ORDER BY id
+	`
+	var payouts []*entity.Payout
+	if err := r.SelectContext(ctx, &payouts, query, before); err != nil {
+		return nil, fmt.Errorf("get pending payouts: %w", err)
+	}
+
+	return payouts, nil
+}
+
+// ListPayouts возвращает выплаты по фильтру, от новых к старым
+func (r *PaymentRepository) ListPayouts(ctx context.Context, f entity.PayoutFilter) ([]*entity.Payout, error) {
+	var q listQuery
+	if f.UserID != nil {
+		q.add("user_id = $%d", *f.UserID)
+	}
+	if len(f.Statuses) > 0 {
+		q.add("status = ANY($%d::text[])", formatStatusesArray(f.Statuses))
+	}
+
+	query := `SELECT ` + payoutColumns + ` FROM payment.payouts` + q.tail("created_at", "id", f.After, f.Limit)
+	var payouts []*entity.Payout
+	if err := r.SelectContext(ctx, &payouts, query, q.args...); err != nil {
+		return nil, fmt.Errorf("list payouts: %w", err)
+	}
+
+	return payouts, nil
+}
+
+func (r *PaymentRepository) AddPayoutAudit(ctx context.Context, audit *entity.PayoutAudit) error {
+	query := `
+		INSERT INTO payment.payout_audit (payout_id, action, actor_id, from_status, to_status, note)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err := r.ExecContext(ctx, query,
+		audit.PayoutID,
+		audit.Action,
+		audit.ActorID,
+		audit.FromStatus,
+		audit.ToStatus,
+		audit.Note,
+	)
+	if err != nil {
+		return fmt.Errorf("add payout audit: %w", err)
+	}
+
+	return nil
+}
+
+// ListPayoutAudit возвращает журнал выплат payoutIDs в порядке записи
+func (r *PaymentRepository) ListPayoutAudit(ctx context.Context, payoutIDs []int64) ([]*entity.PayoutAudit, error) {
+	if len(payoutIDs) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT id, payout_id, action, actor_id, from_status, to_status, note, created_at
+		FROM payment.payout_audit
+		WHERE payout_id = ANY($1::int[])
+		ORDER BY id
+	`
+	var audit []*entity.PayoutAudit
+	if err := r.SelectContext(ctx, &audit, query, formatIDsArray(payoutIDs)); err != nil {
+		return nil, fmt.Errorf("list payout audit: %w", err)
+	}
+
+	return audit, nil
+}
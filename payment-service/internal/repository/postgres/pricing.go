@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+)
+
+// TicketDraws дополняет билеты тиражом и типом лотереи из схем сервисов билетов и тиражей
+func (r *PaymentRepository) TicketDraws(ctx context.Context, ticketIDs []int64) (entity.Tickets, error) {
+	query := `
+		SELECT t.ticket_id AS id, t.draw_id, d.lottery_type
+		FROM ticket.tickets t
+		JOIN draw.draws d ON d.id = t.draw_id
+		WHERE t.ticket_id = ANY($1::int[])
+	`
+	var found entity.Tickets
+	if err := r.SelectContext(ctx, &found, query, formatIDsArray(ticketIDs)); err != nil {
+		return nil, fmt.Errorf("get ticket draws: %w", err)
+	}
+
+	byID := make(map[int64]entity.Ticket, len(found))
+	for _, t := range found {
+		byID[t.ID] = t
+	}
+	tickets := make(entity.Tickets, len(ticketIDs))
+	for i, id := range ticketIDs {
+		t, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("ticket %d: %w", id, entity.ErrInvalidTickets)
+		}
+		tickets[i] = t
+	}
+
+	return tickets, nil
+}
+
+const priceColumns = `id, lottery_type, draw_id, amount, currency, valid_from, valid_to, created_at`
+
+// ActivePrices возвращает цены, действующие в now
+func (r *PaymentRepository) ActivePrices(ctx context.Context, now time.Time) ([]*entity.Price, error) {
+	query := `
+		SELECT ` + priceColumns + `
+		FROM payment.prices
+		WHERE valid_from <= $1 AND (valid_to IS NULL OR valid_to > $1)
+	`
+	var prices []*entity.Price
+	if err := r.SelectContext(ctx, &prices, query, now); err != nil {
+		return nil, fmt.Errorf("get active prices: %w", err)
+	}
+
+	return prices, nil
+}
+
+func (r *PaymentRepository) CreatePrice(ctx context.Context, price *entity.Price) (*entity.Price, error) {
+	query := `
+		INSERT INTO payment.prices (lottery_type, draw_id, amount, currency, valid_from, valid_to)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + priceColumns
+	var created entity.Price
+	err := r.GetContext(ctx, &created, query,
+		price.LotteryType,
+		price.DrawID,
+		price.Amount,
+		price.Currency,
+		price.ValidFrom,
+		price.ValidTo,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create price: %w", err)
+	}
+
+	return &created, nil
+}
+
+const promotionColumns = `id, name, kind, lottery_type, draw_id, buy, pay, percent, starts_at, ends_at, created_at`
+
+// ActivePromotions возвращает акции, идущие в now
+func (r *PaymentRepository) ActivePromotions(ctx context.Context, now time.Time) ([]*entity.Promotion, error) {
+	query := `
+		SELECT ` + promotionColumns + `
+		FROM payment.promotions
+		WHERE starts_at <= $1 AND ends_at > $1
+		ORDER BY id
+	`
+	var promotions []*entity.Promotion
+	if err := r.SelectContext(ctx, &promotions, query, now); err != nil {
+		return nil, fmt.Errorf("get active promotions: %w", err)
+	}
+
+	return promotions, nil
+}
+
+func (r *PaymentRepository) CreatePromotion(ctx context.Context, promotion *entity.Promotion) (*entity.Promotion, error) {
+	query := `
+		INSERT INTO payment.promotions (name, kind, lottery_type, draw_id, buy, pay, percent, starts_at, ends_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING ` + promotionColumns
+	var created entity.Promotion
+	err := r.GetContext(ctx, &created, query,
+		promotion.Name,
+		promotion.Kind,
+		promotion.LotteryType,
+		promotion.DrawID,
+		promotion.Buy,
+		promotion.Pay,
+		promotion.Percent,
+		promotion.StartsAt,
+		promotion.EndsAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create promotion: %w", err)
+	}
+
+	return &created, nil
+}
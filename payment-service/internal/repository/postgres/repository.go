@@ -2,12 +2,15 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/MaxFando/lms/payment-service/internal/entity"
 	"github.com/MaxFando/lms/payment-service/pkg/sqlxtransaction"
 	"github.com/jmoiron/sqlx"
+	"github.com/shopspring/decimal"
 )
 
 type PaymentRepository struct {
@@ -22,10 +25,12 @@ func New(db *sqlx.DB) *PaymentRepository {
 	}
 }
 
+const invoiceColumns = `id, owner_id, amount, ticket_data, status, register_time, due_date, currency, pricing`
+
 func (r *PaymentRepository) CreateInvoice(ctx context.Context, invoice *entity.Invoice) (int64, error) {
 	query := `
-		INSERT INTO payment.invoices (owner_id, amount, ticket_data, status, register_time, due_date)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO payment.invoices (owner_id, amount, ticket_data, status, register_time, due_date, currency, pricing)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 		RETURNING id
 	`
 
@@ -33,10 +38,12 @@ func (r *PaymentRepository) CreateInvoice(ctx context.Context, invoice *entity.I
 	err := r.GetContext(ctx, &id, query,
 		invoice.OwnerID,
 		invoice.Amount,
-		invoice.Ticket,
+		invoice.Tickets,
 		invoice.Status,
 		invoice.RegisterTime,
 		invoice.DueDate,
+		invoice.Currency,
+		invoice.Pricing,
 	)
 	if err != nil {
 		return 0, fmt.Errorf("create invoice: %w", err)
@@ -45,15 +52,26 @@ func (r *PaymentRepository) CreateInvoice(ctx context.Context, invoice *entity.I
 	return id, nil
 }
 
-func (r *PaymentRepository) GetPendingInvoices(ctx context.Context) ([]*entity.Invoice, error) {
+// ExpireOverdueInvoices переводит в OVERDUE до limit просроченных счетов и возвращает их.
+// Счета, заблокированные другой транзакцией (оплата или соседняя реплика), пропускаются.
+func (r *PaymentRepository) ExpireOverdueInvoices(ctx context.Context, limit int) ([]*entity.Invoice, error) {
 	query := `
-		SELECT id, owner_id, amount, ticket_data, status, register_time, due_date
-		FROM payment.invoices
-		WHERE status = 'PENDING'
+		UPDATE payment.invoices i
+		SET status = 'OVERDUE'
+		FROM (
+			SELECT id
+			FROM payment.invoices
+			WHERE status = 'PENDING' AND due_date <= NOW()
+			ORDER BY due_date
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		) due
+		WHERE i.id = due.id
+		RETURNING i.id, i.owner_id, i.amount, i.ticket_data, i.status, i.register_time, i.due_date, i.currency, i.pricing
 	`
 	var invoices []*entity.Invoice
-	if err := r.SelectContext(ctx, &invoices, query); err != nil {
-		return nil, fmt.Errorf("get pending invoices: %w", err)
+	if err := r.SelectContext(ctx, &invoices, query, limit); err != nil {
+		return nil, fmt.Errorf("expire overdue invoices: %w", err)
 	}
 
 	return invoices, nil
@@ -61,12 +79,15 @@ func (r *PaymentRepository) GetPendingInvoices(ctx context.Context) ([]*entity.I
 
 func (r *PaymentRepository) GetInvoiceByID(ctx context.Context, id int64) (*entity.Invoice, error) {
 	query := `
-		SELECT id, owner_id, amount, ticket_data, status, register_time, due_date
+		SELECT ` + invoiceColumns + `
 		FROM payment.invoices
 		WHERE id = $1
 	`
 	var invoice entity.Invoice
 	if err := r.GetContext(ctx, &invoice, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("invoice %d: %w", id, entity.ErrInvoiceNotFound)
+		}
 		return nil, fmt.Errorf("get invoice: %w", err)
 	}
 
@@ -86,16 +107,67 @@ func (r *PaymentRepository) SetInvoiceStatus(ctx context.Context, id int64, stat
 	return nil
 }
 
-func (r *PaymentRepository) CreatePayment(ctx context.Context, invoiceID int64, status entity.PaymentStatus) (int64, error) {
+func (r *PaymentRepository) CancelInvoice(ctx context.Context, id int64) error {
 	query := `
-		INSERT INTO payment.payments (invoice_id, status, payment_time)
-		VALUES ($1, $2, $3)
+		UPDATE payment.invoices
+		SET status = 'CANCELLED'
+		WHERE id = $1 AND status = 'PENDING'
+	`
+	res, err := r.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("cancel invoice: %w", err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("cancel invoice: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("invoice %d: %w", id, entity.ErrInvoiceNotPending)
+	}
+
+	return nil
+}
+
+// LockInvoice блокирует счёт до конца транзакции
+func (r *PaymentRepository) LockInvoice(ctx context.Context, id int64) (*entity.Invoice, error) {
+	query := `
+		SELECT ` + invoiceColumns + `
+		FROM payment.invoices
+		WHERE id = $1
+		FOR UPDATE
+	`
+	var invoice entity.Invoice
+	if err := r.GetContext(ctx, &invoice, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("invoice %d: %w", id, entity.ErrInvoiceNotFound)
+		}
+		return nil, fmt.Errorf("lock invoice: %w", err)
+	}
+
+	return &invoice, nil
+}
+
+const paymentColumns = `id, invoice_id, status, method, payment_time, gateway_id, COALESCE(amount, 0) AS amount, reason, updated_at`
+
+func (r *PaymentRepository) CreatePayment(ctx context.Context, payment *entity.Payment) (int64, error) {
+	query := `
+		INSERT INTO payment.payments (invoice_id, status, method, amount, reason, payment_time, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $6)
 		RETURNING id
 	`
+	method := payment.Method
+	if method == "" {
+		method = entity.PaymentMethodCard
+	}
+
 	var id int64
 	err := r.GetContext(ctx, &id, query,
-		invoiceID,
-		status,
+		payment.InvoiceID,
+		payment.Status,
+		method,
+		payment.Amount,
+		payment.Reason,
 		time.Now(),
 	)
 	if err != nil {
@@ -103,3 +175,295 @@ func (r *PaymentRepository) CreatePayment(ctx context.Context, invoiceID int64,
 	}
 	return id, nil
 }
+
+// AuthorizePayment сохраняет идентификатор авторизации эквайера
+func (r *PaymentRepository) AuthorizePayment(ctx context.Context, id int64, gatewayID int64) error {
+	query := `
+		UPDATE payment.payments
+		SET status = 'AUTHORIZED', gateway_id = $2, updated_at = NOW()
+		WHERE id = $1 AND status = 'AUTHORIZING'
+	`
+	res, err := r.ExecContext(ctx, query, id, gatewayID)
+	if err != nil {
+		return fmt.Errorf("authorize payment: %w", err)
+	}
+
+	return paymentUpdated(res, id)
+}
+
+// SetPaymentStatus переводит платёж из статуса from в to; если платёж уже не в from - ErrPaymentStateChanged
+func (r *PaymentRepository) SetPaymentStatus(ctx context.Context, id int64, from, to entity.PaymentStatus, reason string) error {
+	query := `
+		UPDATE payment.payments
+		SET status = $3, reason = $4, updated_at = NOW()
+		WHERE id = $1 AND status = $2
+	`
+	res, err := r.ExecContext(ctx, query, id, from, to, reason)
+	if err != nil {
+		return fmt.Errorf("set payment status: %w", err)
+	}
+
+	return paymentUpdated(res, id)
+}
+
+func paymentUpdated(res sql.Result, id int64) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("update payment: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("payment %d: %w", id, entity.ErrPaymentStateChanged)
+	}
+
+	return nil
+}
+
+// GetStalePayments возвращает незавершённые платежи, которые не менялись с before
+func (r *PaymentRepository) GetStalePayments(ctx context.Context, before time.Time) ([]*entity.Payment, error) {
+	query := `
+		SELECT ` + paymentColumns + `
+		FROM payment.payments
+		WHERE status IN ('AUTHORIZING', 'AUTHORIZED', 'CAPTURING') AND updated_at < $1
+		ORDER BY id
+	`
+	var payments []*entity.Payment
+	if err := r.SelectContext(ctx, &payments, query, before); err != nil {
+		return nil, fmt.Errorf("get stale payments: %w", err)
+	}
+
+	return payments, nil
+}
+
+func (r *PaymentRepository) GetPaymentByID(ctx context.Context, id int64) (*entity.Payment, error) {
+	query := `
+		SELECT ` + paymentColumns + `
+		FROM payment.payments
+		WHERE id = $1
+	`
+	var payment entity.Payment
+	if err := r.GetContext(ctx, &payment, query, id); err != nil {
+		return nil, fmt.Errorf("get payment: %w", err)
+	}
+
+	return &payment, nil
+}
+
+// GetCapturedPayment возвращает списанный платёж по счёту
+func (r *PaymentRepository) GetCapturedPayment(ctx context.Context, invoiceID int64) (*entity.Payment, error) {
+	query := `
+		SELECT ` + paymentColumns + `
+		FROM payment.payments
+		WHERE invoice_id = $1 AND status = 'PAID' AND (gateway_id IS NOT NULL OR method = 'WALLET')
+		ORDER BY id DESC
+		LIMIT 1
+	`
+	var payment entity.Payment
+	if err := r.GetContext(ctx, &payment, query, invoiceID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("invoice %d has no captured payment: %w", invoiceID, entity.ErrInvoiceNotRefundable)
+		}
+		return nil, fmt.Errorf("get captured payment: %w", err)
+	}
+
+	return &payment, nil
+}
+
+const refundColumns = `id, invoice_id, payment_id, amount, reason, status, gateway_id, attempts, last_error, created_at, updated_at`
+
+func (r *PaymentRepository) CreateRefund(ctx context.Context, refund *entity.Refund) (*entity.Refund, error) {
+	query := `
+		INSERT INTO payment.refunds (invoice_id, payment_id, amount, reason, status)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING ` + refundColumns
+	var created entity.Refund
+	err := r.GetContext(ctx, &created, query,
+		refund.InvoiceID,
+		refund.PaymentID,
+		refund.Amount,
+		refund.Reason,
+		refund.Status,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create refund: %w", err)
+	}
+
+	return &created, nil
+}
+
+// RefundedAmount - сумма возвратов по счёту, кроме несостоявшихся
+func (r *PaymentRepository) RefundedAmount(ctx context.Context, invoiceID int64) (decimal.Decimal, error) {
+	query := `
+		SELECT COALESCE(SUM(amount), 0)
+		FROM payment.refunds
+		WHERE invoice_id = $1 AND status <> 'FAILED'
+	`
+	var amount decimal.Decimal
+	if err := r.GetContext(ctx, &amount, query, invoiceID); err != nil {
+		return decimal.Zero, fmt.Errorf("get refunded amount: %w", err)
+	}
+
+	return amount, nil
+}
+
+func (r *PaymentRepository) HasPendingRefunds(ctx context.Context, invoiceID int64) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM payment.refunds WHERE invoice_id = $1 AND status = 'PENDING')`
+	var exists bool
+	if err := r.GetContext(ctx, &exists, query, invoiceID); err != nil {
+		return false, fmt.Errorf("check pending refunds: %w", err)
+	}
+
+	return exists, nil
+}
+
+// HasRefund сообщает, есть ли по счёту возврат с причиной reason, кроме несостоявшихся
+func (r *PaymentRepository) HasRefund(ctx context.Context, invoiceID int64, reason string) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM payment.refunds WHERE invoice_id = $1 AND reason = $2 AND status <> 'FAILED')`
+	var exists bool
+	if err := r.GetContext(ctx, &exists, query, invoiceID, reason); err != nil {
+		return false, fmt.Errorf("check refund: %w", err)
+	}
+
+	return exists, nil
+}
+
+// LockClosedDraws блокирует тиражи билетов от смены статуса до конца транзакции
+// и возвращает те из них, что уже не ACTIVE
+func (r *PaymentRepository) LockClosedDraws(ctx context.Context, ticketIDs []int64) ([]int64, error) {
+	query := `
+		SELECT d.id, d.status
+		FROM draw.draws d
+		WHERE d.id IN (SELECT t.draw_id FROM ticket.tickets t WHERE t.ticket_id = ANY($1::int[]))
+		ORDER BY d.id
+		FOR SHARE
+	`
+	var draws []struct {
+		ID     int64  `db:"id"`
+		Status string `db:"status"`
+	}
+	if err := r.SelectContext(ctx, &draws, query, formatIDsArray(ticketIDs)); err != nil {
+		return nil, fmt.Errorf("lock ticket draws: %w", err)
+	}
+
+	var closed []int64
+	for _, d := range draws {
+		if d.Status != "ACTIVE" {
+			closed = append(closed, d.ID)
+		}
+	}
+	return closed, nil
+}
+
+// CompleteRefund помечает возврат проведённым; gatewayID - идентификатор возврата у эквайера, nil для возврата на кошелёк
+func (r *PaymentRepository) CompleteRefund(ctx context.Context, id int64, gatewayID *int64) error {
+	query := `
+		UPDATE payment.refunds
+		SET status = 'SUCCEEDED', gateway_id = $2, attempts = attempts + 1, last_error = '', updated_at = NOW()
+		WHERE id = $1 AND status = 'PENDING'
+	`
+	res, err := r.ExecContext(ctx, query, id, gatewayID)
+	if err != nil {
+		return fmt.Errorf("complete refund: %w", err)
+	}
+
+	return refundUpdated(res, id)
+}
+
+// RecordRefundAttempt сохраняет неудачную попытку возврата; status - PENDING для повтора или FAILED
+func (r *PaymentRepository) RecordRefundAttempt(ctx context.Context, id int64, status entity.RefundStatus, lastError string) error {
+	query := `
+		UPDATE payment.refunds
+		SET status = $2, attempts = attempts + 1, last_error = $3, updated_at = NOW()
+		WHERE id = $1 AND status = 'PENDING'
+	`
+	res, err := r.ExecContext(ctx, query, id, status, lastError)
+	if err != nil {
+		return fmt.Errorf("record refund attempt: %w", err)
+	}
+
+	return refundUpdated(res, id)
+}
+
+func refundUpdated(res sql.Result, id int64) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("update refund: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("refund %d: %w", id, entity.ErrRefundStateChanged)
+	}
+
+	return nil
+}
+
+// GetPendingRefunds возвращает возвраты, ожидающие повтора и не менявшиеся с before
+func (r *PaymentRepository) GetPendingRefunds(ctx context.Context, before time.Time) ([]*entity.Refund, error) {
+	query := `
+		SELECT ` + refundColumns + `
+		FROM payment.refunds
+		WHERE status = 'PENDING' AND updated_at < $1
+		ORDER BY id
+	`
+	var refunds []*entity.Refund
+	if err := r.SelectContext(ctx, &refunds, query, before); err != nil {
+		return nil, fmt.Errorf("get pending refunds: %w", err)
+	}
+
+	return refunds, nil
+}
+
+// ListInvoices возвращает счета по фильтру, от новых к старым
+func (r *PaymentRepository) ListInvoices(ctx context.Context, f entity.InvoiceFilter) ([]*entity.Invoice, error) {
+	var q listQuery
+	if f.OwnerID != nil {
+		q.add("owner_id = $%d", *f.OwnerID)
+	}
+	if len(f.Statuses) > 0 {
+		q.add("status::text = ANY($%d::text[])", formatStatusesArray(f.Statuses))
+	}
+	if f.From != nil {
+		q.add("register_time >= $%d", *f.From)
+	}
+	if f.To != nil {
+		q.add("register_time < $%d", *f.To)
+	}
+
+	query := `SELECT ` + invoiceColumns + ` FROM payment.invoices` + q.tail("register_time", "id", f.After, f.Limit)
+	var invoices []*entity.Invoice
+	if err := r.SelectContext(ctx, &invoices, query, q.args...); err != nil {
+		return nil, fmt.Errorf("list invoices: %w", err)
+	}
+
+	return invoices, nil
+}
+
+// ListPayments возвращает платежи по фильтру вместе с владельцем и билетами счёта, от новых к старым
+func (r *PaymentRepository) ListPayments(ctx context.Context, f entity.PaymentFilter) ([]*entity.PaymentDetails, error) {
+	var q listQuery
+	if f.OwnerID != nil {
+		q.add("i.owner_id = $%d", *f.OwnerID)
+	}
+	if f.InvoiceID != nil {
+		q.add("p.invoice_id = $%d", *f.InvoiceID)
+	}
+	if len(f.Statuses) > 0 {
+		q.add("p.status::text = ANY($%d::text[])", formatStatusesArray(f.Statuses))
+	}
+	if f.From != nil {
+		q.add("p.payment_time >= $%d", *f.From)
+	}
+	if f.To != nil {
+		q.add("p.payment_time < $%d", *f.To)
+	}
+
+	query := `
+		SELECT p.id, p.invoice_id, p.status, p.method, p.payment_time, p.gateway_id, COALESCE(p.amount, 0) AS amount,
+			p.reason, p.updated_at, i.owner_id, i.ticket_data, i.currency
+		FROM payment.payments p
+		JOIN payment.invoices i ON i.id = p.invoice_id` + q.tail("p.payment_time", "p.id", f.After, f.Limit)
+	var payments []*entity.PaymentDetails
+	if err := r.SelectContext(ctx, &payments, query, q.args...); err != nil {
+		return nil, fmt.Errorf("list payments: %w", err)
+	}
+
+	return payments, nil
+}
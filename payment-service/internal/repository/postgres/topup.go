@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+)
+
+const topUpColumns = `id, user_id, amount, status, gateway_id, reason, created_at, updated_at`
+
+func (r *PaymentRepository) CreateTopUp(ctx context.Context, topUp *entity.TopUp) (int64, error) {
+	query := `
+		INSERT INTO payment.top_ups (user_id, amount, status)
+		VALUES ($1, $2, $3)
+		RETURNING id
+	`
+	var id int64
+	if err := r.GetContext(ctx, &id, query, topUp.UserID, topUp.Amount, topUp.Status); err != nil {
+		return 0, fmt.Errorf("create top up: %w", err)
+	}
+	return id, nil
+}
+
+// AuthorizeTopUp сохраняет идентификатор авторизации эквайера
+func (r *PaymentRepository) AuthorizeTopUp(ctx context.Context, id int64, gatewayID int64) error {
+	query := `
+		UPDATE payment.top_ups
+		SET status = 'AUTHORIZED', gateway_id = $2, updated_at = NOW()
+		WHERE id = $1 AND status = 'AUTHORIZING'
+	`
+	res, err := r.ExecContext(ctx, query, id, gatewayID)
+	if err != nil {
+		return fmt.Errorf("authorize top up: %w", err)
+	}
+
+	return topUpUpdated(res, id)
+}
+
+// SetTopUpStatus переводит пополнение из статуса from в to; если оно уже не в from - ErrPaymentStateChanged
+func (r *PaymentRepository) SetTopUpStatus(ctx context.Context, id int64, from, to entity.PaymentStatus, reason string) error {
+	query := `
+		UPDATE payment.top_ups
+		SET status = $3, reason = $4, updated_at = NOW()
+		WHERE id = $1 AND status = $2
+	`
+	res, err := r.ExecContext(ctx, query, id, from, to, reason)
+	if err != nil {
+		return fmt.Errorf("set top up status: %w", err)
+	}
+
+	return topUpUpdated(res, id)
+}
+
+func topUpUpdated(res sql.Result, id int64) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("update top up: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("top up %d: %w", id, entity.ErrPaymentStateChanged)
+	}
+
+	return nil
+}
+
+// GetStaleTopUps возвращает незавершённые пополнения, которые не менялись с before
+func (r *PaymentRepository) GetStaleTopUps(ctx context.Context, before time.Time) ([]*entity.TopUp, error) {
+	query := `
+		SELECT ` + topUpColumns + `
+		FROM payment.top_ups
+		WHERE status IN ('AUTHORIZING', 'AUTHORIZED', 'CAPTURING') AND updated_at < $1
+		ORDER BY id
+	`
+	var topUps []*entity.TopUp
+	if err := r.SelectContext(ctx, &topUps, query, before); err != nil {
+		return nil, fmt.Errorf("get stale top ups: %w", err)
+	}
+
+	return topUps, nil
+}
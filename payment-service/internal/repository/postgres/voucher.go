@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+)
+
+const voucherColumns = `id, code, kind, percent, amount, currency, max_redemptions, per_user_limit, redeemed, expires_at, created_at`
+
+// CreateVoucher создаёт промокод; занятый код - ErrInvalidVoucher
+func (r *PaymentRepository) CreateVoucher(ctx context.Context, voucher *entity.Voucher) (*entity.Voucher, error) {
+	query := `
+		INSERT INTO payment.vouchers (code, kind, percent, amount, currency, max_redemptions, per_user_limit, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (code) DO NOTHING
+		RETURNING ` + voucherColumns
+	var created entity.Voucher
+	err := r.GetContext(ctx, &created, query,
+		voucher.Code,
+		voucher.Kind,
+		voucher.Percent,
+		voucher.Amount,
+		voucher.Currency,
+		voucher.MaxRedemptions,
+		voucher.PerUserLimit,
+		voucher.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: code %q already exists", entity.ErrInvalidVoucher, voucher.Code)
+		}
+		return nil, fmt.Errorf("create voucher: %w", err)
+	}
+
+	return &created, nil
+}
+
+func (r *PaymentRepository) GetVoucherByCode(ctx context.Context, code string) (*entity.Voucher, error) {
+	query := `SELECT ` + voucherColumns + ` FROM payment.vouchers WHERE code = $1`
+	var voucher entity.Voucher
+	if err := r.GetContext(ctx, &voucher, query, code); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("voucher %q: %w", code, entity.ErrVoucherNotFound)
+		}
+		return nil, fmt.Errorf("get voucher: %w", err)
+	}
+
+	return &voucher, nil
+}
+
+// RedeemVoucher занимает одно погашение кода; вызывается в транзакции и держит строку кода до её конца,
+// поэтому параллельные погашения одного кода не превышают MaxRedemptions
+func (r *PaymentRepository) RedeemVoucher(ctx context.Context, code string, now time.Time) (*entity.Voucher, error) {
+	query := `
+		UPDATE payment.vouchers
+		SET redeemed = redeemed + 1
+		WHERE code = $1 AND expires_at > $2 AND (max_redemptions = 0 OR redeemed < max_redemptions)
+		RETURNING ` + voucherColumns
+	var voucher entity.Voucher
+	err := r.GetContext(ctx, &voucher, query, code, now)
+	if err == nil {
+		return &voucher, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("redeem voucher: %w", err)
+	}
+
+	existing, err := r.GetVoucherByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if !existing.ExpiresAt.After(now) {
+		return nil, fmt.Errorf("voucher %q: %w", code, entity.ErrVoucherExpired)
+	}
+	return nil, fmt.Errorf("voucher %q: %w", code, entity.ErrVoucherExhausted)
+}
+
+// UserRedemptions - сколько раз пользователь погасил код, не считая возвращённых погашений
+func (r *PaymentRepository) UserRedemptions(ctx context.Context, voucherID int64, userID int64) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM payment.voucher_redemptions
+		WHERE voucher_id = $1 AND user_id = $2 AND released_at IS NULL
+	`
+	var n int
+	if err := r.GetContext(ctx, &n, query, voucherID, userID); err != nil {
+		return 0, fmt.Errorf("count voucher redemptions: %w", err)
+	}
+
+	return n, nil
+}
+
+func (r *PaymentRepository) CreateRedemption(ctx context.Context, redemption *entity.VoucherRedemption) error {
+	query := `
+		INSERT INTO payment.voucher_redemptions (voucher_id, user_id, invoice_id, discount)
+		VALUES ($1, $2, $3, $4)
+	`
+	_, err := r.ExecContext(ctx, query,
+		redemption.VoucherID,
+		redemption.UserID,
+		redemption.InvoiceID,
+		redemption.Discount,
+	)
+	if err != nil {
+		return fmt.Errorf("create voucher redemption: %w", err)
+	}
+
+	return nil
+}
+
+// ReleaseRedemptions возвращает погашения кодов по неоплаченным счетам invoiceIDs
+func (r *PaymentRepository) ReleaseRedemptions(ctx context.Context, invoiceIDs []int64) error {
+	if len(invoiceIDs) == 0 {
+		return nil
+	}
+
+	query := `
+		WITH released AS (
+			UPDATE payment.voucher_redemptions
+			SET released_at = NOW()
+			WHERE invoice_id = ANY($1::int[]) AND released_at IS NULL
+			RETURNING voucher_id
+		), counts AS (
+			SELECT voucher_id, COUNT(*) AS n FROM released GROUP BY voucher_id
+		)
+		UPDATE payment.vouchers v
+		SET redeemed = v.redeemed - counts.n
+		FROM counts
+		WHERE v.id = counts.voucher_id
+	`
+	if _, err := r.ExecContext(ctx, query, formatIDsArray(invoiceIDs)); err != nil {
+		return fmt.Errorf("release voucher redemptions: %w", err)
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/MaxFando/lms/payment-service/internal/entity"
 	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
 )
 
 type Publisher struct {
@@ -33,14 +34,20 @@ func (p *Publisher) Close() error {
 }
 
 type event struct {
-	Type     entity.EventType `json:"type"`
-	TicketID int64            `json:"ticket_id"`
+	Type      entity.EventType `json:"type"`
+	InvoiceID int64            `json:"invoice_id"`
+	TicketIDs []int64          `json:"ticket_ids"`
+	UserID    int64            `json:"user_id"`
+	Amount    decimal.Decimal  `json:"amount"`
 }
 
 func (p *Publisher) PublishInvoice(ctx context.Context, invoice *entity.Invoice, eventType entity.EventType) error {
 	data, err := json.Marshal(event{
-		Type:     eventType,
-		TicketID: invoice.Ticket.ID,
+		Type:      eventType,
+		InvoiceID: invoice.ID,
+		TicketIDs: invoice.Tickets.IDs(),
+		UserID:    invoice.OwnerID,
+		Amount:    invoice.Amount,
 	})
 
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/MaxFando/lms/payment-service/internal/entity"
 	"github.com/go-redis/redismock/v9"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -22,14 +23,21 @@ func TestPublisher_PublishInvoice(t *testing.T) {
 	ctx := context.Background()
 
 	invoice := &entity.Invoice{
-		Ticket: &entity.Ticket{
-			ID: 111111,
+		ID:      42,
+		OwnerID: 7,
+		Amount:  decimal.NewFromInt(200),
+		Tickets: entity.Tickets{
+			{ID: 111111},
+			{ID: 222222},
 		},
 	}
 
 	data, err := json.Marshal(&event{
-		Type:     entity.EventTypeInvoiceOverdue,
-		TicketID: 111111,
+		Type:      entity.EventTypeInvoiceOverdue,
+		InvoiceID: 42,
+		TicketIDs: []int64{111111, 222222},
+		UserID:    7,
+		Amount:    decimal.NewFromInt(200),
 	})
 	assert.NoError(t, err)
 
@@ -0,0 +1,150 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+	"github.com/MaxFando/lms/platform/auth"
+	"github.com/MaxFando/lms/platform/logger"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	idempotencyMetadataKey = "idempotency-key"
+	idempotencyField       = "idempotency_key"
+	maxIdempotencyKeyLen   = 255
+)
+
+type IdempotencyStore interface {
+	Acquire(ctx context.Context, method string, userID int64, key, fingerprint string) (*entity.IdempotencyRecord, error)
+	Complete(ctx context.Context, method string, userID int64, key, responseType string, response []byte) error
+	Release(ctx context.Context, method string, userID int64, key string) error
+}
+
+// IdempotencyUnaryInterceptor для перечисленных методов выполняет запрос с ключом идемпотентности
+// один раз: повтор с тем же ключом и теми же данными получает сохранённый ответ, повтор с другими
+// данными отклоняется. Ключ берётся из метаданных idempotency-key или поля idempotency_key запроса
+// и действует только для вызывающего из access-токена: ключи разных пользователей не пересекаются,
+// а запрос с ключом без токена отклоняется.
+// Запросы, завершившиеся ошибкой, не сохраняются, и их можно повторить с тем же ключом.
+func IdempotencyUnaryInterceptor(logger logger.Logger, store IdempotencyStore, methods ...string) grpc.UnaryServerInterceptor {
+	guarded := make(map[string]struct{}, len(methods))
+	for _, m := range methods {
+		guarded[m] = struct{}{}
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := guarded[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+		key := idempotencyKey(ctx, msg)
+		if key == "" {
+			return handler(ctx, req)
+		}
+		if len(key) > maxIdempotencyKeyLen {
+			return nil, status.Error(codes.InvalidArgument, "idempotency key is too long")
+		}
+
+		fingerprint, err := requestFingerprint(msg)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "fingerprint request: %v", err)
+		}
+
+		caller, ok := auth.FromContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "idempotency key requires an access token")
+		}
+		userID := caller.UserID
+
+		record, err := store.Acquire(ctx, info.FullMethod, userID, key, fingerprint)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "idempotency: %v", err)
+		}
+		if record != nil {
+			return replay(record, fingerprint)
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if relErr := store.Release(ctx, info.FullMethod, userID, key); relErr != nil {
+				logger.Error(ctx, "failed to release idempotency key", "method", info.FullMethod, "error", relErr)
+			}
+			return resp, err
+		}
+
+		if out, ok := resp.(proto.Message); ok {
+			data, mErr := proto.Marshal(out)
+			if mErr == nil {
+				mErr = store.Complete(ctx, info.FullMethod, userID, key, string(out.ProtoReflect().Descriptor().FullName()), data)
+			}
+			if mErr != nil {
+				logger.Error(ctx, "failed to save idempotent response", "method", info.FullMethod, "error", mErr)
+			}
+		}
+
+		return resp, nil
+	}
+}
+
+func idempotencyKey(ctx context.Context, req proto.Message) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(idempotencyMetadataKey); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+
+	m := req.ProtoReflect()
+	if fd := m.Descriptor().Fields().ByName(idempotencyField); fd != nil && fd.Kind() == protoreflect.StringKind {
+		return m.Get(fd).String()
+	}
+	return ""
+}
+
+// requestFingerprint - хеш данных запроса без самого ключа
+func requestFingerprint(req proto.Message) (string, error) {
+	clone := proto.Clone(req)
+	m := clone.ProtoReflect()
+	if fd := m.Descriptor().Fields().ByName(idempotencyField); fd != nil {
+		m.Clear(fd)
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(clone)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func replay(record *entity.IdempotencyRecord, fingerprint string) (any, error) {
+	if record.Fingerprint != fingerprint {
+		return nil, status.Error(codes.InvalidArgument, "idempotency key was used with a different request")
+	}
+	if record.Status != entity.IdempotencyCompleted {
+		return nil, status.Error(codes.Aborted, "request with this idempotency key is in progress")
+	}
+
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(record.ResponseType))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "idempotency: unknown response type %q", record.ResponseType)
+	}
+	resp := mt.New().Interface()
+	if err := proto.Unmarshal(record.Response, resp); err != nil {
+		return nil, status.Errorf(codes.Internal, "idempotency: decode response: %v", err)
+	}
+	return resp, nil
+}
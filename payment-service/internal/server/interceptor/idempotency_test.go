@@ -0,0 +1,140 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	api "github.com/MaxFando/lms/payment-service/api/grpc/gen/go/payment-service/v1"
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+	"github.com/MaxFando/lms/platform/auth"
+	"github.com/MaxFando/lms/platform/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type memoryStore struct {
+	records map[string]*entity.IdempotencyRecord
+}
+
+func recordKey(method string, userID int64, key string) string {
+	return fmt.Sprintf("%s/%d/%s", method, userID, key)
+}
+
+func (s *memoryStore) Acquire(_ context.Context, method string, userID int64, key, fingerprint string) (*entity.IdempotencyRecord, error) {
+	if r, ok := s.records[recordKey(method, userID, key)]; ok {
+		return r, nil
+	}
+	s.records[recordKey(method, userID, key)] = &entity.IdempotencyRecord{
+		Method: method, UserID: userID, Key: key, Fingerprint: fingerprint, Status: entity.IdempotencyInProgress,
+	}
+	return nil, nil
+}
+
+func (s *memoryStore) Complete(_ context.Context, method string, userID int64, key, responseType string, response []byte) error {
+	r := s.records[recordKey(method, userID, key)]
+	r.Status, r.ResponseType, r.Response = entity.IdempotencyCompleted, responseType, response
+	return nil
+}
+
+func (s *memoryStore) Release(_ context.Context, method string, userID int64, key string) error {
+	delete(s.records, recordKey(method, userID, key))
+	return nil
+}
+
+const method = api.PaymentService_CreateInvoice_FullMethodName
+
+func TestIdempotencyUnaryInterceptor(t *testing.T) {
+	store := &memoryStore{records: make(map[string]*entity.IdempotencyRecord)}
+	intercept := IdempotencyUnaryInterceptor(logger.NewLogger(), store, method)
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+
+	calls := 0
+	var fail error
+	handler := func(context.Context, any) (any, error) {
+		calls++
+		if fail != nil {
+			return nil, fail
+		}
+		return &api.CreateInvoiceResponse{Id: int64(calls)}, nil
+	}
+
+	ctx := auth.WithIdentity(context.Background(), auth.Identity{UserID: 1, Role: auth.RoleUser})
+	req := &api.CreateInvoiceRequest{UserId: 1, TicketIds: []int64{10}, IdempotencyKey: "k1"}
+
+	resp, err := intercept(ctx, req, info, handler)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, resp.(*api.CreateInvoiceResponse).Id)
+
+	t.Run("repeat returns saved response", func(t *testing.T) {
+		resp, err := intercept(ctx, proto.Clone(req), info, handler)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, resp.(*api.CreateInvoiceResponse).Id)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("key from metadata", func(t *testing.T) {
+		mdCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("idempotency-key", "k1"))
+		resp, err := intercept(mdCtx, &api.CreateInvoiceRequest{UserId: 1, TicketIds: []int64{10}}, info, handler)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, resp.(*api.CreateInvoiceResponse).Id)
+	})
+
+	t.Run("different payload rejected", func(t *testing.T) {
+		_, err := intercept(ctx, &api.CreateInvoiceRequest{UserId: 1, TicketIds: []int64{11}, IdempotencyKey: "k1"}, info, handler)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("in progress", func(t *testing.T) {
+		store.records[recordKey(method, 1, "k2")] = &entity.IdempotencyRecord{Status: entity.IdempotencyInProgress, Fingerprint: mustFingerprint(t, req)}
+		_, err := intercept(ctx, &api.CreateInvoiceRequest{UserId: 1, TicketIds: []int64{10}, IdempotencyKey: "k2"}, info, handler)
+		assert.Equal(t, codes.Aborted, status.Code(err))
+	})
+
+	t.Run("failed request can be retried", func(t *testing.T) {
+		fail = errors.New("boom")
+		retry := &api.CreateInvoiceRequest{UserId: 2, TicketIds: []int64{20}, IdempotencyKey: "k3"}
+		_, err := intercept(ctx, retry, info, handler)
+		require.Error(t, err)
+
+		fail = nil
+		_, err = intercept(ctx, retry, info, handler)
+		require.NoError(t, err)
+	})
+
+	t.Run("keys of different users do not collide", func(t *testing.T) {
+		before := calls
+		userCtx := auth.WithIdentity(ctx, auth.Identity{UserID: 7, Role: auth.RoleUser})
+		resp, err := intercept(userCtx, proto.Clone(req), info, handler)
+		require.NoError(t, err)
+		assert.Equal(t, before+1, calls)
+		assert.EqualValues(t, calls, resp.(*api.CreateInvoiceResponse).Id)
+	})
+
+	t.Run("anonymous key rejected", func(t *testing.T) {
+		before := calls
+		_, err := intercept(context.Background(), proto.Clone(req), info, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Equal(t, before, calls)
+	})
+
+	t.Run("no key", func(t *testing.T) {
+		before := calls
+		_, err := intercept(context.Background(), &api.CreateInvoiceRequest{UserId: 1, TicketIds: []int64{10}}, info, handler)
+		require.NoError(t, err)
+		assert.Equal(t, before+1, calls)
+	})
+}
+
+func mustFingerprint(t *testing.T, req proto.Message) string {
+	t.Helper()
+	f, err := requestFingerprint(req)
+	require.NoError(t, err)
+	return f
+}
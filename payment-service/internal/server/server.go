@@ -7,6 +7,7 @@ import (
 
 	paymentservicev1 "github.com/MaxFando/lms/payment-service/api/grpc/gen/go/payment-service/v1"
 
+	"github.com/MaxFando/lms/platform/auth"
 	"github.com/MaxFando/lms/platform/logger"
 
 	"github.com/MaxFando/lms/payment-service/internal/server/interceptor"
@@ -35,14 +36,14 @@ type Server struct {
 	errors chan error
 }
 
-func NewServer(logger logger.Logger, serviceServer *v1.Server) *Server {
+func NewServer(logger logger.Logger, serviceServer *v1.Server, jwtSecret string, idempotency interceptor.IdempotencyStore) *Server {
 	srv := new(Server)
 
 	srv.grpcPort = defaultGRPCPort
 	srv.errors = make(chan error, 1)
 	srv.logger = logger
 
-	srv.grpcServer = initGRPCServer(logger, serviceServer)
+	srv.grpcServer = initGRPCServer(logger, serviceServer, jwtSecret, idempotency)
 
 	return srv
 }
@@ -103,10 +104,18 @@ func (s *Server) serveGRPC(ctx context.Context) {
 	}
 }
 
-func initGRPCServer(logger logger.Logger, serviceServer *v1.Server) *grpc.Server {
+func initGRPCServer(logger logger.Logger, serviceServer *v1.Server, jwtSecret string, idempotency interceptor.IdempotencyStore) *grpc.Server {
 	server := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			interceptor.PanicRecoveryUnaryInterceptor(logger),
+			auth.UnaryServerInterceptor(jwtSecret),
+			interceptor.IdempotencyUnaryInterceptor(logger, idempotency,
+				paymentservicev1.PaymentService_CreateInvoice_FullMethodName,
+				paymentservicev1.PaymentService_CreateInvoiceInternal_FullMethodName,
+				paymentservicev1.PaymentService_Pay_FullMethodName,
+				paymentservicev1.PaymentService_RefundInvoice_FullMethodName,
+				paymentservicev1.PaymentService_TopUp_FullMethodName,
+			),
 		),
 		grpc.MaxRecvMsgSize(defaultMaxRecvMsgSize),
 		grpc.MaxSendMsgSize(defaultMaxSendMsgSize),
@@ -0,0 +1,177 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	api "github.com/MaxFando/lms/payment-service/api/grpc/gen/go/payment-service/v1"
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+	"github.com/MaxFando/lms/platform/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) GetInvoice(ctx context.Context, req *api.GetInvoiceRequest) (*api.Invoice, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice, payments, err := s.service.GetInvoice(ctx, req.GetInvoiceId())
+	if err != nil {
+		return nil, historyError("GetInvoice", err)
+	}
+	if !caller.CanAccess(invoice.OwnerID) {
+		return nil, status.Error(codes.PermissionDenied, "invoice of another user")
+	}
+
+	resp := toInvoice(invoice)
+	for _, p := range payments {
+		resp.Payments = append(resp.Payments, toPayment(p))
+	}
+	return resp, nil
+}
+
+func (s *Server) ListInvoices(ctx context.Context, req *api.ListInvoicesRequest) (*api.ListInvoicesResponse, error) {
+	ownerID, err := listOwner(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	f := entity.InvoiceFilter{OwnerID: ownerID}
+	for _, st := range req.GetStatuses() {
+		f.Statuses = append(f.Statuses, entity.InvoiceStatus(st))
+	}
+	f.From, f.To, err = timeRange(req.GetCreatedFrom(), req.GetCreatedTo())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	invoices, next, err := s.service.ListInvoices(ctx, f, req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, historyError("ListInvoices", err)
+	}
+
+	resp := &api.ListInvoicesResponse{NextPageToken: next}
+	for _, invoice := range invoices {
+		resp.Invoices = append(resp.Invoices, toInvoice(invoice))
+	}
+	return resp, nil
+}
+
+func (s *Server) ListPayments(ctx context.Context, req *api.ListPaymentsRequest) (*api.ListPaymentsResponse, error) {
+	ownerID, err := listOwner(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	f := entity.PaymentFilter{OwnerID: ownerID}
+	if id := req.GetInvoiceId(); id != 0 {
+		f.InvoiceID = &id
+	}
+	for _, st := range req.GetStatuses() {
+		f.Statuses = append(f.Statuses, entity.PaymentStatus(st))
+	}
+	f.From, f.To, err = timeRange(req.GetCreatedFrom(), req.GetCreatedTo())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	payments, next, err := s.service.ListPayments(ctx, f, req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, historyError("ListPayments", err)
+	}
+
+	resp := &api.ListPaymentsResponse{NextPageToken: next}
+	for _, p := range payments {
+		resp.Payments = append(resp.Payments, toPayment(p))
+	}
+	return resp, nil
+}
+
+func callerIdentity(ctx context.Context) (auth.Identity, error) {
+	id, ok := auth.FromContext(ctx)
+	if !ok {
+		return auth.Identity{}, status.Error(codes.Unauthenticated, "access token required")
+	}
+	return id, nil
+}
+
+// listOwner возвращает владельца, чьи записи видит вызывающий; nil - записи всех пользователей
+func listOwner(ctx context.Context, userID int64) (*int64, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if userID == 0 {
+		if caller.IsAdmin() {
+			return nil, nil
+		}
+		userID = caller.UserID
+	}
+	if !caller.CanAccess(userID) {
+		return nil, status.Error(codes.PermissionDenied, "records of another user")
+	}
+	return &userID, nil
+}
+
+func timeRange(from, to string) (*time.Time, *time.Time, error) {
+	var fromTime, toTime *time.Time
+	if from != "" {
+		t, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return nil, nil, fmt.Errorf("created_from invalid: %w", err)
+		}
+		fromTime = &t
+	}
+	if to != "" {
+		t, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return nil, nil, fmt.Errorf("created_to invalid: %w", err)
+		}
+		toTime = &t
+	}
+	return fromTime, toTime, nil
+}
+
+func historyError(method string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrInvoiceNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrInvalidFilter), errors.Is(err, entity.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", method, err)
+	}
+}
+
+func toInvoice(invoice *entity.Invoice) *api.Invoice {
+	return &api.Invoice{
+		Id:           invoice.ID,
+		UserId:       invoice.OwnerID,
+		Amount:       decimalToMoney(invoice.Amount, invoice.Currency),
+		Status:       string(invoice.Status),
+		TicketIds:    invoice.Tickets.IDs(),
+		RegisterTime: timestamppb.New(invoice.RegisterTime),
+		DueDate:      timestamppb.New(invoice.DueDate),
+		Pricing:      toPricing(&invoice.Pricing),
+	}
+}
+
+func toPayment(p *entity.PaymentDetails) *api.Payment {
+	return &api.Payment{
+		Id:          p.ID,
+		InvoiceId:   p.InvoiceID,
+		UserId:      p.OwnerID,
+		Status:      string(p.Status),
+		Method:      string(p.Method),
+		Amount:      decimalToMoney(p.Amount, p.Currency),
+		Reason:      p.Reason,
+		TicketIds:   p.Tickets.IDs(),
+		PaymentTime: timestamppb.New(p.PaymentTime),
+		UpdatedAt:   timestamppb.New(p.UpdatedAt),
+	}
+}
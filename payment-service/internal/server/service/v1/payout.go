@@ -0,0 +1,122 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	api "github.com/MaxFando/lms/payment-service/api/grpc/gen/go/payment-service/v1"
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+	"github.com/MaxFando/lms/platform/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) ListPayouts(ctx context.Context, req *api.ListPayoutsRequest) (*api.ListPayoutsResponse, error) {
+	if _, err := adminIdentity(ctx); err != nil {
+		return nil, err
+	}
+
+	var f entity.PayoutFilter
+	if id := req.GetUserId(); id != 0 {
+		f.UserID = &id
+	}
+	for _, st := range req.GetStatuses() {
+		f.Statuses = append(f.Statuses, entity.PayoutStatus(st))
+	}
+
+	payouts, next, err := s.service.ListPayouts(ctx, f, req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, payoutError("ListPayouts", err)
+	}
+
+	resp := &api.ListPayoutsResponse{NextPageToken: next}
+	for _, p := range payouts {
+		resp.Payouts = append(resp.Payouts, toPayout(p))
+	}
+	return resp, nil
+}
+
+func (s *Server) ApprovePayout(ctx context.Context, req *api.ApprovePayoutRequest) (*api.Payout, error) {
+	caller, err := adminIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	payout, err := s.service.ApprovePayout(ctx, caller.UserID, req.GetPayoutId(), req.GetNote())
+	if err != nil {
+		return nil, payoutError("ApprovePayout", err)
+	}
+
+	return toPayout(payout), nil
+}
+
+func adminIdentity(ctx context.Context) (auth.Identity, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return auth.Identity{}, err
+	}
+	if !caller.IsAdmin() {
+		return auth.Identity{}, status.Error(codes.PermissionDenied, "admin role required")
+	}
+	return caller, nil
+}
+
+// internalCaller пропускает к внутренним методам только другие сервисы и администраторов
+func internalCaller(ctx context.Context) error {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return err
+	}
+	if !caller.IsService() && !caller.IsAdmin() {
+		return status.Error(codes.PermissionDenied, "service credentials required")
+	}
+	return nil
+}
+
+func payoutError(method string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrInvalidFilter), errors.Is(err, entity.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrPayoutNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrPayoutNotAwaiting):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", method, err)
+	}
+}
+
+func toPayout(p *entity.Payout) *api.Payout {
+	resp := &api.Payout{
+		Id:        p.ID,
+		TicketId:  p.TicketID,
+		UserId:    p.UserID,
+		Amount:    decimalToMoney(p.Amount, entity.DefaultCurrency),
+		Status:    string(p.Status),
+		CreatedAt: timestamppb.New(p.CreatedAt),
+	}
+	if p.SyndicateID != nil {
+		resp.SyndicateId = *p.SyndicateID
+	}
+	if p.DrawID != nil {
+		resp.DrawId = *p.DrawID
+	}
+	if p.EntryID != nil {
+		resp.TransactionId = *p.EntryID
+	}
+	for _, a := range p.Audit {
+		record := &api.PayoutAuditRecord{
+			Action:     string(a.Action),
+			FromStatus: string(a.FromStatus),
+			ToStatus:   string(a.ToStatus),
+			Note:       a.Note,
+			CreatedAt:  timestamppb.New(a.CreatedAt),
+		}
+		if a.ActorID != nil {
+			record.ActorId = *a.ActorID
+		}
+		resp.Audit = append(resp.Audit, record)
+	}
+	return resp
+}
@@ -0,0 +1,152 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	api "github.com/MaxFando/lms/payment-service/api/grpc/gen/go/payment-service/v1"
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) SetPrice(ctx context.Context, req *api.SetPriceRequest) (*api.Price, error) {
+	if _, err := adminIdentity(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetAmount() == nil {
+		return nil, status.Error(codes.InvalidArgument, "amount is required")
+	}
+
+	price := &entity.Price{
+		Amount:   moneyToDecimal(req.GetAmount()),
+		Currency: req.GetAmount().GetCurrencyCode(),
+	}
+	if price.Currency == "" {
+		price.Currency = entity.DefaultCurrency
+	}
+	if lt := req.GetLotteryType(); lt != "" {
+		price.LotteryType = &lt
+	}
+	if id := req.GetDrawId(); id != 0 {
+		price.DrawID = &id
+	}
+	if req.GetValidFrom() != nil {
+		price.ValidFrom = req.GetValidFrom().AsTime()
+	}
+	if req.GetValidTo() != nil {
+		validTo := req.GetValidTo().AsTime()
+		price.ValidTo = &validTo
+	}
+
+	price, err := s.service.SetPrice(ctx, price)
+	if err != nil {
+		return nil, pricingError("SetPrice", err)
+	}
+
+	return toPrice(price), nil
+}
+
+func (s *Server) CreatePromotion(ctx context.Context, req *api.CreatePromotionRequest) (*api.Promotion, error) {
+	if _, err := adminIdentity(ctx); err != nil {
+		return nil, err
+	}
+
+	promotion := &entity.Promotion{
+		Name:     req.GetName(),
+		Kind:     entity.PromotionKind(req.GetKind()),
+		Buy:      int(req.GetBuy()),
+		Pay:      int(req.GetPay()),
+		Percent:  decimal.NewFromFloat(req.GetPercent()),
+		StartsAt: req.GetStartsAt().AsTime(),
+		EndsAt:   req.GetEndsAt().AsTime(),
+	}
+	if lt := req.GetLotteryType(); lt != "" {
+		promotion.LotteryType = &lt
+	}
+	if id := req.GetDrawId(); id != 0 {
+		promotion.DrawID = &id
+	}
+
+	promotion, err := s.service.CreatePromotion(ctx, promotion)
+	if err != nil {
+		return nil, pricingError("CreatePromotion", err)
+	}
+
+	return toPromotion(promotion), nil
+}
+
+func pricingError(method string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrInvalidPrice), errors.Is(err, entity.ErrInvalidPromotion):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", method, err)
+	}
+}
+
+func toPricing(p *entity.Pricing) *api.Pricing {
+	resp := &api.Pricing{
+		Subtotal:  decimalToMoney(p.Subtotal, p.Currency),
+		Promotion: p.Promotion,
+		Discount:  decimalToMoney(p.Discount, p.Currency),
+		Total:     decimalToMoney(p.Total, p.Currency),
+	}
+	if p.PromotionID != nil {
+		resp.PromotionId = *p.PromotionID
+	}
+	if p.Voucher != "" {
+		resp.Voucher = p.Voucher
+		resp.VoucherDiscount = decimalToMoney(p.VoucherDiscount, p.Currency)
+	}
+	for _, l := range p.Lines {
+		resp.Lines = append(resp.Lines, &api.PriceLine{
+			PriceId:     l.PriceID,
+			DrawId:      l.DrawID,
+			LotteryType: l.LotteryType,
+			Quantity:    int32(l.Quantity),
+			UnitPrice:   decimalToMoney(l.UnitPrice, p.Currency),
+		})
+	}
+	return resp
+}
+
+func toPrice(p *entity.Price) *api.Price {
+	resp := &api.Price{
+		Id:        p.ID,
+		Amount:    decimalToMoney(p.Amount, p.Currency),
+		ValidFrom: timestamppb.New(p.ValidFrom),
+	}
+	if p.LotteryType != nil {
+		resp.LotteryType = *p.LotteryType
+	}
+	if p.DrawID != nil {
+		resp.DrawId = *p.DrawID
+	}
+	if p.ValidTo != nil {
+		resp.ValidTo = timestamppb.New(*p.ValidTo)
+	}
+	return resp
+}
+
+func toPromotion(p *entity.Promotion) *api.Promotion {
+	resp := &api.Promotion{
+		Id:       p.ID,
+		Name:     p.Name,
+		Kind:     string(p.Kind),
+		Buy:      int32(p.Buy),
+		Pay:      int32(p.Pay),
+		Percent:  p.Percent.InexactFloat64(),
+		StartsAt: timestamppb.New(p.StartsAt),
+		EndsAt:   timestamppb.New(p.EndsAt),
+	}
+	if p.LotteryType != nil {
+		resp.LotteryType = *p.LotteryType
+	}
+	if p.DrawID != nil {
+		resp.DrawId = *p.DrawID
+	}
+	return resp
+}
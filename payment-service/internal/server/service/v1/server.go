@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"errors"
 
 	api "github.com/MaxFando/lms/payment-service/api/grpc/gen/go/payment-service/v1"
 	"github.com/MaxFando/lms/payment-service/internal/entity"
@@ -10,12 +11,29 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type service interface {
-	CreateInvoice(ctx context.Context, userId int64, ticketId int64) (int64, decimal.Decimal, error)
-	CreateInvoiceForBookedTicket(ctx context.Context, userId int64, ticketId int64) (int64, decimal.Decimal, error)
+	CreateInvoice(ctx context.Context, userId int64, ticketIds []int64, voucherCode string) (*entity.Invoice, error)
+	CreateInvoiceForBookedTickets(ctx context.Context, userId int64, ticketIds []int64, voucherCode string) (*entity.Invoice, error)
+	CancelInvoice(ctx context.Context, invoiceId int64) error
 	Pay(ctx context.Context, userId int64, invoiceId int64, card *entity.Card) error
+	RefundInvoice(ctx context.Context, invoiceId int64, amount decimal.Decimal, currency string, reason string) (*entity.Refund, error)
+	GetInvoice(ctx context.Context, invoiceId int64) (*entity.Invoice, []*entity.PaymentDetails, error)
+	ListInvoices(ctx context.Context, f entity.InvoiceFilter, pageSize int32, pageToken string) ([]*entity.Invoice, string, error)
+	ListPayments(ctx context.Context, f entity.PaymentFilter, pageSize int32, pageToken string) ([]*entity.PaymentDetails, string, error)
+	PayFromWallet(ctx context.Context, userId int64, invoiceId int64) error
+	TopUp(ctx context.Context, userId int64, card *entity.Card, amount decimal.Decimal) (*entity.WalletTransaction, decimal.Decimal, error)
+	CreditWinnings(ctx context.Context, userId int64, amount decimal.Decimal, reference string) (*entity.WalletTransaction, decimal.Decimal, error)
+	GetBalance(ctx context.Context, userId int64) (decimal.Decimal, error)
+	ListTransactions(ctx context.Context, userId int64, pageSize int32, pageToken string) ([]*entity.WalletTransaction, string, error)
+	ListPayouts(ctx context.Context, f entity.PayoutFilter, pageSize int32, pageToken string) ([]*entity.Payout, string, error)
+	ApprovePayout(ctx context.Context, adminId int64, payoutId int64, note string) (*entity.Payout, error)
+	SetPrice(ctx context.Context, price *entity.Price) (*entity.Price, error)
+	CreatePromotion(ctx context.Context, promotion *entity.Promotion) (*entity.Promotion, error)
+	CreateVoucher(ctx context.Context, voucher *entity.Voucher) (*entity.Voucher, error)
+	GetVoucher(ctx context.Context, code string) (*entity.Voucher, error)
 }
 
 type Server struct {
@@ -30,30 +48,66 @@ func NewServer(service service) *Server {
 }
 
 func (s *Server) CreateInvoice(ctx context.Context, req *api.CreateInvoiceRequest) (*api.CreateInvoiceResponse, error) {
-	invoiceID, price, err := s.service.CreateInvoice(ctx, req.GetUserId(), req.GetTicketId())
+	userID, err := invoiceOwner(ctx, req.GetUserId())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, err
+	}
+
+	invoice, err := s.service.CreateInvoice(ctx, userID, ticketIDs(req), req.GetVoucherCode())
+	if err != nil {
+		return nil, invoiceError(err)
 	}
 
 	return &api.CreateInvoiceResponse{
-		Id:    invoiceID,
-		Price: decimalToMoney(price),
+		Id:      invoice.ID,
+		Price:   decimalToMoney(invoice.Amount, invoice.Currency),
+		Pricing: toPricing(&invoice.Pricing),
 	}, nil
 }
 
 func (s *Server) CreateInvoiceInternal(ctx context.Context, req *api.CreateInvoiceRequest) (*api.CreateInvoiceResponse, error) {
-	invoiceID, price, err := s.service.CreateInvoiceForBookedTicket(ctx, req.GetUserId(), req.GetTicketId())
+	if err := internalCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	invoice, err := s.service.CreateInvoiceForBookedTickets(ctx, req.GetUserId(), ticketIDs(req), req.GetVoucherCode())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, invoiceError(err)
 	}
 
 	return &api.CreateInvoiceResponse{
-		Id:    invoiceID,
-		Price: decimalToMoney(price),
+		Id:      invoice.ID,
+		Price:   decimalToMoney(invoice.Amount, invoice.Currency),
+		Pricing: toPricing(&invoice.Pricing),
 	}, nil
 }
 
+func (s *Server) CancelInvoiceInternal(ctx context.Context, req *api.CancelInvoiceRequest) (*emptypb.Empty, error) {
+	if err := internalCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	err := s.service.CancelInvoice(ctx, req.GetInvoiceId())
+	if err != nil {
+		if errors.Is(err, entity.ErrInvoiceNotPending) {
+			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *Server) Pay(ctx context.Context, req *api.PayRequest) (*emptypb.Empty, error) {
+	userID, err := invoiceOwner(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetFromWallet() {
+		return s.payFromWallet(ctx, userID, req.GetInvoiceId())
+	}
+
 	card := &entity.Card{
 		Number:  req.GetCardNumber(),
 		ExpDate: req.GetExpDate(),
@@ -64,22 +118,144 @@ func (s *Server) Pay(ctx context.Context, req *api.PayRequest) (*emptypb.Empty,
 		return nil, status.Errorf(codes.InvalidArgument, "invalid card data: %s", err.Error())
 	}
 
-	err := s.service.Pay(ctx, req.GetUserId(), req.GetInvoiceId(), card)
+	if err := s.service.Pay(ctx, userID, req.GetInvoiceId(), card); err != nil {
+		return nil, payError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) RefundInvoice(ctx context.Context, req *api.RefundInvoiceRequest) (*api.Refund, error) {
+	if _, err := adminIdentity(ctx); err != nil {
+		return nil, err
+	}
+
+	var amount decimal.Decimal
+	if req.GetAmount() != nil {
+		amount = moneyToDecimal(req.GetAmount())
+	}
+
+	refund, err := s.service.RefundInvoice(ctx, req.GetInvoiceId(), amount, req.GetAmount().GetCurrencyCode(), req.GetReason())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, refundError(err)
+	}
+
+	return &api.Refund{
+		Id:        refund.ID,
+		InvoiceId: refund.InvoiceID,
+		Amount:    decimalToMoney(refund.Amount, refund.Currency),
+		Reason:    refund.Reason,
+		Status:    string(refund.Status),
+		Attempts:  int32(refund.Attempts),
+		LastError: refund.LastError,
+		CreatedAt: timestamppb.New(refund.CreatedAt),
+	}, nil
+}
+
+func (s *Server) payFromWallet(ctx context.Context, userID int64, invoiceID int64) (*emptypb.Empty, error) {
+	if err := s.service.PayFromWallet(ctx, userID, invoiceID); err != nil {
+		return nil, payError(err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
-func decimalToMoney(d decimal.Decimal) *money.Money {
+// invoiceOwner возвращает пользователя, на которого выставляется и оплачивается счёт: по умолчанию
+// вызывающий из access-токена, от имени другого пользователя может действовать только администратор
+func invoiceOwner(ctx context.Context, userID int64) (int64, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if userID == 0 {
+		userID = caller.UserID
+	}
+	if !caller.CanAccess(userID) {
+		return 0, status.Error(codes.PermissionDenied, "invoice of another user")
+	}
+	return userID, nil
+}
+
+func ticketIDs(req *api.CreateInvoiceRequest) []int64 {
+	if len(req.GetTicketIds()) > 0 {
+		return req.GetTicketIds()
+	}
+
+	return []int64{req.GetTicketId()}
+}
+
+func invoiceError(err error) error {
+	switch {
+	case errors.Is(err, entity.ErrInvalidTickets):
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrPriceNotFound), errors.Is(err, entity.ErrMixedCurrency):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrVoucherNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrVoucherExpired), errors.Is(err, entity.ErrVoucherNotApplicable),
+		errors.Is(err, entity.ErrCurrencyMismatch):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrVoucherExhausted), errors.Is(err, entity.ErrVoucherUserLimit):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Errorf(codes.Internal, err.Error())
+	}
+}
+
+func payError(err error) error {
+	switch {
+	case errors.Is(err, entity.ErrPaymentDeclined):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrPaymentTimeout):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, entity.ErrInvoiceNotOwned):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, entity.ErrInvoiceNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrInvoiceNotPending), errors.Is(err, entity.ErrInsufficientFunds),
+		errors.Is(err, entity.ErrCurrencyMismatch):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Errorf(codes.Internal, err.Error())
+	}
+}
+
+func refundError(err error) error {
+	switch {
+	case errors.Is(err, entity.ErrInvalidRefundAmount), errors.Is(err, entity.ErrCurrencyMismatch):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrInvoiceNotRefundable), errors.Is(err, entity.ErrDrawClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrInvoiceNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Errorf(codes.Internal, err.Error())
+	}
+}
+
+func decimalToMoney(d decimal.Decimal, currency string) *money.Money {
 	units := d.Truncate(0).IntPart()
 	nanosDecimal := d.Sub(decimal.NewFromInt(units))
 	nanos := nanosDecimal.Mul(decimal.NewFromInt(1_000_000_000)).IntPart()
 
 	return &money.Money{
-		CurrencyCode: "RUB",
+		CurrencyCode: currency,
 		Units:        units,
 		Nanos:        int32(nanos),
 	}
 }
+
+// moneyAmount переводит сумму операции с кошельком в decimal; пустая сумма - ноль, валюта - только валюта кошельков
+func moneyAmount(m *money.Money) (decimal.Decimal, error) {
+	if m == nil {
+		return decimal.Zero, nil
+	}
+	if code := m.GetCurrencyCode(); code != "" && code != entity.DefaultCurrency {
+		return decimal.Zero, status.Errorf(codes.InvalidArgument, "unsupported currency %q", code)
+	}
+	return moneyToDecimal(m), nil
+}
+
+func moneyToDecimal(m *money.Money) decimal.Decimal {
+	return decimal.NewFromInt(m.GetUnits()).Add(decimal.New(int64(m.GetNanos()), -9))
+}
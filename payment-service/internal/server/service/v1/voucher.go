@@ -0,0 +1,84 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	api "github.com/MaxFando/lms/payment-service/api/grpc/gen/go/payment-service/v1"
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) CreateVoucher(ctx context.Context, req *api.CreateVoucherRequest) (*api.Voucher, error) {
+	if _, err := adminIdentity(ctx); err != nil {
+		return nil, err
+	}
+
+	voucher := &entity.Voucher{
+		Code:           req.GetCode(),
+		Kind:           entity.VoucherKind(req.GetKind()),
+		Percent:        decimal.NewFromFloat(req.GetPercent()),
+		MaxRedemptions: int(req.GetMaxRedemptions()),
+		PerUserLimit:   int(req.GetPerUserLimit()),
+		ExpiresAt:      req.GetExpiresAt().AsTime(),
+	}
+	if req.GetAmount() != nil {
+		voucher.Amount = moneyToDecimal(req.GetAmount())
+		voucher.Currency = req.GetAmount().GetCurrencyCode()
+		if voucher.Currency == "" {
+			voucher.Currency = entity.DefaultCurrency
+		}
+	}
+
+	voucher, err := s.service.CreateVoucher(ctx, voucher)
+	if err != nil {
+		return nil, voucherError("CreateVoucher", err)
+	}
+
+	return toVoucher(voucher), nil
+}
+
+func (s *Server) GetVoucher(ctx context.Context, req *api.GetVoucherRequest) (*api.Voucher, error) {
+	if _, err := adminIdentity(ctx); err != nil {
+		return nil, err
+	}
+
+	voucher, err := s.service.GetVoucher(ctx, req.GetCode())
+	if err != nil {
+		return nil, voucherError("GetVoucher", err)
+	}
+
+	return toVoucher(voucher), nil
+}
+
+func voucherError(method string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrInvalidVoucher):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrVoucherNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", method, err)
+	}
+}
+
+func toVoucher(v *entity.Voucher) *api.Voucher {
+	resp := &api.Voucher{
+		Id:             v.ID,
+		Code:           v.Code,
+		Kind:           string(v.Kind),
+		Percent:        v.Percent.InexactFloat64(),
+		MaxRedemptions: int32(v.MaxRedemptions),
+		PerUserLimit:   int32(v.PerUserLimit),
+		Redeemed:       int32(v.Redeemed),
+		ExpiresAt:      timestamppb.New(v.ExpiresAt),
+		CreatedAt:      timestamppb.New(v.CreatedAt),
+	}
+	if v.Kind == entity.VoucherKindFixed {
+		resp.Amount = decimalToMoney(v.Amount, v.Currency)
+	}
+	return resp
+}
@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	api "github.com/MaxFando/lms/payment-service/api/grpc/gen/go/payment-service/v1"
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) TopUp(ctx context.Context, req *api.TopUpRequest) (*api.WalletOperationResponse, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := moneyAmount(req.GetAmount())
+	if err != nil {
+		return nil, err
+	}
+
+	card := &entity.Card{
+		Number:  req.GetCardNumber(),
+		ExpDate: req.GetExpDate(),
+		CVV:     req.GetCVV(),
+	}
+	if err := card.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid card data: %s", err.Error())
+	}
+
+	tx, balance, err := s.service.TopUp(ctx, caller.UserID, card, amount)
+	if err != nil {
+		return nil, walletError("TopUp", err)
+	}
+
+	return &api.WalletOperationResponse{
+		Transaction: toWalletTransaction(tx),
+		Balance:     decimalToMoney(balance, entity.DefaultCurrency),
+	}, nil
+}
+
+func (s *Server) CreditWinningsInternal(ctx context.Context, req *api.CreditWinningsRequest) (*api.WalletOperationResponse, error) {
+	if err := internalCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	amount, err := moneyAmount(req.GetAmount())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, balance, err := s.service.CreditWinnings(ctx, req.GetUserId(), amount, req.GetReference())
+	if err != nil {
+		return nil, walletError("CreditWinningsInternal", err)
+	}
+
+	return &api.WalletOperationResponse{
+		Transaction: toWalletTransaction(tx),
+		Balance:     decimalToMoney(balance, entity.DefaultCurrency),
+	}, nil
+}
+
+func (s *Server) GetBalance(ctx context.Context, req *api.GetBalanceRequest) (*api.Balance, error) {
+	userID, err := walletOwner(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := s.service.GetBalance(ctx, userID)
+	if err != nil {
+		return nil, walletError("GetBalance", err)
+	}
+
+	return &api.Balance{UserId: userID, Balance: decimalToMoney(balance, entity.DefaultCurrency)}, nil
+}
+
+func (s *Server) ListTransactions(ctx context.Context, req *api.ListTransactionsRequest) (*api.ListTransactionsResponse, error) {
+	userID, err := walletOwner(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	txs, next, err := s.service.ListTransactions(ctx, userID, req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, walletError("ListTransactions", err)
+	}
+
+	resp := &api.ListTransactionsResponse{NextPageToken: next}
+	for _, tx := range txs {
+		resp.Transactions = append(resp.Transactions, toWalletTransaction(tx))
+	}
+	return resp, nil
+}
+
+// walletOwner возвращает владельца кошелька, к которому обращается вызывающий; 0 - сам вызывающий
+func walletOwner(ctx context.Context, userID int64) (int64, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if userID == 0 {
+		userID = caller.UserID
+	}
+	if !caller.CanAccess(userID) {
+		return 0, status.Error(codes.PermissionDenied, "wallet of another user")
+	}
+	return userID, nil
+}
+
+func walletError(method string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrInvalidAmount), errors.Is(err, entity.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrInsufficientFunds):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrPaymentDeclined):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrPaymentTimeout):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", method, err)
+	}
+}
+
+func toWalletTransaction(tx *entity.WalletTransaction) *api.WalletTransaction {
+	resp := &api.WalletTransaction{
+		Id:        tx.EntryID,
+		Kind:      string(tx.Kind),
+		Amount:    decimalToMoney(tx.Amount, entity.DefaultCurrency),
+		Reference: tx.Reference,
+		CreatedAt: timestamppb.New(tx.CreatedAt),
+	}
+	if tx.InvoiceID != nil {
+		resp.InvoiceId = *tx.InvoiceID
+	}
+	return resp
+}
@@ -5,60 +5,129 @@ import (
 	"time"
 
 	"github.com/MaxFando/lms/payment-service/internal/entity"
-	"github.com/shopspring/decimal"
 )
 
-func (s *Service) CreateInvoice(ctx context.Context, userId int64, ticketId int64) (int64, decimal.Decimal, error) {
-	ticket, err := s.ticket.BookTicket(ctx, userId, ticketId)
+// CreateInvoice бронирует билеты и выставляет на них счёт; непустой voucherCode даёт скидку по промокоду
+func (s *Service) CreateInvoice(ctx context.Context, userId int64, ticketIds []int64, voucherCode string) (*entity.Invoice, error) {
+	if err := validateTicketIDs(ticketIds); err != nil {
+		return nil, err
+	}
+
+	booked := make(entity.Tickets, 0, len(ticketIds))
+	for _, ticketId := range ticketIds {
+		ticket, err := s.ticket.BookTicket(ctx, userId, ticketId)
+		if err != nil {
+			s.releaseTickets(ctx, &entity.Invoice{OwnerID: userId, Tickets: booked})
+			return nil, err
+		}
+		booked = append(booked, *ticket)
+	}
+
+	invoice, err := s.newInvoice(ctx, userId, ticketIds)
 	if err != nil {
-		return 0, decimal.Zero, err
+		s.releaseTickets(ctx, &entity.Invoice{OwnerID: userId, Tickets: booked})
+		return nil, err
 	}
 
-	registerTime := s.nowFunc()
-	dueDate := registerTime.Add(15 * time.Minute)
-	price := decimal.NewFromInt(s.cfg.TicketPrice)
+	if err := s.storeInvoice(ctx, invoice, voucherCode); err != nil {
+		s.releaseTickets(ctx, invoice)
+		return nil, err
+	}
 
-	invoice := &entity.Invoice{
-		Ticket:       ticket,
-		OwnerID:      userId,
-		Amount:       price,
-		Status:       entity.InvoiceStatusPending,
-		RegisterTime: registerTime,
-		DueDate:      dueDate,
+	return invoice, nil
+}
+
+func (s *Service) CreateInvoiceForBookedTickets(ctx context.Context, userId int64, ticketIds []int64, voucherCode string) (*entity.Invoice, error) {
+	if err := validateTicketIDs(ticketIds); err != nil {
+		return nil, err
 	}
 
-	id, err := s.repo.CreateInvoice(ctx, invoice)
+	invoice, err := s.newInvoice(ctx, userId, ticketIds)
 	if err != nil {
-		publishErr := s.publisher.PublishInvoice(ctx, invoice, entity.EventTypeInvoiceFailure)
-		if publishErr != nil {
-			s.log.Error(ctx, "failed to publish rollback task for ticked", ticketId, publishErr)
-		}
-		return 0, decimal.Zero, err
+		return nil, err
+	}
+
+	if err := s.storeInvoice(ctx, invoice, voucherCode); err != nil {
+		return nil, err
 	}
 
-	return id, price, nil
+	return invoice, nil
 }
 
-func (s *Service) CreateInvoiceForBookedTicket(ctx context.Context, userId int64, ticketId int64) (int64, decimal.Decimal, error) {
+// newInvoice выставляет счёт на билеты по ценам и акциям, действующим на момент выставления
+func (s *Service) newInvoice(ctx context.Context, userId int64, ticketIds []int64) (*entity.Invoice, error) {
+	tickets, err := s.repo.TicketDraws(ctx, ticketIds)
+	if err != nil {
+		return nil, err
+	}
+
 	registerTime := s.nowFunc()
-	dueDate := registerTime.Add(15 * time.Minute)
-	price := decimal.NewFromInt(s.cfg.TicketPrice)
+	pricing, err := s.quote(ctx, tickets, registerTime)
+	if err != nil {
+		return nil, err
+	}
 
-	invoice := &entity.Invoice{
-		Ticket: &entity.Ticket{
-			ID: ticketId,
-		},
+	return &entity.Invoice{
+		Tickets:      tickets,
 		OwnerID:      userId,
-		Amount:       price,
+		Amount:       pricing.Total,
+		Currency:     pricing.Currency,
+		Pricing:      *pricing,
 		Status:       entity.InvoiceStatusPending,
 		RegisterTime: registerTime,
-		DueDate:      dueDate,
+		DueDate:      registerTime.Add(15 * time.Minute),
+	}, nil
+}
+
+// CancelInvoice отменяет неоплаченный счёт, покупку по которому ticket-service не смог завершить,
+// и возвращает погашенный им промокод
+func (s *Service) CancelInvoice(ctx context.Context, invoiceId int64) error {
+	return s.inTransaction(ctx, func(tx context.Context) error {
+		if err := s.repo.CancelInvoice(tx, invoiceId); err != nil {
+			return err
+		}
+		return s.repo.ReleaseRedemptions(tx, []int64{invoiceId})
+	})
+}
+
+// releaseTickets снимает бронь с билетов несостоявшегося счёта, а если ticket-service недоступен - публикует событие об ошибке
+func (s *Service) releaseTickets(ctx context.Context, invoice *entity.Invoice) {
+	if len(invoice.Tickets) == 0 {
+		return
+	}
+
+	err := s.ticket.ReleaseTickets(ctx, invoice.Tickets.IDs())
+	if err == nil {
+		return
+	}
+
+	s.log.Error(ctx, "failed to release tickets", "ticketIDs", invoice.Tickets.IDs(), "err", err)
+	s.publishFailure(ctx, invoice)
+}
+
+func (s *Service) publishFailure(ctx context.Context, invoice *entity.Invoice) {
+	if len(invoice.Tickets) == 0 {
+		return
 	}
 
-	id, err := s.repo.CreateInvoice(ctx, invoice)
+	err := s.publisher.PublishInvoice(ctx, invoice, entity.EventTypeInvoiceFailure)
 	if err != nil {
-		return 0, decimal.Zero, err
+		s.log.Error(ctx, "failed to publish rollback task for tickets", "ticketIDs", invoice.Tickets.IDs(), "err", err)
+	}
+}
+
+func validateTicketIDs(ticketIds []int64) error {
+	if len(ticketIds) == 0 {
+		return entity.ErrInvalidTickets
+	}
+
+	seen := make(map[int64]struct{}, len(ticketIds))
+	for _, id := range ticketIds {
+		if _, dup := seen[id]; dup || id <= 0 {
+			return entity.ErrInvalidTickets
+		}
+		seen[id] = struct{}{}
 	}
 
-	return id, price, nil
+	return nil
 }
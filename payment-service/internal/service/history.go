@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+)
+
+// GetInvoice возвращает счёт вместе со всеми попытками его оплаты
+func (s *Service) GetInvoice(ctx context.Context, invoiceId int64) (*entity.Invoice, []*entity.PaymentDetails, error) {
+	invoice, err := s.repo.GetInvoiceByID(ctx, invoiceId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payments, err := s.repo.ListPayments(ctx, entity.PaymentFilter{InvoiceID: &invoice.ID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return invoice, payments, nil
+}
+
+func (s *Service) ListInvoices(ctx context.Context, f entity.InvoiceFilter, pageSize int32, pageToken string) ([]*entity.Invoice, string, error) {
+	for _, st := range f.Statuses {
+		if !st.Valid() {
+			return nil, "", entity.ErrInvalidFilter
+		}
+	}
+	size, after, err := preparePage(f.From, f.To, pageSize, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	f.Limit, f.After = size+1, after
+
+	invoices, err := s.repo.ListInvoices(ctx, f)
+	if err != nil {
+		return nil, "", err
+	}
+
+	invoices, next := cutPage(invoices, size, func(i *entity.Invoice) entity.Cursor {
+		return entity.Cursor{Time: i.RegisterTime, ID: i.ID}
+	})
+	return invoices, next, nil
+}
+
+func (s *Service) ListPayments(ctx context.Context, f entity.PaymentFilter, pageSize int32, pageToken string) ([]*entity.PaymentDetails, string, error) {
+	for _, st := range f.Statuses {
+		if !st.Valid() {
+			return nil, "", entity.ErrInvalidFilter
+		}
+	}
+	size, after, err := preparePage(f.From, f.To, pageSize, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	f.Limit, f.After = size+1, after
+
+	payments, err := s.repo.ListPayments(ctx, f)
+	if err != nil {
+		return nil, "", err
+	}
+
+	payments, next := cutPage(payments, size, func(p *entity.PaymentDetails) entity.Cursor {
+		return entity.Cursor{Time: p.PaymentTime, ID: p.ID}
+	})
+	return payments, next, nil
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+// metrics - счётчики фоновых задач; пишутся в глобальный MeterProvider OpenTelemetry
+type metrics struct {
+	overdueProcessed    metric.Int64Counter
+	invoiceEventsFailed metric.Int64Counter
+}
+
+func newMetrics() *metrics {
+	meter := otel.Meter("github.com/MaxFando/lms/payment-service")
+
+	return &metrics{
+		overdueProcessed:    int64Counter(meter, "payment.invoices.overdue.processed", "Счета, переведённые в OVERDUE"),
+		invoiceEventsFailed: int64Counter(meter, "payment.invoices.events.failed", "События о счетах, которые не удалось опубликовать"),
+	}
+}
+
+func int64Counter(meter metric.Meter, name, description string) metric.Int64Counter {
+	counter, err := meter.Int64Counter(name, metric.WithDescription(description))
+	if err != nil {
+		return noop.Int64Counter{}
+	}
+	return counter
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+// preparePage проверяет границы времени, приводит размер страницы и разбирает курсор из токена.
+// Лимит запрашивается на один больше, чтобы узнать, есть ли следующая страница.
+func preparePage(from, to *time.Time, pageSize int32, pageToken string) (int, *entity.Cursor, error) {
+	if from != nil && to != nil && !from.Before(*to) {
+		return 0, nil, entity.ErrInvalidFilter
+	}
+
+	size := int(pageSize)
+	switch {
+	case size <= 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+
+	if pageToken == "" {
+		return size, nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return 0, nil, entity.ErrInvalidPageToken
+	}
+	var c entity.Cursor
+	if err := json.Unmarshal(raw, &c); err != nil || c.ID == 0 {
+		return 0, nil, entity.ErrInvalidPageToken
+	}
+	return size, &c, nil
+}
+
+// cutPage обрезает выборку до размера страницы и возвращает токен следующей страницы
+func cutPage[T any](items []T, size int, cursor func(T) entity.Cursor) ([]T, string) {
+	if len(items) <= size {
+		return items, ""
+	}
+	items = items[:size]
+	raw, _ := json.Marshal(cursor(items[size-1]))
+	return items, base64.RawURLEncoding.EncodeToString(raw)
+}
@@ -3,69 +3,210 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/MaxFando/lms/payment-service/internal/entity"
 )
 
+// Pay проводит оплату счёта в две фазы: сумма авторизуется на карте, затем под блокировкой
+// счёта проверяется, что его ещё можно оплатить, и только после фиксации счёта в базе
+// деньги списываются. Если счёт оплатить нельзя, авторизация отменяется.
+// Каждая фаза сохраняется в payment.payments, прерванные платежи доводит ResumePayments.
 func (s *Service) Pay(ctx context.Context, userId int64, invoiceId int64, card *entity.Card) error {
-	transactionID, err := s.payer.Pay(ctx, card)
+	invoice, err := s.repo.GetInvoiceByID(ctx, invoiceId)
 	if err != nil {
 		return err
 	}
+	if err := checkPayable(invoice, userId); err != nil {
+		return err
+	}
 
-	tx, err := s.repo.BeginTransaction(ctx)
+	payment := &entity.Payment{
+		InvoiceID: invoiceId,
+		Status:    entity.PaymentStatusAuthorizing,
+		Amount:    invoice.Amount,
+	}
+	payment.ID, err = s.repo.CreatePayment(ctx, payment)
 	if err != nil {
 		return err
 	}
 
-	err = s.processPayment(tx, userId, invoiceId)
+	gatewayID, err := s.payer.Authorize(ctx, payment.Reference(), card, invoice.Amount)
+	if errors.Is(err, entity.ErrPaymentTimeout) {
+		// эквайер мог авторизовать платёж: его найдёт по reference и отменит ResumePayments
+		s.log.Error(ctx, "authorization result is unknown", "paymentID", payment.ID, "err", err)
+		return err
+	}
 	if err != nil {
-		s.processRollback(tx, transactionID, invoiceId)
-
-		_, rbErr := s.repo.CreatePayment(ctx, invoiceId, entity.PaymentStatusRejected)
-		if rbErr != nil {
-			s.log.Error(ctx, "failed to create payment", "invoiceID", invoiceId, "err", rbErr)
-		}
+		s.setPaymentStatus(ctx, payment, entity.PaymentStatusRejected, err.Error())
+		return err
+	}
+	payment.GatewayID = &gatewayID
 
+	if err := s.repo.AuthorizePayment(ctx, payment.ID, gatewayID); err != nil {
+		s.voidPayment(ctx, payment, err.Error())
 		return err
 	}
+	payment.Status = entity.PaymentStatusAuthorized
 
-	return s.repo.CommitTransaction(tx)
+	return s.completePayment(ctx, payment, userId)
 }
 
-func (s *Service) processPayment(ctx context.Context, userId int64, invoiceId int64) error {
-	invoice, err := s.repo.GetInvoiceByID(ctx, invoiceId)
+// completePayment помечает счёт оплаченным и списывает авторизованную сумму
+func (s *Service) completePayment(ctx context.Context, payment *entity.Payment, userId int64) error {
+	invoice, err := s.markInvoicePaid(ctx, payment, userId)
 	if err != nil {
+		if !errors.Is(err, entity.ErrPaymentStateChanged) {
+			s.voidPayment(ctx, payment, err.Error())
+		}
 		return err
 	}
-	if invoice.OwnerID != userId {
-		return errors.New("invoice is not owned by user")
+
+	err = s.publisher.PublishInvoice(ctx, invoice, entity.EventTypeInvoicePaid)
+	if err != nil {
+		s.log.Error(ctx, "failed to publish paid invoice", "invoiceID", invoice.ID, "err", err)
 	}
-	if invoice.Status != entity.InvoiceStatusPending {
-		return errors.New("invoice is not pending")
+
+	// счёт уже оплачен: если списание не прошло, его повторит ResumePayments
+	s.capturePayment(ctx, payment)
+
+	return nil
+}
+
+func (s *Service) markInvoicePaid(ctx context.Context, payment *entity.Payment, userId int64) (*entity.Invoice, error) {
+	tx, err := s.repo.BeginTransaction(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	err = s.repo.SetInvoiceStatus(ctx, invoiceId, entity.InvoiceStatusPaid)
+	invoice, err := s.processPayment(tx, payment, userId)
 	if err != nil {
-		return err
+		if rbErr := s.repo.RollbackTransaction(tx); rbErr != nil {
+			s.log.Error(ctx, "failed to rollback invoice", "invoiceID", payment.InvoiceID, "err", rbErr)
+		}
+		return nil, err
 	}
 
-	_, err = s.repo.CreatePayment(ctx, invoiceId, entity.PaymentStatusPaid)
+	if err := s.repo.CommitTransaction(tx); err != nil {
+		return nil, err
+	}
+
+	payment.Status = entity.PaymentStatusCapturing
+	return invoice, nil
+}
+
+func (s *Service) processPayment(ctx context.Context, payment *entity.Payment, userId int64) (*entity.Invoice, error) {
+	invoice, err := s.repo.LockInvoice(ctx, payment.InvoiceID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if err := checkPayable(invoice, userId); err != nil {
+		return nil, err
+	}
+
+	err = s.repo.SetInvoiceStatus(ctx, invoice.ID, entity.InvoiceStatusPaid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.SetPaymentStatus(ctx, payment.ID, entity.PaymentStatusAuthorized, entity.PaymentStatusCapturing, "")
+	if err != nil {
+		return nil, err
+	}
+
+	invoice.Status = entity.InvoiceStatusPaid
+	return invoice, nil
+}
+
+func (s *Service) capturePayment(ctx context.Context, payment *entity.Payment) {
+	if err := s.payer.Capture(ctx, *payment.GatewayID); err != nil {
+		s.log.Error(ctx, "failed to capture payment", "paymentID", payment.ID, "err", err)
+		return
+	}
+
+	s.setPaymentStatus(ctx, payment, entity.PaymentStatusPaid, "")
+}
+
+func (s *Service) voidPayment(ctx context.Context, payment *entity.Payment, reason string) {
+	if err := s.payer.Void(ctx, *payment.GatewayID); err != nil {
+		s.log.Error(ctx, "failed to void payment", "paymentID", payment.ID, "err", err)
+		return
+	}
+
+	s.setPaymentStatus(ctx, payment, entity.PaymentStatusVoided, reason)
+}
+
+func (s *Service) setPaymentStatus(ctx context.Context, payment *entity.Payment, to entity.PaymentStatus, reason string) {
+	if err := s.repo.SetPaymentStatus(ctx, payment.ID, payment.Status, to, reason); err != nil {
+		s.log.Error(ctx, "failed to set payment status", "paymentID", payment.ID, "status", to, "err", err)
+		return
+	}
+	payment.Status = to
+}
+
+// ResumePayments доводит платежи, прерванные падением сервиса: авторизованные проводит
+// дальше, по оплаченным счетам повторяет списание, а авторизации без ответа эквайера
+// ищет у него по reference и отменяет - покупатель уже получил ошибку оплаты.
+// Так же доводятся прерванные пополнения кошелька.
+func (s *Service) ResumePayments(ctx context.Context) error {
+	payments, err := s.repo.GetStalePayments(ctx, s.nowFunc().Add(-s.cfg.PaymentResumeAfter))
+	if err != nil {
+		s.log.Error(ctx, "failed to get stale payments", "err", err)
 	}
 
+	for _, payment := range payments {
+		switch payment.Status {
+		case entity.PaymentStatusAuthorizing:
+			s.resolveAuthorization(ctx, payment)
+		case entity.PaymentStatusAuthorized:
+			invoice, err := s.repo.GetInvoiceByID(ctx, payment.InvoiceID)
+			if err != nil {
+				s.log.Error(ctx, "failed to get invoice of payment", "paymentID", payment.ID, "err", err)
+				continue
+			}
+			if err := s.completePayment(ctx, payment, invoice.OwnerID); err != nil {
+				s.log.Error(ctx, "failed to resume payment", "paymentID", payment.ID, "err", err)
+			}
+		case entity.PaymentStatusCapturing:
+			s.capturePayment(ctx, payment)
+		}
+	}
+
+	s.resumeTopUps(ctx)
+
 	return nil
 }
 
-func (s *Service) processRollback(ctx context.Context, transactionID int64, invoiceId int64) {
-	rbErr := s.payer.Refund(ctx, transactionID)
-	if rbErr != nil {
-		s.log.Error(ctx, "failed to refund invoice for transaction", "transactionId", transactionID, "err", rbErr)
+// resolveAuthorization выясняет у эквайера исход авторизации без ответа: найденную
+// авторизацию отменяет, а если сумма не заблокирована - отклоняет платёж
+func (s *Service) resolveAuthorization(ctx context.Context, payment *entity.Payment) {
+	gatewayID, err := s.payer.FindAuthorization(ctx, payment.Reference())
+	if errors.Is(err, entity.ErrAuthorizationNotFound) {
+		s.setPaymentStatus(ctx, payment, entity.PaymentStatusRejected, "authorization not found at gateway")
+		return
+	}
+	if err != nil {
+		// эквайер ещё не ответил - повторим в следующий запуск
+		s.log.Error(ctx, "failed to find authorization", "paymentID", payment.ID, "err", err)
+		return
 	}
 
-	rbErr = s.repo.RollbackTransaction(ctx)
-	if rbErr != nil {
-		s.log.Error(ctx, "failed to rollback invoice", "invoiceID", invoiceId, "err", rbErr)
+	if err := s.repo.AuthorizePayment(ctx, payment.ID, gatewayID); err != nil {
+		s.log.Error(ctx, "failed to save found authorization", "paymentID", payment.ID, "err", err)
+		return
 	}
+	payment.GatewayID = &gatewayID
+	payment.Status = entity.PaymentStatusAuthorized
+
+	s.voidPayment(ctx, payment, "authorization result was lost")
+}
+
+func checkPayable(invoice *entity.Invoice, userId int64) error {
+	if invoice.OwnerID != userId {
+		return entity.ErrInvoiceNotOwned
+	}
+	if invoice.Status != entity.InvoiceStatusPending {
+		return fmt.Errorf("invoice %d is %s: %w", invoice.ID, invoice.Status, entity.ErrInvoiceNotPending)
+	}
+	return nil
 }
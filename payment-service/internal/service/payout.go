@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+	"github.com/shopspring/decimal"
+)
+
+// HandleWin создаёт выплату выигрыша по билету. Выигрыш не больше PayoutAutoLimit сразу
+// зачисляется на кошелёк, крупный ждёт подтверждения администратора. Повтор события
+// не создаёт вторую выплату.
+func (s *Service) HandleWin(ctx context.Context, win *entity.Payout) error {
+	if err := validateAmount(win.Amount); err != nil {
+		return err
+	}
+
+	win.Status = entity.PayoutStatusAwaitingApproval
+	if !win.Amount.GreaterThan(decimal.NewFromInt(s.cfg.PayoutAutoLimit)) {
+		win.Status = entity.PayoutStatusPending
+	}
+
+	var payout *entity.Payout
+	err := s.inTransaction(ctx, func(tx context.Context) error {
+		var (
+			created bool
+			err     error
+		)
+		payout, created, err = s.repo.CreatePayout(tx, win)
+		if err != nil || !created {
+			return err
+		}
+
+		return s.repo.AddPayoutAudit(tx, &entity.PayoutAudit{
+			PayoutID: payout.ID,
+			Action:   entity.PayoutActionCreated,
+			ToStatus: payout.Status,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	if payout.Status == entity.PayoutStatusPending {
+		s.creditPayout(ctx, payout)
+	}
+	return nil
+}
+
+// ApprovePayout зачисляет крупный выигрыш из очереди подтверждения
+func (s *Service) ApprovePayout(ctx context.Context, adminId int64, payoutId int64, note string) (*entity.Payout, error) {
+	err := s.inTransaction(ctx, func(tx context.Context) error {
+		_, err := s.payPayout(tx, payoutId, entity.PayoutStatusAwaitingApproval, entity.PayoutAudit{
+			Action:  entity.PayoutActionApproved,
+			ActorID: &adminId,
+			Note:    note,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetPayout(ctx, payoutId)
+}
+
+// GetPayout возвращает выплату вместе с её журналом
+func (s *Service) GetPayout(ctx context.Context, payoutId int64) (*entity.Payout, error) {
+	payout, err := s.repo.GetPayoutByID(ctx, payoutId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.attachPayoutAudit(ctx, []*entity.Payout{payout}); err != nil {
+		return nil, err
+	}
+	return payout, nil
+}
+
+func (s *Service) ListPayouts(ctx context.Context, f entity.PayoutFilter, pageSize int32, pageToken string) ([]*entity.Payout, string, error) {
+	for _, st := range f.Statuses {
+		if !st.Valid() {
+			return nil, "", entity.ErrInvalidFilter
+		}
+	}
+	size, after, err := preparePage(nil, nil, pageSize, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	f.Limit, f.After = size+1, after
+
+	payouts, err := s.repo.ListPayouts(ctx, f)
+	if err != nil {
+		return nil, "", err
+	}
+
+	payouts, next := cutPage(payouts, size, func(p *entity.Payout) entity.Cursor {
+		return entity.Cursor{Time: p.CreatedAt, ID: p.ID}
+	})
+	if err := s.attachPayoutAudit(ctx, payouts); err != nil {
+		return nil, "", err
+	}
+	return payouts, next, nil
+}
+
+// RetryPayouts повторяет автоматические выплаты, которые не удалось зачислить с прошлой попытки
+func (s *Service) RetryPayouts(ctx context.Context) error {
+	payouts, err := s.repo.GetPendingPayouts(ctx, s.nowFunc().Add(-s.cfg.PayoutRetryInterval))
+	if err != nil {
+		s.log.Error(ctx, "failed to get pending payouts", "err", err)
+		return nil
+	}
+
+	for _, payout := range payouts {
+		s.creditPayout(ctx, payout)
+	}
+
+	return nil
+}
+
+// ReconcilePayouts сверяет выплаты со списком победителей сервиса билетов: события о выигрышах
+// доставляются не более одного раза, и потерянное создаёт здесь выплату. Сверяются тиражи билетов
+// из счетов за PayoutReconcileWindow; уже созданные выплаты не дублируются.
+func (s *Service) ReconcilePayouts(ctx context.Context) error {
+	draws, err := s.repo.GetInvoicedDraws(ctx, s.nowFunc().Add(-s.cfg.PayoutReconcileWindow))
+	if err != nil {
+		s.log.Error(ctx, "failed to get invoiced draws", "err", err)
+		return nil
+	}
+
+	for _, drawID := range draws {
+		wins, err := s.ticket.ListDrawWinners(ctx, drawID)
+		if err != nil {
+			s.log.Error(ctx, "failed to list draw winners", "drawID", drawID, "err", err)
+			continue
+		}
+
+		for _, win := range wins {
+			if !win.Amount.IsPositive() {
+				continue
+			}
+			if err := s.HandleWin(ctx, win); err != nil {
+				s.log.Error(ctx, "failed to reconcile payout", "drawID", drawID, "ticketID", win.TicketID, "userID", win.UserID, "err", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// creditPayout зачисляет автоматическую выплату; неудача попадает в журнал, выплату повторит RetryPayouts
+func (s *Service) creditPayout(ctx context.Context, payout *entity.Payout) {
+	err := s.inTransaction(ctx, func(tx context.Context) error {
+		_, err := s.payPayout(tx, payout.ID, entity.PayoutStatusPending, entity.PayoutAudit{
+			Action: entity.PayoutActionCredited,
+		})
+		return err
+	})
+	if err == nil || errors.Is(err, entity.ErrPayoutNotAwaiting) {
+		return
+	}
+	s.log.Error(ctx, "failed to credit payout", "payoutID", payout.ID, "err", err)
+
+	cause := err
+	err = s.inTransaction(ctx, func(tx context.Context) error {
+		if err := s.repo.TouchPayout(tx, payout.ID); err != nil {
+			return err
+		}
+		return s.repo.AddPayoutAudit(tx, &entity.PayoutAudit{
+			PayoutID:   payout.ID,
+			Action:     entity.PayoutActionCreditFailed,
+			FromStatus: payout.Status,
+			ToStatus:   payout.Status,
+			Note:       cause.Error(),
+		})
+	})
+	if err != nil {
+		s.log.Error(ctx, "failed to record payout failure", "payoutID", payout.ID, "err", err)
+	}
+}
+
+// payPayout зачисляет выплату из призового фонда, если она в статусе from, и пишет audit в журнал
+func (s *Service) payPayout(ctx context.Context, payoutId int64, from entity.PayoutStatus, audit entity.PayoutAudit) (*entity.Payout, error) {
+	payout, err := s.repo.LockPayout(ctx, payoutId)
+	if err != nil {
+		return nil, err
+	}
+	if payout.Status != from {
+		return nil, fmt.Errorf("payout %d is %s: %w", payout.ID, payout.Status, entity.ErrPayoutNotAwaiting)
+	}
+
+	entryID, err := s.postWalletEntry(ctx, payout.UserID, entity.AccountKindPrizeFund, entity.EntryKindWinnings, payout.Reference(), payout.Amount, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.PayPayout(ctx, payout.ID, entryID); err != nil {
+		return nil, err
+	}
+
+	audit.PayoutID = payout.ID
+	audit.FromStatus = from
+	audit.ToStatus = entity.PayoutStatusPaid
+	if err := s.repo.AddPayoutAudit(ctx, &audit); err != nil {
+		return nil, err
+	}
+
+	payout.Status = entity.PayoutStatusPaid
+	payout.EntryID = &entryID
+	return payout, nil
+}
+
+func (s *Service) attachPayoutAudit(ctx context.Context, payouts []*entity.Payout) error {
+	ids := make([]int64, len(payouts))
+	byID := make(map[int64]*entity.Payout, len(payouts))
+	for i, p := range payouts {
+		ids[i] = p.ID
+		byID[p.ID] = p
+	}
+
+	audit, err := s.repo.ListPayoutAudit(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, a := range audit {
+		p := byID[a.PayoutID]
+		p.Audit = append(p.Audit, a)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+)
+
+// SetPrice вводит новую цену; ранее заданные цены того же тиража или лотереи перекрываются ею с ValidFrom
+func (s *Service) SetPrice(ctx context.Context, price *entity.Price) (*entity.Price, error) {
+	if price.ValidFrom.IsZero() {
+		price.ValidFrom = s.nowFunc()
+	}
+	if err := price.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s.repo.CreatePrice(ctx, price)
+}
+
+func (s *Service) CreatePromotion(ctx context.Context, promotion *entity.Promotion) (*entity.Promotion, error) {
+	if err := promotion.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s.repo.CreatePromotion(ctx, promotion)
+}
+
+// quote считает стоимость билетов по ценам и акциям, действующим в now
+func (s *Service) quote(ctx context.Context, tickets entity.Tickets, now time.Time) (*entity.Pricing, error) {
+	prices, err := s.repo.ActivePrices(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	promotions, err := s.repo.ActivePromotions(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.Quote(tickets, prices, promotions, now)
+}
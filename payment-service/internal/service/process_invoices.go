@@ -3,58 +3,114 @@ package service
 import (
 	"context"
 	"fmt"
+
 	"github.com/MaxFando/lms/payment-service/internal/entity"
 )
 
+// ProcessInvoices переводит просроченные счета в OVERDUE пачками по OverdueBatchSize и публикует события о них.
+// Каждая пачка - отдельная транзакция, строки выбираются с SKIP LOCKED, поэтому задачу
+// можно запускать на нескольких репликах одновременно.
 func (s *Service) ProcessInvoices(ctx context.Context) error {
-	pendingInvoices, err := s.repo.GetPendingInvoices(ctx)
-	if err != nil {
-		return err
+	var processed int
+	for {
+		n, err := s.processOverdueBatch(ctx)
+		processed += n
+		if err != nil {
+			s.log.Error(ctx, "failed to process overdue invoices", "err", err)
+			break
+		}
+		if n < s.cfg.OverdueBatchSize {
+			break
+		}
 	}
 
-	now := s.nowFunc()
-	for _, invoice := range pendingInvoices {
-		if invoice.DueDate.UTC().After(now) {
-			continue
-		}
+	s.metrics.overdueProcessed.Add(ctx, int64(processed))
+	if processed > 0 {
+		s.log.Info(ctx, "overdue invoices processed", "processed", processed)
+	}
 
-		err = s.processInvoiceTx(ctx, invoice)
+	return s.PublishInvoiceEvents(ctx)
+}
+
+// processOverdueBatch просрочивает одну пачку счетов. События о них пишутся в outbox в той же транзакции:
+// ticket-service узнает о просрочке, даже если сервис упадёт сразу после коммита.
+func (s *Service) processOverdueBatch(ctx context.Context) (int, error) {
+	var processed int
+	err := s.inTransaction(ctx, func(tx context.Context) error {
+		invoices, err := s.repo.ExpireOverdueInvoices(tx, s.cfg.OverdueBatchSize)
 		if err != nil {
-			s.log.Error(ctx, err.Error())
+			return err
 		}
-	}
 
-	return nil
-}
+		expired := make([]int64, 0, len(invoices))
+		for _, invoice := range invoices {
+			expired = append(expired, invoice.ID)
+		}
 
-func (s *Service) processInvoiceTx(ctx context.Context, invoice *entity.Invoice) error {
-	tx, err := s.repo.BeginTransaction(ctx)
-	if err != nil {
-		return err
-	}
+		// промокоды просроченных счетов снова можно погасить
+		if err := s.repo.ReleaseRedemptions(tx, expired); err != nil {
+			return fmt.Errorf("release redemptions of overdue invoices: %w", err)
+		}
+		if err := s.repo.EnqueueInvoiceEvents(tx, expired, entity.EventTypeInvoiceOverdue); err != nil {
+			return err
+		}
 
-	err = s.processInvoice(tx, invoice)
+		processed = len(expired)
+		return nil
+	})
 	if err != nil {
-		txErr := s.repo.RollbackTransaction(tx)
-		if txErr != nil {
-			s.log.Error(ctx, txErr.Error())
-		}
-		return err
+		return 0, err
 	}
 
-	return s.repo.CommitTransaction(tx)
+	return processed, nil
 }
 
-func (s *Service) processInvoice(ctx context.Context, invoice *entity.Invoice) error {
-	err := s.repo.SetInvoiceStatus(ctx, invoice.ID, entity.InvoiceStatusOverdue)
-	if err != nil {
-		return fmt.Errorf("failded to set invoice status to overdue: %w", err)
+// PublishInvoiceEvents публикует события из outbox пачками по OverdueBatchSize.
+// Событие, которое не удалось опубликовать, остаётся в outbox и уйдёт в следующий запуск.
+func (s *Service) PublishInvoiceEvents(ctx context.Context) error {
+	for {
+		n, failed, err := s.publishInvoiceEventsBatch(ctx)
+		s.metrics.invoiceEventsFailed.Add(ctx, int64(failed))
+		if err != nil {
+			s.log.Error(ctx, "failed to publish invoice events", "err", err)
+			return nil
+		}
+		if failed > 0 || n < s.cfg.OverdueBatchSize {
+			return nil
+		}
 	}
+}
+
+// publishInvoiceEventsBatch держит события заблокированными до отметки о публикации,
+// поэтому соседняя реплика не отправит их второй раз.
+func (s *Service) publishInvoiceEventsBatch(ctx context.Context) (published, failed int, err error) {
+	err = s.inTransaction(ctx, func(tx context.Context) error {
+		events, err := s.repo.LockInvoiceEvents(tx, s.cfg.OverdueBatchSize)
+		if err != nil {
+			return err
+		}
+
+		done := make([]int64, 0, len(events))
+		for _, event := range events {
+			invoice, err := s.repo.GetInvoiceByID(tx, event.InvoiceID)
+			if err != nil {
+				return fmt.Errorf("get invoice %d: %w", event.InvoiceID, err)
+			}
+
+			if err := s.publisher.PublishInvoice(ctx, invoice, event.Type); err != nil {
+				s.log.Error(ctx, "failed to publish invoice event", "invoiceID", event.InvoiceID, "type", event.Type, "err", err)
+				failed++
+				continue
+			}
+			done = append(done, event.ID)
+		}
 
-	err = s.publisher.PublishInvoice(ctx, invoice, entity.EventTypeInvoiceOverdue)
+		published = len(done)
+		return s.repo.MarkInvoiceEventsPublished(tx, done)
+	})
 	if err != nil {
-		return fmt.Errorf("failded to publish rollback task for invoice: %w", err)
+		return 0, failed, err
 	}
 
-	return nil
+	return published, failed, nil
 }
@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+	"github.com/shopspring/decimal"
+)
+
+// RefundInvoice возвращает amount в валюте currency по оплаченному счёту; нулевой amount - весь остаток,
+// пустая currency - валюта счёта.
+// Вернуть можно только билеты тиражей, которые ещё ACTIVE.
+// Возврат резервирует сумму и меняет статус счёта под его блокировкой, затем отправляется
+// эквайеру. Если эквайер не ответил, возврат остаётся PENDING и его повторяет RetryRefunds.
+func (s *Service) RefundInvoice(ctx context.Context, invoiceId int64, amount decimal.Decimal, currency string, reason string) (*entity.Refund, error) {
+	tx, err := s.repo.BeginTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	refund, err := s.createRefund(tx, invoiceId, amount, currency, reason, true)
+	if err != nil {
+		if rbErr := s.repo.RollbackTransaction(tx); rbErr != nil {
+			s.log.Error(ctx, "failed to rollback refund", "invoiceID", invoiceId, "err", rbErr)
+		}
+		return nil, err
+	}
+
+	if err := s.repo.CommitTransaction(tx); err != nil {
+		return nil, err
+	}
+
+	s.attemptRefund(ctx, refund)
+	return refund, nil
+}
+
+// createRefund резервирует возврат под блокировкой счёта; openDrawsOnly запрещает возврат
+// билетов тиражей, которые уже не ACTIVE
+func (s *Service) createRefund(ctx context.Context, invoiceId int64, amount decimal.Decimal, currency string, reason string, openDrawsOnly bool) (*entity.Refund, error) {
+	invoice, err := s.repo.LockInvoice(ctx, invoiceId)
+	if err != nil {
+		return nil, err
+	}
+	if currency != "" && currency != invoice.Currency {
+		return nil, fmt.Errorf("invoice %d in %s, refund in %s: %w", invoice.ID, invoice.Currency, currency, entity.ErrCurrencyMismatch)
+	}
+	if invoice.Status != entity.InvoiceStatusPaid && invoice.Status != entity.InvoiceStatusPartiallyRefunded {
+		return nil, fmt.Errorf("invoice %d is %s: %w", invoice.ID, invoice.Status, entity.ErrInvoiceNotRefundable)
+	}
+
+	if openDrawsOnly {
+		closed, err := s.repo.LockClosedDraws(ctx, invoice.Tickets.IDs())
+		if err != nil {
+			return nil, err
+		}
+		if len(closed) > 0 {
+			return nil, fmt.Errorf("invoice %d, draws %v: %w", invoice.ID, closed, entity.ErrDrawClosed)
+		}
+	}
+
+	payment, err := s.repo.GetCapturedPayment(ctx, invoice.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	refunded, err := s.repo.RefundedAmount(ctx, invoice.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := invoice.Amount.Sub(refunded)
+	if amount.IsZero() {
+		amount = remaining
+	}
+	if !amount.IsPositive() || amount.GreaterThan(remaining) || !amount.Equal(amount.Round(2)) {
+		return nil, fmt.Errorf("%w: %s, refundable %s", entity.ErrInvalidRefundAmount, amount.String(), remaining.StringFixed(2))
+	}
+
+	refund, err := s.repo.CreateRefund(ctx, &entity.Refund{
+		InvoiceID: invoice.ID,
+		PaymentID: payment.ID,
+		Amount:    amount,
+		Reason:    reason,
+		Status:    entity.RefundStatusPending,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.SetInvoiceStatus(ctx, invoice.ID, entity.RefundedStatus(invoice.Amount, refunded.Add(amount)))
+	if err != nil {
+		return nil, err
+	}
+
+	refund.Currency = invoice.Currency
+	return refund, nil
+}
+
+// RefundUndelivered возвращает деньги за билеты оплаченного счёта, которые сервис билетов не смог
+// выдать покупателю: бронь с них сняли, пока счёт ещё можно было оплатить. Покупатель не получил
+// билеты, поэтому возврат проводится и по закрытым тиражам. Повтор события не создаёт второй возврат.
+func (s *Service) RefundUndelivered(ctx context.Context, invoiceId int64, ticketIds []int64) error {
+	reason := fmt.Sprintf("tickets %v were not delivered", ticketIds)
+
+	var refund *entity.Refund
+	err := s.inTransaction(ctx, func(tx context.Context) error {
+		invoice, err := s.repo.LockInvoice(tx, invoiceId)
+		if err != nil {
+			return err
+		}
+
+		exists, err := s.repo.HasRefund(tx, invoiceId, reason)
+		if err != nil || exists {
+			return err
+		}
+
+		// за все билеты счёта возвращается весь остаток, за часть - их доля итога счёта
+		amount := decimal.Zero
+		if len(ticketIds) < len(invoice.Tickets) {
+			amount = invoice.Amount.Mul(decimal.NewFromInt(int64(len(ticketIds)))).
+				Div(decimal.NewFromInt(int64(len(invoice.Tickets)))).Truncate(2)
+		}
+
+		refund, err = s.createRefund(tx, invoiceId, amount, "", reason, false)
+		return err
+	})
+	if err != nil || refund == nil {
+		return err
+	}
+
+	s.attemptRefund(ctx, refund)
+	return nil
+}
+
+// attemptRefund отправляет возврат эквайеру и сохраняет результат попытки
+func (s *Service) attemptRefund(ctx context.Context, refund *entity.Refund) {
+	payment, err := s.repo.GetPaymentByID(ctx, refund.PaymentID)
+	if err != nil {
+		s.log.Error(ctx, "failed to get payment of refund", "refundID", refund.ID, "err", err)
+		return
+	}
+
+	if payment.Method == entity.PaymentMethodWallet {
+		s.refundToWallet(ctx, refund)
+		return
+	}
+
+	gatewayID, err := s.payer.Refund(ctx, *payment.GatewayID, refund.Reference(), refund.Amount)
+	if err != nil {
+		s.log.Error(ctx, "failed to refund payment", "refundID", refund.ID, "attempt", refund.Attempts+1, "err", err)
+		s.recordRefundFailure(ctx, refund, err)
+		return
+	}
+
+	if err := s.repo.CompleteRefund(ctx, refund.ID, &gatewayID); err != nil {
+		s.log.Error(ctx, "failed to complete refund", "refundID", refund.ID, "err", err)
+		return
+	}
+	s.refundSucceeded(ctx, refund, &gatewayID)
+}
+
+// refundToWallet возвращает оплату с кошелька обратно на кошелёк владельца счёта
+func (s *Service) refundToWallet(ctx context.Context, refund *entity.Refund) {
+	invoice, err := s.repo.GetInvoiceByID(ctx, refund.InvoiceID)
+	if err != nil {
+		s.log.Error(ctx, "failed to get invoice of refund", "refundID", refund.ID, "err", err)
+		return
+	}
+
+	err = s.inTransaction(ctx, func(tx context.Context) error {
+		_, err := s.postWalletEntry(tx, invoice.OwnerID, entity.AccountKindRevenue, entity.EntryKindRefund, refund.Reference(), refund.Amount, &invoice.ID)
+		if err != nil {
+			return err
+		}
+		return s.repo.CompleteRefund(tx, refund.ID, nil)
+	})
+	if err != nil {
+		s.log.Error(ctx, "failed to refund to wallet", "refundID", refund.ID, "attempt", refund.Attempts+1, "err", err)
+		s.recordRefundFailure(ctx, refund, err)
+		return
+	}
+	s.refundSucceeded(ctx, refund, nil)
+}
+
+func (s *Service) refundSucceeded(ctx context.Context, refund *entity.Refund, gatewayID *int64) {
+	refund.Status = entity.RefundStatusSucceeded
+	refund.GatewayID = gatewayID
+	refund.Attempts++
+	refund.LastError = ""
+
+	s.publishRefunded(ctx, refund.InvoiceID)
+}
+
+func (s *Service) recordRefundFailure(ctx context.Context, refund *entity.Refund, cause error) {
+	if refund.Attempts+1 < s.cfg.RefundMaxAttempts {
+		err := s.repo.RecordRefundAttempt(ctx, refund.ID, entity.RefundStatusPending, cause.Error())
+		if err != nil {
+			s.log.Error(ctx, "failed to record refund attempt", "refundID", refund.ID, "err", err)
+			return
+		}
+		refund.Attempts++
+		refund.LastError = cause.Error()
+		return
+	}
+
+	if err := s.failRefund(ctx, refund, cause.Error()); err != nil {
+		s.log.Error(ctx, "failed to mark refund failed", "refundID", refund.ID, "err", err)
+		return
+	}
+	refund.Status = entity.RefundStatusFailed
+	refund.Attempts++
+	refund.LastError = cause.Error()
+}
+
+// failRefund закрывает возврат как несостоявшийся и снимает его сумму со статуса счёта
+func (s *Service) failRefund(ctx context.Context, refund *entity.Refund, lastError string) error {
+	tx, err := s.repo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.releaseRefund(tx, refund, lastError)
+	if err != nil {
+		if rbErr := s.repo.RollbackTransaction(tx); rbErr != nil {
+			s.log.Error(ctx, "failed to rollback refund", "refundID", refund.ID, "err", rbErr)
+		}
+		return err
+	}
+
+	return s.repo.CommitTransaction(tx)
+}
+
+func (s *Service) releaseRefund(ctx context.Context, refund *entity.Refund, lastError string) error {
+	invoice, err := s.repo.LockInvoice(ctx, refund.InvoiceID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.RecordRefundAttempt(ctx, refund.ID, entity.RefundStatusFailed, lastError)
+	if err != nil {
+		return err
+	}
+
+	refunded, err := s.repo.RefundedAmount(ctx, invoice.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.SetInvoiceStatus(ctx, invoice.ID, entity.RefundedStatus(invoice.Amount, refunded))
+}
+
+// publishRefunded сообщает о полном возврате, когда по счёту не осталось незавершённых возвратов
+func (s *Service) publishRefunded(ctx context.Context, invoiceId int64) {
+	invoice, err := s.repo.GetInvoiceByID(ctx, invoiceId)
+	if err != nil {
+		s.log.Error(ctx, "failed to get refunded invoice", "invoiceID", invoiceId, "err", err)
+		return
+	}
+	if invoice.Status != entity.InvoiceStatusRefunded {
+		return
+	}
+
+	pending, err := s.repo.HasPendingRefunds(ctx, invoiceId)
+	if err != nil {
+		s.log.Error(ctx, "failed to check pending refunds", "invoiceID", invoiceId, "err", err)
+		return
+	}
+	if pending {
+		return
+	}
+
+	if err := s.publisher.PublishInvoice(ctx, invoice, entity.EventTypeInvoiceRefunded); err != nil {
+		s.log.Error(ctx, "failed to publish refunded invoice", "invoiceID", invoiceId, "err", err)
+	}
+}
+
+// RetryRefunds повторяет возвраты, которые эквайер не провёл с прошлой попытки
+func (s *Service) RetryRefunds(ctx context.Context) error {
+	refunds, err := s.repo.GetPendingRefunds(ctx, s.nowFunc().Add(-s.cfg.RefundRetryInterval))
+	if err != nil {
+		s.log.Error(ctx, "failed to get pending refunds", "err", err)
+		return nil
+	}
+
+	for _, refund := range refunds {
+		s.attemptRefund(ctx, refund)
+	}
+
+	return nil
+}
@@ -7,14 +7,65 @@ import (
 	"github.com/MaxFando/lms/payment-service/config"
 	"github.com/MaxFando/lms/payment-service/internal/entity"
 	"github.com/MaxFando/lms/platform/logger"
+	"github.com/shopspring/decimal"
 )
 
 type repo interface {
 	CreateInvoice(ctx context.Context, invoice *entity.Invoice) (int64, error)
 	GetInvoiceByID(ctx context.Context, id int64) (*entity.Invoice, error)
-	GetPendingInvoices(ctx context.Context) ([]*entity.Invoice, error)
+	ExpireOverdueInvoices(ctx context.Context, limit int) ([]*entity.Invoice, error)
+	ListInvoices(ctx context.Context, f entity.InvoiceFilter) ([]*entity.Invoice, error)
+	ListPayments(ctx context.Context, f entity.PaymentFilter) ([]*entity.PaymentDetails, error)
+	LockInvoice(ctx context.Context, id int64) (*entity.Invoice, error)
 	SetInvoiceStatus(ctx context.Context, id int64, status entity.InvoiceStatus) error
-	CreatePayment(ctx context.Context, invoiceID int64, status entity.PaymentStatus) (int64, error)
+	CancelInvoice(ctx context.Context, id int64) error
+	CreatePayment(ctx context.Context, payment *entity.Payment) (int64, error)
+	AuthorizePayment(ctx context.Context, id int64, gatewayID int64) error
+	SetPaymentStatus(ctx context.Context, id int64, from, to entity.PaymentStatus, reason string) error
+	GetStalePayments(ctx context.Context, before time.Time) ([]*entity.Payment, error)
+	GetPaymentByID(ctx context.Context, id int64) (*entity.Payment, error)
+	GetCapturedPayment(ctx context.Context, invoiceID int64) (*entity.Payment, error)
+	CreateRefund(ctx context.Context, refund *entity.Refund) (*entity.Refund, error)
+	RefundedAmount(ctx context.Context, invoiceID int64) (decimal.Decimal, error)
+	HasPendingRefunds(ctx context.Context, invoiceID int64) (bool, error)
+	HasRefund(ctx context.Context, invoiceID int64, reason string) (bool, error)
+	LockClosedDraws(ctx context.Context, ticketIDs []int64) ([]int64, error)
+	CompleteRefund(ctx context.Context, id int64, gatewayID *int64) error
+	RecordRefundAttempt(ctx context.Context, id int64, status entity.RefundStatus, lastError string) error
+	GetPendingRefunds(ctx context.Context, before time.Time) ([]*entity.Refund, error)
+	CreateTopUp(ctx context.Context, topUp *entity.TopUp) (int64, error)
+	AuthorizeTopUp(ctx context.Context, id int64, gatewayID int64) error
+	SetTopUpStatus(ctx context.Context, id int64, from, to entity.PaymentStatus, reason string) error
+	GetStaleTopUps(ctx context.Context, before time.Time) ([]*entity.TopUp, error)
+	Wallet(ctx context.Context, ownerID int64) (*entity.Account, error)
+	SystemAccount(ctx context.Context, kind entity.AccountKind) (*entity.Account, error)
+	PostEntry(ctx context.Context, entry *entity.JournalEntry) (int64, error)
+	WalletTransaction(ctx context.Context, accountID int64, kind entity.EntryKind, reference string) (*entity.WalletTransaction, error)
+	ListWalletTransactions(ctx context.Context, accountID int64, limit int, after *entity.Cursor) ([]*entity.WalletTransaction, error)
+	CreatePayout(ctx context.Context, payout *entity.Payout) (*entity.Payout, bool, error)
+	GetPayoutByID(ctx context.Context, id int64) (*entity.Payout, error)
+	LockPayout(ctx context.Context, id int64) (*entity.Payout, error)
+	PayPayout(ctx context.Context, id int64, entryID int64) error
+	TouchPayout(ctx context.Context, id int64) error
+	GetPendingPayouts(ctx context.Context, before time.Time) ([]*entity.Payout, error)
+	GetInvoicedDraws(ctx context.Context, since time.Time) ([]int64, error)
+	ListPayouts(ctx context.Context, f entity.PayoutFilter) ([]*entity.Payout, error)
+	AddPayoutAudit(ctx context.Context, audit *entity.PayoutAudit) error
+	ListPayoutAudit(ctx context.Context, payoutIDs []int64) ([]*entity.PayoutAudit, error)
+	TicketDraws(ctx context.Context, ticketIDs []int64) (entity.Tickets, error)
+	ActivePrices(ctx context.Context, now time.Time) ([]*entity.Price, error)
+	CreatePrice(ctx context.Context, price *entity.Price) (*entity.Price, error)
+	ActivePromotions(ctx context.Context, now time.Time) ([]*entity.Promotion, error)
+	CreatePromotion(ctx context.Context, promotion *entity.Promotion) (*entity.Promotion, error)
+	CreateVoucher(ctx context.Context, voucher *entity.Voucher) (*entity.Voucher, error)
+	GetVoucherByCode(ctx context.Context, code string) (*entity.Voucher, error)
+	RedeemVoucher(ctx context.Context, code string, now time.Time) (*entity.Voucher, error)
+	UserRedemptions(ctx context.Context, voucherID int64, userID int64) (int, error)
+	CreateRedemption(ctx context.Context, redemption *entity.VoucherRedemption) error
+	ReleaseRedemptions(ctx context.Context, invoiceIDs []int64) error
+	EnqueueInvoiceEvents(ctx context.Context, invoiceIDs []int64, eventType entity.EventType) error
+	LockInvoiceEvents(ctx context.Context, limit int) ([]*entity.InvoiceEvent, error)
+	MarkInvoiceEventsPublished(ctx context.Context, ids []int64) error
 	BeginTransaction(ctx context.Context) (txContext context.Context, err error)
 	RollbackTransaction(txContext context.Context) (err error)
 	CommitTransaction(txContext context.Context) (err error)
@@ -26,11 +77,16 @@ type publisher interface {
 
 type ticketService interface {
 	BookTicket(ctx context.Context, userId int64, ticketI int64) (*entity.Ticket, error)
+	ReleaseTickets(ctx context.Context, ticketIds []int64) error
+	ListDrawWinners(ctx context.Context, drawID int64) ([]*entity.Payout, error)
 }
 
 type payer interface {
-	Pay(ctx context.Context, card *entity.Card) (int64, error)
-	Refund(ctx context.Context, transactionID int64) error
+	Authorize(ctx context.Context, reference string, card *entity.Card, amount decimal.Decimal) (int64, error)
+	FindAuthorization(ctx context.Context, reference string) (int64, error)
+	Capture(ctx context.Context, transactionID int64) error
+	Void(ctx context.Context, transactionID int64) error
+	Refund(ctx context.Context, transactionID int64, reference string, amount decimal.Decimal) (int64, error)
 }
 
 type Service struct {
@@ -40,6 +96,7 @@ type Service struct {
 	publisher publisher
 	log       logger.Logger
 	cfg       *config.Config
+	metrics   *metrics
 
 	nowFunc func() time.Time
 }
@@ -58,6 +115,7 @@ func New(
 		publisher: publisher,
 		log:       logger.NewLogger().With("app", "lms", "component", "payment-service", "layer", "usecase"),
 		cfg:       cfg,
+		metrics:   newMetrics(),
 
 		nowFunc: func() time.Time { return time.Now().UTC() },
 	}
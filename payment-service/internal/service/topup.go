@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+	"github.com/shopspring/decimal"
+)
+
+// TopUp пополняет кошелёк с карты в те же фазы, что и Pay: пополнение сохраняется до обращения
+// к эквайеру, сумма авторизуется на карте, зачисляется проводкой с карточного счёта на кошелёк
+// и списывается только после фиксации проводки. Прерванные пополнения доводит ResumePayments.
+func (s *Service) TopUp(ctx context.Context, userId int64, card *entity.Card, amount decimal.Decimal) (*entity.WalletTransaction, decimal.Decimal, error) {
+	if err := validateAmount(amount); err != nil {
+		return nil, decimal.Zero, err
+	}
+
+	topUp := &entity.TopUp{
+		UserID: userId,
+		Amount: amount,
+		Status: entity.PaymentStatusAuthorizing,
+	}
+	var err error
+	topUp.ID, err = s.repo.CreateTopUp(ctx, topUp)
+	if err != nil {
+		return nil, decimal.Zero, err
+	}
+
+	gatewayID, err := s.payer.Authorize(ctx, topUp.Reference(), card, amount)
+	if errors.Is(err, entity.ErrPaymentTimeout) {
+		// эквайер мог авторизовать пополнение: его найдёт по reference и отменит ResumePayments
+		s.log.Error(ctx, "top up authorization result is unknown", "topUpID", topUp.ID, "err", err)
+		return nil, decimal.Zero, err
+	}
+	if err != nil {
+		s.setTopUpStatus(ctx, topUp, entity.PaymentStatusRejected, err.Error())
+		return nil, decimal.Zero, err
+	}
+	topUp.GatewayID = &gatewayID
+
+	if err := s.repo.AuthorizeTopUp(ctx, topUp.ID, gatewayID); err != nil {
+		s.voidTopUp(ctx, topUp, err.Error())
+		return nil, decimal.Zero, err
+	}
+	topUp.Status = entity.PaymentStatusAuthorized
+
+	if err := s.completeTopUp(ctx, topUp); err != nil {
+		return nil, decimal.Zero, err
+	}
+
+	return s.walletTransaction(ctx, userId, entity.EntryKindTopUp, topUp.Reference())
+}
+
+// completeTopUp зачисляет авторизованное пополнение на кошелёк и списывает его с карты
+func (s *Service) completeTopUp(ctx context.Context, topUp *entity.TopUp) error {
+	err := s.inTransaction(ctx, func(tx context.Context) error {
+		err := s.repo.SetTopUpStatus(tx, topUp.ID, entity.PaymentStatusAuthorized, entity.PaymentStatusCapturing, "")
+		if err != nil {
+			return err
+		}
+
+		_, err = s.postWalletEntry(tx, topUp.UserID, entity.AccountKindCard, entity.EntryKindTopUp, topUp.Reference(), topUp.Amount, nil)
+		return err
+	})
+	if err != nil {
+		if !errors.Is(err, entity.ErrPaymentStateChanged) {
+			s.voidTopUp(ctx, topUp, err.Error())
+		}
+		return err
+	}
+	topUp.Status = entity.PaymentStatusCapturing
+
+	// кошелёк уже пополнен: если списание не прошло, его повторит ResumePayments
+	s.captureTopUp(ctx, topUp)
+
+	return nil
+}
+
+func (s *Service) captureTopUp(ctx context.Context, topUp *entity.TopUp) {
+	if err := s.payer.Capture(ctx, *topUp.GatewayID); err != nil {
+		s.log.Error(ctx, "failed to capture top up", "topUpID", topUp.ID, "err", err)
+		return
+	}
+
+	s.setTopUpStatus(ctx, topUp, entity.PaymentStatusPaid, "")
+}
+
+func (s *Service) voidTopUp(ctx context.Context, topUp *entity.TopUp, reason string) {
+	if err := s.payer.Void(ctx, *topUp.GatewayID); err != nil {
+		s.log.Error(ctx, "failed to void top up", "topUpID", topUp.ID, "err", err)
+		return
+	}
+
+	s.setTopUpStatus(ctx, topUp, entity.PaymentStatusVoided, reason)
+}
+
+func (s *Service) setTopUpStatus(ctx context.Context, topUp *entity.TopUp, to entity.PaymentStatus, reason string) {
+	if err := s.repo.SetTopUpStatus(ctx, topUp.ID, topUp.Status, to, reason); err != nil {
+		s.log.Error(ctx, "failed to set top up status", "topUpID", topUp.ID, "status", to, "err", err)
+		return
+	}
+	topUp.Status = to
+}
+
+// resumeTopUps доводит прерванные пополнения так же, как ResumePayments - платежи:
+// авторизованные зачисляет, зачисленные списывает, а авторизации без ответа ищет и отменяет
+func (s *Service) resumeTopUps(ctx context.Context) {
+	topUps, err := s.repo.GetStaleTopUps(ctx, s.nowFunc().Add(-s.cfg.PaymentResumeAfter))
+	if err != nil {
+		s.log.Error(ctx, "failed to get stale top ups", "err", err)
+		return
+	}
+
+	for _, topUp := range topUps {
+		switch topUp.Status {
+		case entity.PaymentStatusAuthorizing:
+			s.resolveTopUpAuthorization(ctx, topUp)
+		case entity.PaymentStatusAuthorized:
+			if err := s.completeTopUp(ctx, topUp); err != nil {
+				s.log.Error(ctx, "failed to resume top up", "topUpID", topUp.ID, "err", err)
+			}
+		case entity.PaymentStatusCapturing:
+			s.captureTopUp(ctx, topUp)
+		}
+	}
+}
+
+// resolveTopUpAuthorization выясняет у эквайера исход авторизации пополнения без ответа
+func (s *Service) resolveTopUpAuthorization(ctx context.Context, topUp *entity.TopUp) {
+	gatewayID, err := s.payer.FindAuthorization(ctx, topUp.Reference())
+	if errors.Is(err, entity.ErrAuthorizationNotFound) {
+		s.setTopUpStatus(ctx, topUp, entity.PaymentStatusRejected, "authorization not found at gateway")
+		return
+	}
+	if err != nil {
+		s.log.Error(ctx, "failed to find top up authorization", "topUpID", topUp.ID, "err", err)
+		return
+	}
+
+	if err := s.repo.AuthorizeTopUp(ctx, topUp.ID, gatewayID); err != nil {
+		s.log.Error(ctx, "failed to save found top up authorization", "topUpID", topUp.ID, "err", err)
+		return
+	}
+	topUp.GatewayID = &gatewayID
+	topUp.Status = entity.PaymentStatusAuthorized
+
+	s.voidTopUp(ctx, topUp, "authorization result was lost")
+}
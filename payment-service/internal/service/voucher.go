@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+)
+
+func (s *Service) CreateVoucher(ctx context.Context, voucher *entity.Voucher) (*entity.Voucher, error) {
+	voucher.Code = entity.NormalizeVoucherCode(voucher.Code)
+	if err := voucher.Validate(s.nowFunc()); err != nil {
+		return nil, err
+	}
+
+	return s.repo.CreateVoucher(ctx, voucher)
+}
+
+func (s *Service) GetVoucher(ctx context.Context, code string) (*entity.Voucher, error) {
+	return s.repo.GetVoucherByCode(ctx, entity.NormalizeVoucherCode(code))
+}
+
+// storeInvoice сохраняет счёт; промокод voucherCode погашается в той же транзакции,
+// так что отклонённый код не оставляет счёта, а несохранённый счёт - погашения
+func (s *Service) storeInvoice(ctx context.Context, invoice *entity.Invoice, voucherCode string) error {
+	if voucherCode == "" {
+		var err error
+		invoice.ID, err = s.repo.CreateInvoice(ctx, invoice)
+		return err
+	}
+
+	return s.inTransaction(ctx, func(tx context.Context) error {
+		voucher, err := s.repo.RedeemVoucher(tx, entity.NormalizeVoucherCode(voucherCode), invoice.RegisterTime)
+		if err != nil {
+			return err
+		}
+
+		// строка кода заблокирована погашением, поэтому параллельные счета пользователя считаются по очереди
+		if voucher.PerUserLimit > 0 {
+			used, err := s.repo.UserRedemptions(tx, voucher.ID, invoice.OwnerID)
+			if err != nil {
+				return err
+			}
+			if used >= voucher.PerUserLimit {
+				return fmt.Errorf("voucher %q used %d times: %w", voucher.Code, used, entity.ErrVoucherUserLimit)
+			}
+		}
+
+		discount, err := voucher.Discount(invoice.Amount, invoice.Currency)
+		if err != nil {
+			return err
+		}
+		invoice.Pricing.ApplyVoucher(voucher.Code, discount)
+		invoice.Amount = invoice.Pricing.Total
+
+		invoice.ID, err = s.repo.CreateInvoice(tx, invoice)
+		if err != nil {
+			return err
+		}
+
+		return s.repo.CreateRedemption(tx, &entity.VoucherRedemption{
+			VoucherID: voucher.ID,
+			UserID:    invoice.OwnerID,
+			InvoiceID: invoice.ID,
+			Discount:  discount,
+		})
+	})
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MaxFando/lms/payment-service/internal/entity"
+	"github.com/shopspring/decimal"
+)
+
+// CreditWinnings зачисляет выигрыш из призового фонда; повтор с тем же reference возвращает уже проведённое зачисление
+func (s *Service) CreditWinnings(ctx context.Context, userId int64, amount decimal.Decimal, reference string) (*entity.WalletTransaction, decimal.Decimal, error) {
+	if err := validateAmount(amount); err != nil {
+		return nil, decimal.Zero, err
+	}
+	if reference == "" {
+		return nil, decimal.Zero, fmt.Errorf("%w: winnings reference is required", entity.ErrInvalidAmount)
+	}
+
+	err := s.inTransaction(ctx, func(tx context.Context) error {
+		_, err := s.postWalletEntry(tx, userId, entity.AccountKindPrizeFund, entity.EntryKindWinnings, reference, amount, nil)
+		return err
+	})
+	if err != nil && !errors.Is(err, entity.ErrDuplicateEntry) {
+		return nil, decimal.Zero, err
+	}
+
+	return s.walletTransaction(ctx, userId, entity.EntryKindWinnings, reference)
+}
+
+// PayFromWallet оплачивает счёт с кошелька владельца: списание, оплата счёта и платёж
+// фиксируются одной транзакцией под блокировкой счёта.
+func (s *Service) PayFromWallet(ctx context.Context, userId int64, invoiceId int64) error {
+	var invoice *entity.Invoice
+	err := s.inTransaction(ctx, func(tx context.Context) error {
+		var err error
+		invoice, err = s.repo.LockInvoice(tx, invoiceId)
+		if err != nil {
+			return err
+		}
+		if err := checkPayable(invoice, userId); err != nil {
+			return err
+		}
+		if invoice.Currency != entity.DefaultCurrency {
+			return fmt.Errorf("invoice in %s, wallet in %s: %w", invoice.Currency, entity.DefaultCurrency, entity.ErrCurrencyMismatch)
+		}
+
+		reference := fmt.Sprintf("invoice-%d", invoice.ID)
+		_, err = s.postWalletEntry(tx, userId, entity.AccountKindRevenue, entity.EntryKindInvoicePayment, reference, invoice.Amount.Neg(), &invoice.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.repo.CreatePayment(tx, &entity.Payment{
+			InvoiceID: invoice.ID,
+			Status:    entity.PaymentStatusPaid,
+			Method:    entity.PaymentMethodWallet,
+			Amount:    invoice.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.repo.SetInvoiceStatus(tx, invoice.ID, entity.InvoiceStatusPaid)
+	})
+	if err != nil {
+		return err
+	}
+
+	invoice.Status = entity.InvoiceStatusPaid
+	if err := s.publisher.PublishInvoice(ctx, invoice, entity.EventTypeInvoicePaid); err != nil {
+		s.log.Error(ctx, "failed to publish paid invoice", "invoiceID", invoice.ID, "err", err)
+	}
+
+	return nil
+}
+
+func (s *Service) GetBalance(ctx context.Context, userId int64) (decimal.Decimal, error) {
+	wallet, err := s.repo.Wallet(ctx, userId)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return wallet.Balance, nil
+}
+
+func (s *Service) ListTransactions(ctx context.Context, userId int64, pageSize int32, pageToken string) ([]*entity.WalletTransaction, string, error) {
+	size, after, err := preparePage(nil, nil, pageSize, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	wallet, err := s.repo.Wallet(ctx, userId)
+	if err != nil {
+		return nil, "", err
+	}
+
+	txs, err := s.repo.ListWalletTransactions(ctx, wallet.ID, size+1, after)
+	if err != nil {
+		return nil, "", err
+	}
+
+	txs, next := cutPage(txs, size, func(t *entity.WalletTransaction) entity.Cursor {
+		return entity.Cursor{Time: t.CreatedAt, ID: t.EntryID}
+	})
+	return txs, next, nil
+}
+
+// postWalletEntry проводит amount между кошельком пользователя и системным счётом counterparty:
+// положительная сумма зачисляется на кошелёк, отрицательная списывается с него
+func (s *Service) postWalletEntry(
+	ctx context.Context,
+	userId int64,
+	counterparty entity.AccountKind,
+	kind entity.EntryKind,
+	reference string,
+	amount decimal.Decimal,
+	invoiceId *int64,
+) (int64, error) {
+	wallet, err := s.repo.Wallet(ctx, userId)
+	if err != nil {
+		return 0, err
+	}
+	system, err := s.repo.SystemAccount(ctx, counterparty)
+	if err != nil {
+		return 0, err
+	}
+
+	entry := entity.Transfer(kind, reference, system.ID, wallet.ID, amount)
+	if amount.IsNegative() {
+		entry = entity.Transfer(kind, reference, wallet.ID, system.ID, amount.Neg())
+	}
+	entry.InvoiceID = invoiceId
+
+	return s.repo.PostEntry(ctx, entry)
+}
+
+// walletTransaction возвращает проведённую проводку и баланс кошелька после неё
+func (s *Service) walletTransaction(ctx context.Context, userId int64, kind entity.EntryKind, reference string) (*entity.WalletTransaction, decimal.Decimal, error) {
+	wallet, err := s.repo.Wallet(ctx, userId)
+	if err != nil {
+		return nil, decimal.Zero, err
+	}
+
+	tx, err := s.repo.WalletTransaction(ctx, wallet.ID, kind, reference)
+	if err != nil {
+		return nil, decimal.Zero, err
+	}
+
+	return tx, wallet.Balance, nil
+}
+
+func (s *Service) inTransaction(ctx context.Context, f func(tx context.Context) error) error {
+	tx, err := s.repo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := f(tx); err != nil {
+		if rbErr := s.repo.RollbackTransaction(tx); rbErr != nil {
+			s.log.Error(ctx, "failed to rollback transaction", "err", rbErr)
+		}
+		return err
+	}
+
+	return s.repo.CommitTransaction(tx)
+}
+
+func validateAmount(amount decimal.Decimal) error {
+	if !amount.IsPositive() || !amount.Equal(amount.Round(2)) {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidAmount, amount.String())
+	}
+	return nil
+}
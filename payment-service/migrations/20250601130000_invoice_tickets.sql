@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+UPDATE payment.invoices
+SET ticket_data = jsonb_build_array(ticket_data)
+WHERE jsonb_typeof(ticket_data) = 'object';
+
+ALTER TABLE payment.invoices
+    ADD CONSTRAINT invoices_ticket_data_array CHECK (jsonb_typeof(ticket_data) = 'array');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE payment.invoices DROP CONSTRAINT IF EXISTS invoices_ticket_data_array;
+
+UPDATE payment.invoices
+SET ticket_data = ticket_data -> 0
+WHERE jsonb_typeof(ticket_data) = 'array';
+-- +goose StatementEnd
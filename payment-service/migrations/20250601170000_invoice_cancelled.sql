@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE payment.invoice_status ADD VALUE IF NOT EXISTS 'CANCELLED';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE payment.invoices SET status = 'OVERDUE' WHERE status = 'CANCELLED';
+
+ALTER TABLE payment.invoices ALTER COLUMN status TYPE TEXT;
+DROP TYPE payment.invoice_status;
+CREATE TYPE payment.invoice_status AS ENUM ('PENDING', 'PAID', 'OVERDUE');
+ALTER TABLE payment.invoices ALTER COLUMN status TYPE payment.invoice_status USING status::payment.invoice_status;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE payment.payment_status ADD VALUE IF NOT EXISTS 'AUTHORIZING';
+ALTER TYPE payment.payment_status ADD VALUE IF NOT EXISTS 'AUTHORIZED';
+ALTER TYPE payment.payment_status ADD VALUE IF NOT EXISTS 'CAPTURING';
+ALTER TYPE payment.payment_status ADD VALUE IF NOT EXISTS 'VOIDED';
+
+ALTER TABLE payment.payments
+    ADD COLUMN IF NOT EXISTS gateway_id BIGINT,
+    ADD COLUMN IF NOT EXISTS amount     DECIMAL(12,2),
+    ADD COLUMN IF NOT EXISTS reason     TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+CREATE INDEX IF NOT EXISTS idx_payments_in_flight ON payment.payments (updated_at)
+    WHERE status IN ('AUTHORIZING', 'AUTHORIZED', 'CAPTURING');
+
+-- +goose Down
+DROP INDEX IF EXISTS payment.idx_payments_in_flight;
+
+ALTER TABLE payment.payments
+    DROP COLUMN IF EXISTS gateway_id,
+    DROP COLUMN IF EXISTS amount,
+    DROP COLUMN IF EXISTS reason,
+    DROP COLUMN IF EXISTS updated_at;
+
+UPDATE payment.payments SET status = 'REJECTED' WHERE status IN ('AUTHORIZING', 'AUTHORIZED', 'VOIDED');
+UPDATE payment.payments SET status = 'PAID' WHERE status = 'CAPTURING';
+
+ALTER TABLE payment.payments ALTER COLUMN status TYPE TEXT;
+DROP TYPE payment.payment_status;
+CREATE TYPE payment.payment_status AS ENUM ('PAID', 'REJECTED');
+ALTER TABLE payment.payments ALTER COLUMN status TYPE payment.payment_status USING status::payment.payment_status;
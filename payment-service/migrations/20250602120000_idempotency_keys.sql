@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS payment.idempotency_keys (
+    method        TEXT        NOT NULL,
+    key           TEXT        NOT NULL,
+    fingerprint   TEXT        NOT NULL,
+    status        TEXT        NOT NULL DEFAULT 'IN_PROGRESS',
+    response_type TEXT        NOT NULL DEFAULT '',
+    response      BYTEA,
+    locked_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at    TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (method, key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON payment.idempotency_keys (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS payment.idempotency_keys;
+-- +goose StatementEnd
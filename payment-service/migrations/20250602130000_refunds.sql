@@ -0,0 +1,31 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE payment.invoice_status ADD VALUE IF NOT EXISTS 'PARTIALLY_REFUNDED';
+ALTER TYPE payment.invoice_status ADD VALUE IF NOT EXISTS 'REFUNDED';
+
+CREATE TABLE IF NOT EXISTS payment.refunds (
+    id         SERIAL PRIMARY KEY,
+    invoice_id INTEGER       NOT NULL REFERENCES payment.invoices ON DELETE CASCADE,
+    payment_id INTEGER       NOT NULL REFERENCES payment.payments ON DELETE CASCADE,
+    amount     DECIMAL(12,2) NOT NULL CHECK (amount > 0),
+    reason     TEXT          NOT NULL DEFAULT '',
+    status     TEXT          NOT NULL DEFAULT 'PENDING',
+    gateway_id BIGINT,
+    attempts   INTEGER       NOT NULL DEFAULT 0,
+    last_error TEXT          NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_refunds_invoice_id ON payment.refunds (invoice_id);
+CREATE INDEX IF NOT EXISTS idx_refunds_pending ON payment.refunds (updated_at) WHERE status = 'PENDING';
+
+-- +goose Down
+DROP TABLE IF EXISTS payment.refunds;
+
+UPDATE payment.invoices SET status = 'PAID' WHERE status IN ('PARTIALLY_REFUNDED', 'REFUNDED');
+
+ALTER TABLE payment.invoices ALTER COLUMN status TYPE TEXT;
+DROP TYPE payment.invoice_status;
+CREATE TYPE payment.invoice_status AS ENUM ('PENDING', 'PAID', 'OVERDUE', 'CANCELLED');
+ALTER TABLE payment.invoices ALTER COLUMN status TYPE payment.invoice_status USING status::payment.invoice_status;
@@ -0,0 +1,7 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_invoices_pending_due_date ON payment.invoices (due_date)
+    WHERE status = 'PENDING';
+
+-- +goose Down
+DROP INDEX CONCURRENTLY IF EXISTS payment.idx_invoices_pending_due_date;
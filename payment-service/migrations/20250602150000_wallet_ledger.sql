@@ -0,0 +1,68 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS payment.accounts (
+    id         SERIAL PRIMARY KEY,
+    owner_id   INTEGER,
+    kind       TEXT          NOT NULL,
+    currency   TEXT          NOT NULL DEFAULT 'RUB',
+    balance    DECIMAL(14,2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    CHECK (kind <> 'USER_WALLET' OR (owner_id IS NOT NULL AND balance >= 0))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_accounts_wallet ON payment.accounts (owner_id, currency)
+    WHERE kind = 'USER_WALLET';
+CREATE UNIQUE INDEX IF NOT EXISTS idx_accounts_system ON payment.accounts (kind, currency)
+    WHERE owner_id IS NULL;
+
+INSERT INTO payment.accounts (kind) VALUES ('CARD_SETTLEMENT'), ('REVENUE'), ('PRIZE_FUND')
+ON CONFLICT DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS payment.journal_entries (
+    id         SERIAL PRIMARY KEY,
+    kind       TEXT        NOT NULL,
+    invoice_id INTEGER REFERENCES payment.invoices ON DELETE SET NULL,
+    reference  TEXT        NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_journal_entries_reference ON payment.journal_entries (kind, reference)
+    WHERE reference <> '';
+
+CREATE TABLE IF NOT EXISTS payment.postings (
+    id         SERIAL PRIMARY KEY,
+    entry_id   INTEGER       NOT NULL REFERENCES payment.journal_entries ON DELETE CASCADE,
+    account_id INTEGER       NOT NULL REFERENCES payment.accounts,
+    amount     DECIMAL(14,2) NOT NULL CHECK (amount <> 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_postings_account_id ON payment.postings (account_id, entry_id);
+CREATE INDEX IF NOT EXISTS idx_postings_entry_id ON payment.postings (entry_id);
+
+-- проводка проверяется в конце транзакции, когда все её изменения уже вставлены
+CREATE OR REPLACE FUNCTION payment.check_entry_balanced() RETURNS TRIGGER AS $$
+BEGIN
+    IF (SELECT SUM(amount) FROM payment.postings WHERE entry_id = NEW.entry_id) <> 0 THEN
+        RAISE EXCEPTION 'journal entry % is unbalanced', NEW.entry_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER postings_balanced
+    AFTER INSERT OR UPDATE ON payment.postings
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION payment.check_entry_balanced();
+
+ALTER TABLE payment.payments ADD COLUMN IF NOT EXISTS method TEXT NOT NULL DEFAULT 'CARD';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE payment.payments DROP COLUMN IF EXISTS method;
+
+DROP TABLE IF EXISTS payment.postings;
+DROP FUNCTION IF EXISTS payment.check_entry_balanced();
+DROP TABLE IF EXISTS payment.journal_entries;
+DROP TABLE IF EXISTS payment.accounts;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS payment.payouts (
+    id           SERIAL PRIMARY KEY,
+    ticket_id    INTEGER       NOT NULL,
+    user_id      INTEGER       NOT NULL,
+    syndicate_id INTEGER,
+    draw_id      INTEGER,
+    amount       DECIMAL(12,2) NOT NULL CHECK (amount > 0),
+    status       TEXT          NOT NULL,
+    entry_id     INTEGER REFERENCES payment.journal_entries,
+    created_at   TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    UNIQUE (ticket_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payouts_created_at ON payment.payouts (created_at, id);
+CREATE INDEX IF NOT EXISTS idx_payouts_user_id ON payment.payouts (user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_payouts_unsettled ON payment.payouts (updated_at) WHERE status <> 'PAID';
+
+CREATE TABLE IF NOT EXISTS payment.payout_audit (
+    id          SERIAL PRIMARY KEY,
+    payout_id   INTEGER     NOT NULL REFERENCES payment.payouts ON DELETE CASCADE,
+    action      TEXT        NOT NULL,
+    actor_id    INTEGER,
+    from_status TEXT        NOT NULL DEFAULT '',
+    to_status   TEXT        NOT NULL DEFAULT '',
+    note        TEXT        NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_payout_audit_payout_id ON payment.payout_audit (payout_id, id);
+
+-- +goose Down
+DROP TABLE IF EXISTS payment.payout_audit;
+DROP TABLE IF EXISTS payment.payouts;
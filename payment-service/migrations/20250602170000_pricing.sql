@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS payment.prices (
+    id           SERIAL PRIMARY KEY,
+    lottery_type TEXT,
+    draw_id      INTEGER,
+    amount       DECIMAL(12,2) NOT NULL CHECK (amount > 0),
+    currency     TEXT          NOT NULL DEFAULT 'RUB',
+    valid_from   TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    valid_to     TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    CHECK (valid_to IS NULL OR valid_to > valid_from)
+);
+
+CREATE INDEX IF NOT EXISTS idx_prices_valid ON payment.prices (valid_from, valid_to);
+
+-- базовая цена билета, раньше задавалась переменной TICKET_PRICE
+INSERT INTO payment.prices (amount, currency, valid_from) VALUES (100, 'RUB', '-infinity');
+
+CREATE TABLE IF NOT EXISTS payment.promotions (
+    id           SERIAL PRIMARY KEY,
+    name         TEXT          NOT NULL,
+    kind         TEXT          NOT NULL,
+    lottery_type TEXT,
+    draw_id      INTEGER,
+    buy          INTEGER       NOT NULL DEFAULT 0,
+    pay          INTEGER       NOT NULL DEFAULT 0,
+    percent      DECIMAL(5,2)  NOT NULL DEFAULT 0,
+    starts_at    TIMESTAMPTZ   NOT NULL,
+    ends_at      TIMESTAMPTZ   NOT NULL,
+    created_at   TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    CHECK (ends_at > starts_at),
+    CHECK (kind <> 'BUNDLE' OR (buy > pay AND pay > 0)),
+    CHECK (kind <> 'PERCENT' OR (percent > 0 AND percent < 100))
+);
+
+CREATE INDEX IF NOT EXISTS idx_promotions_period ON payment.promotions (starts_at, ends_at);
+
+ALTER TABLE payment.invoices
+    ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT 'RUB',
+    ADD COLUMN IF NOT EXISTS pricing JSONB NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE payment.invoices
+    DROP COLUMN IF EXISTS pricing,
+    DROP COLUMN IF EXISTS currency;
+
+DROP TABLE IF EXISTS payment.promotions;
+DROP TABLE IF EXISTS payment.prices;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS payment.vouchers (
+    id              SERIAL PRIMARY KEY,
+    code            TEXT          NOT NULL UNIQUE,
+    kind            TEXT          NOT NULL,
+    percent         DECIMAL(5,2)  NOT NULL DEFAULT 0,
+    amount          DECIMAL(12,2) NOT NULL DEFAULT 0,
+    currency        TEXT          NOT NULL DEFAULT '',
+    max_redemptions INTEGER       NOT NULL DEFAULT 0,
+    per_user_limit  INTEGER       NOT NULL DEFAULT 0,
+    redeemed        INTEGER       NOT NULL DEFAULT 0,
+    expires_at      TIMESTAMPTZ   NOT NULL,
+    created_at      TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    CHECK (max_redemptions >= 0 AND per_user_limit >= 0),
+    CHECK (redeemed >= 0 AND (max_redemptions = 0 OR redeemed <= max_redemptions)),
+    CHECK (kind <> 'PERCENT' OR (percent > 0 AND percent < 100)),
+    CHECK (kind <> 'FIXED' OR (amount > 0 AND currency <> ''))
+);
+
+CREATE TABLE IF NOT EXISTS payment.voucher_redemptions (
+    id          SERIAL PRIMARY KEY,
+    voucher_id  INTEGER       NOT NULL REFERENCES payment.vouchers,
+    user_id     INTEGER       NOT NULL,
+    invoice_id  INTEGER       NOT NULL UNIQUE REFERENCES payment.invoices ON DELETE CASCADE,
+    discount    DECIMAL(12,2) NOT NULL,
+    created_at  TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    released_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_voucher_redemptions_user ON payment.voucher_redemptions (voucher_id, user_id)
+    WHERE released_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS payment.voucher_redemptions;
+DROP TABLE IF EXISTS payment.vouchers;
+-- +goose StatementEnd
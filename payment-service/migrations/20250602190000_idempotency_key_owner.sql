@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Ключи идемпотентности разных пользователей не пересекаются: user_id - вызывающий из access-токена, 0 - анонимный запрос
+ALTER TABLE payment.idempotency_keys ADD COLUMN user_id BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE payment.idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE payment.idempotency_keys ADD PRIMARY KEY (method, user_id, key);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM payment.idempotency_keys WHERE user_id <> 0;
+
+ALTER TABLE payment.idempotency_keys DROP CONSTRAINT idempotency_keys_pkey;
+ALTER TABLE payment.idempotency_keys ADD PRIMARY KEY (method, key);
+ALTER TABLE payment.idempotency_keys DROP COLUMN user_id;
+-- +goose StatementEnd
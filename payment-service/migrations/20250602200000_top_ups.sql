@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Пополнение кошелька сохраняется до обращения к эквайеру и проходит те же фазы, что и платёж по счёту
+CREATE TABLE IF NOT EXISTS payment.top_ups (
+    id         SERIAL PRIMARY KEY,
+    user_id    INTEGER       NOT NULL,
+    amount     DECIMAL(12,2) NOT NULL CHECK (amount > 0),
+    status     TEXT          NOT NULL DEFAULT 'AUTHORIZING',
+    gateway_id BIGINT,
+    reason     TEXT          NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_top_ups_in_flight ON payment.top_ups (updated_at)
+    WHERE status IN ('AUTHORIZING', 'AUTHORIZED', 'CAPTURING');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS payment.top_ups;
+-- +goose StatementEnd
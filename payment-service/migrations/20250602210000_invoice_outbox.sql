@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- События о счетах пишутся в одной транзакции со сменой статуса счёта и публикуются после коммита:
+-- падение сервиса между коммитом и публикацией не теряет событие
+CREATE TABLE IF NOT EXISTS payment.invoice_outbox (
+    id           SERIAL PRIMARY KEY,
+    invoice_id   INTEGER     NOT NULL REFERENCES payment.invoices ON DELETE CASCADE,
+    event_type   TEXT        NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_invoice_outbox_pending ON payment.invoice_outbox (id) WHERE published_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS payment.invoice_outbox;
+-- +goose StatementEnd
@@ -0,0 +1,3 @@
+module github.com/MaxFando/lms/platform/scheduler
+
+go 1.23.8
@@ -0,0 +1,3165 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: payment-service/v1/payment-service.proto
+
+package payment_servicev1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	money "google.golang.org/genproto/googleapis/type/money"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateInvoiceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id - на кого выставить счёт; 0 - на пользователя из access-токена, на другого - только администратор.
+	// В CreateInvoiceInternal - пользователь, за которым забронированы билеты.
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Устаревшее: счёт на один билет, используйте ticket_ids.
+	TicketId  int64   `protobuf:"varint,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	TicketIds []int64 `protobuf:"varint,3,rep,packed,name=ticket_ids,json=ticketIds,proto3" json:"ticket_ids,omitempty"`
+	// idempotency_key - ключ повтора запроса; можно передать и в метаданных idempotency-key.
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// voucher_code - промокод на скидку, регистр не важен.
+	VoucherCode   string `protobuf:"bytes,5,opt,name=voucher_code,json=voucherCode,proto3" json:"voucher_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInvoiceRequest) Reset() {
+	*x = CreateInvoiceRequest{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvoiceRequest) ProtoMessage() {}
+
+func (x *CreateInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateInvoiceRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateInvoiceRequest) GetTicketId() int64 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *CreateInvoiceRequest) GetTicketIds() []int64 {
+	if x != nil {
+		return x.TicketIds
+	}
+	return nil
+}
+
+func (x *CreateInvoiceRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateInvoiceRequest) GetVoucherCode() string {
+	if x != nil {
+		return x.VoucherCode
+	}
+	return ""
+}
+
+type CreateInvoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Price         *money.Money           `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	Pricing       *Pricing               `protobuf:"bytes,3,opt,name=pricing,proto3" json:"pricing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInvoiceResponse) Reset() {
+	*x = CreateInvoiceResponse{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvoiceResponse) ProtoMessage() {}
+
+func (x *CreateInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*CreateInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateInvoiceResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateInvoiceResponse) GetPrice() *money.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CreateInvoiceResponse) GetPricing() *Pricing {
+	if x != nil {
+		return x.Pricing
+	}
+	return nil
+}
+
+type PriceLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PriceId       int64                  `protobuf:"varint,1,opt,name=price_id,json=priceId,proto3" json:"price_id,omitempty"`
+	DrawId        int64                  `protobuf:"varint,2,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	LotteryType   string                 `protobuf:"bytes,3,opt,name=lottery_type,json=lotteryType,proto3" json:"lottery_type,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     *money.Money           `protobuf:"bytes,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceLine) Reset() {
+	*x = PriceLine{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceLine) ProtoMessage() {}
+
+func (x *PriceLine) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceLine.ProtoReflect.Descriptor instead.
+func (*PriceLine) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PriceLine) GetPriceId() int64 {
+	if x != nil {
+		return x.PriceId
+	}
+	return 0
+}
+
+func (x *PriceLine) GetDrawId() int64 {
+	if x != nil {
+		return x.DrawId
+	}
+	return 0
+}
+
+func (x *PriceLine) GetLotteryType() string {
+	if x != nil {
+		return x.LotteryType
+	}
+	return ""
+}
+
+func (x *PriceLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PriceLine) GetUnitPrice() *money.Money {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+// Pricing - как посчитана сумма счёта: цены билетов и применённая акция.
+type Pricing struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Lines    []*PriceLine           `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	Subtotal *money.Money           `protobuf:"bytes,2,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	// promotion_id, promotion - применённая акция; 0 и пустая строка, если акций не было.
+	PromotionId int64        `protobuf:"varint,3,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Promotion   string       `protobuf:"bytes,4,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	Discount    *money.Money `protobuf:"bytes,5,opt,name=discount,proto3" json:"discount,omitempty"`
+	Total       *money.Money `protobuf:"bytes,6,opt,name=total,proto3" json:"total,omitempty"`
+	// voucher, voucher_discount - погашенный промокод и скидка по нему после акции.
+	Voucher         string       `protobuf:"bytes,7,opt,name=voucher,proto3" json:"voucher,omitempty"`
+	VoucherDiscount *money.Money `protobuf:"bytes,8,opt,name=voucher_discount,json=voucherDiscount,proto3" json:"voucher_discount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Pricing) Reset() {
+	*x = Pricing{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pricing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pricing) ProtoMessage() {}
+
+func (x *Pricing) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pricing.ProtoReflect.Descriptor instead.
+func (*Pricing) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Pricing) GetLines() []*PriceLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Pricing) GetSubtotal() *money.Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *Pricing) GetPromotionId() int64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *Pricing) GetPromotion() string {
+	if x != nil {
+		return x.Promotion
+	}
+	return ""
+}
+
+func (x *Pricing) GetDiscount() *money.Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *Pricing) GetTotal() *money.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *Pricing) GetVoucher() string {
+	if x != nil {
+		return x.Voucher
+	}
+	return ""
+}
+
+func (x *Pricing) GetVoucherDiscount() *money.Money {
+	if x != nil {
+		return x.VoucherDiscount
+	}
+	return nil
+}
+
+type CancelInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId     int64                  `protobuf:"varint,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelInvoiceRequest) Reset() {
+	*x = CancelInvoiceRequest{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInvoiceRequest) ProtoMessage() {}
+
+func (x *CancelInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*CancelInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CancelInvoiceRequest) GetInvoiceId() int64 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+type PayRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id - владелец счёта; 0 - пользователь из access-токена, за другого платит только администратор.
+	UserId     int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	InvoiceId  int64  `protobuf:"varint,2,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	CardNumber string `protobuf:"bytes,3,opt,name=card_number,json=cardNumber,proto3" json:"card_number,omitempty"`
+	ExpDate    string `protobuf:"bytes,4,opt,name=exp_date,json=expDate,proto3" json:"exp_date,omitempty"`
+	CVV        string `protobuf:"bytes,5,opt,name=CVV,proto3" json:"CVV,omitempty"`
+	// idempotency_key - ключ повтора запроса; можно передать и в метаданных idempotency-key.
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// from_wallet - оплата с кошелька вместо карты, данные карты не нужны.
+	FromWallet    bool `protobuf:"varint,7,opt,name=from_wallet,json=fromWallet,proto3" json:"from_wallet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayRequest) Reset() {
+	*x = PayRequest{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayRequest) ProtoMessage() {}
+
+func (x *PayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayRequest.ProtoReflect.Descriptor instead.
+func (*PayRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PayRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PayRequest) GetInvoiceId() int64 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+func (x *PayRequest) GetCardNumber() string {
+	if x != nil {
+		return x.CardNumber
+	}
+	return ""
+}
+
+func (x *PayRequest) GetExpDate() string {
+	if x != nil {
+		return x.ExpDate
+	}
+	return ""
+}
+
+func (x *PayRequest) GetCVV() string {
+	if x != nil {
+		return x.CVV
+	}
+	return ""
+}
+
+func (x *PayRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *PayRequest) GetFromWallet() bool {
+	if x != nil {
+		return x.FromWallet
+	}
+	return false
+}
+
+type GetInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId     int64                  `protobuf:"varint,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceRequest) Reset() {
+	*x = GetInvoiceRequest{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceRequest) ProtoMessage() {}
+
+func (x *GetInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetInvoiceRequest) GetInvoiceId() int64 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+type Invoice struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId       int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount       *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status       string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	TicketIds    []int64                `protobuf:"varint,5,rep,packed,name=ticket_ids,json=ticketIds,proto3" json:"ticket_ids,omitempty"`
+	RegisterTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=register_time,json=registerTime,proto3" json:"register_time,omitempty"`
+	DueDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	// payments - попытки оплаты счёта, заполняется только в GetInvoice.
+	Payments      []*Payment `protobuf:"bytes,8,rep,name=payments,proto3" json:"payments,omitempty"`
+	Pricing       *Pricing   `protobuf:"bytes,9,opt,name=pricing,proto3" json:"pricing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Invoice) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Invoice) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Invoice) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Invoice) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Invoice) GetTicketIds() []int64 {
+	if x != nil {
+		return x.TicketIds
+	}
+	return nil
+}
+
+func (x *Invoice) GetRegisterTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegisterTime
+	}
+	return nil
+}
+
+func (x *Invoice) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *Invoice) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *Invoice) GetPricing() *Pricing {
+	if x != nil {
+		return x.Pricing
+	}
+	return nil
+}
+
+type Payment struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	InvoiceId int64                  `protobuf:"varint,2,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	UserId    int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status    string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Amount    *money.Money           `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// reason - причина отказа или отмены платежа.
+	Reason      string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	TicketIds   []int64                `protobuf:"varint,7,rep,packed,name=ticket_ids,json=ticketIds,proto3" json:"ticket_ids,omitempty"`
+	PaymentTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=payment_time,json=paymentTime,proto3" json:"payment_time,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// method - CARD или WALLET.
+	Method        string `protobuf:"bytes,10,opt,name=method,proto3" json:"method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Payment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Payment) GetInvoiceId() int64 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+func (x *Payment) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Payment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Payment) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Payment) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Payment) GetTicketIds() []int64 {
+	if x != nil {
+		return x.TicketIds
+	}
+	return nil
+}
+
+func (x *Payment) GetPaymentTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaymentTime
+	}
+	return nil
+}
+
+func (x *Payment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Payment) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+type ListInvoicesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id - владелец счетов; 0 - текущий пользователь, для администратора - все пользователи.
+	UserId   int64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Statuses []string `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	// created_from, created_to - границы времени выставления в RFC3339, created_to не включается
+	CreatedFrom   string `protobuf:"bytes,3,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     string `protobuf:"bytes,4,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	PageSize      int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvoicesRequest) Reset() {
+	*x = ListInvoicesRequest{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesRequest) ProtoMessage() {}
+
+func (x *ListInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListInvoicesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListInvoicesRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListInvoicesRequest) GetCreatedFrom() string {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return ""
+}
+
+func (x *ListInvoicesRequest) GetCreatedTo() string {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return ""
+}
+
+func (x *ListInvoicesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListInvoicesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListInvoicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoices      []*Invoice             `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvoicesResponse) Reset() {
+	*x = ListInvoicesResponse{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesResponse) ProtoMessage() {}
+
+func (x *ListInvoicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvoicesResponse) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListInvoicesResponse) GetInvoices() []*Invoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+func (x *ListInvoicesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListPaymentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id - владелец платежей; 0 - текущий пользователь, для администратора - все пользователи.
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// invoice_id - только платежи по этому счёту, 0 - по всем.
+	InvoiceId int64    `protobuf:"varint,2,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Statuses  []string `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	// created_from, created_to - границы времени платежа в RFC3339, created_to не включается
+	CreatedFrom   string `protobuf:"bytes,4,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     string `protobuf:"bytes,5,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	PageSize      int32  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentsRequest) Reset() {
+	*x = ListPaymentsRequest{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentsRequest) ProtoMessage() {}
+
+func (x *ListPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListPaymentsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListPaymentsRequest) GetInvoiceId() int64 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+func (x *ListPaymentsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListPaymentsRequest) GetCreatedFrom() string {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return ""
+}
+
+func (x *ListPaymentsRequest) GetCreatedTo() string {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return ""
+}
+
+func (x *ListPaymentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPaymentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPaymentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payments      []*Payment             `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentsResponse) Reset() {
+	*x = ListPaymentsResponse{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentsResponse) ProtoMessage() {}
+
+func (x *ListPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListPaymentsResponse) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *ListPaymentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type TopUpRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Amount     *money.Money           `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	CardNumber string                 `protobuf:"bytes,2,opt,name=card_number,json=cardNumber,proto3" json:"card_number,omitempty"`
+	ExpDate    string                 `protobuf:"bytes,3,opt,name=exp_date,json=expDate,proto3" json:"exp_date,omitempty"`
+	CVV        string                 `protobuf:"bytes,4,opt,name=CVV,proto3" json:"CVV,omitempty"`
+	// idempotency_key - ключ повтора запроса; можно передать и в метаданных idempotency-key.
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TopUpRequest) Reset() {
+	*x = TopUpRequest{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpRequest) ProtoMessage() {}
+
+func (x *TopUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpRequest.ProtoReflect.Descriptor instead.
+func (*TopUpRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TopUpRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *TopUpRequest) GetCardNumber() string {
+	if x != nil {
+		return x.CardNumber
+	}
+	return ""
+}
+
+func (x *TopUpRequest) GetExpDate() string {
+	if x != nil {
+		return x.ExpDate
+	}
+	return ""
+}
+
+func (x *TopUpRequest) GetCVV() string {
+	if x != nil {
+		return x.CVV
+	}
+	return ""
+}
+
+func (x *TopUpRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type CreditWinningsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount *money.Money           `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// reference - идентификатор выигрыша, например билета.
+	Reference     string `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditWinningsRequest) Reset() {
+	*x = CreditWinningsRequest{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditWinningsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditWinningsRequest) ProtoMessage() {}
+
+func (x *CreditWinningsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditWinningsRequest.ProtoReflect.Descriptor instead.
+func (*CreditWinningsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreditWinningsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreditWinningsRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CreditWinningsRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type WalletTransaction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// kind - TOP_UP, INVOICE_PAYMENT, REFUND или WINNINGS.
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// amount - положительная сумма зачислена на кошелёк, отрицательная списана.
+	Amount        *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	InvoiceId     int64                  `protobuf:"varint,4,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Reference     string                 `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletTransaction) Reset() {
+	*x = WalletTransaction{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletTransaction) ProtoMessage() {}
+
+func (x *WalletTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletTransaction.ProtoReflect.Descriptor instead.
+func (*WalletTransaction) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WalletTransaction) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *WalletTransaction) GetInvoiceId() int64 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type WalletOperationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *WalletTransaction     `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Balance       *money.Money           `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletOperationResponse) Reset() {
+	*x = WalletOperationResponse{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletOperationResponse) ProtoMessage() {}
+
+func (x *WalletOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletOperationResponse.ProtoReflect.Descriptor instead.
+func (*WalletOperationResponse) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WalletOperationResponse) GetTransaction() *WalletTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *WalletOperationResponse) GetBalance() *money.Money {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+type GetBalanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id - владелец кошелька; 0 - текущий пользователь.
+	UserId        int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceRequest) Reset() {
+	*x = GetBalanceRequest{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceRequest) ProtoMessage() {}
+
+func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetBalanceRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type Balance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Balance       *money.Money           `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Balance) Reset() {
+	*x = Balance{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Balance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Balance) ProtoMessage() {}
+
+func (x *Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Balance) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Balance) GetBalance() *money.Money {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+type ListTransactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id - владелец кошелька; 0 - текущий пользователь.
+	UserId        int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsRequest) Reset() {
+	*x = ListTransactionsRequest{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsRequest) ProtoMessage() {}
+
+func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListTransactionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*WalletTransaction   `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsResponse) Reset() {
+	*x = ListTransactionsResponse{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsResponse) ProtoMessage() {}
+
+func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListTransactionsResponse) GetTransactions() []*WalletTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *ListTransactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RefundInvoiceRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId int64                  `protobuf:"varint,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	// amount - сумма возврата; если не задана, возвращается весь остаток по счёту.
+	Amount *money.Money `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason string       `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// idempotency_key - ключ повтора запроса; можно передать и в метаданных idempotency-key.
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RefundInvoiceRequest) Reset() {
+	*x = RefundInvoiceRequest{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundInvoiceRequest) ProtoMessage() {}
+
+func (x *RefundInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*RefundInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RefundInvoiceRequest) GetInvoiceId() int64 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+func (x *RefundInvoiceRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *RefundInvoiceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RefundInvoiceRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type Refund struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	InvoiceId int64                  `protobuf:"varint,2,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Amount    *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason    string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// status - PENDING, SUCCEEDED или FAILED; PENDING-возврат повторяется автоматически.
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts      int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Refund) Reset() {
+	*x = Refund{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Refund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Refund) ProtoMessage() {}
+
+func (x *Refund) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Refund.ProtoReflect.Descriptor instead.
+func (*Refund) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Refund) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Refund) GetInvoiceId() int64 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+func (x *Refund) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Refund) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Refund) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Refund) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Refund) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Refund) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListPayoutsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id - получатель выплат, 0 - все пользователи.
+	UserId        int64    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Statuses      []string `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	PageSize      int32    `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string   `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayoutsRequest) Reset() {
+	*x = ListPayoutsRequest{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayoutsRequest) ProtoMessage() {}
+
+func (x *ListPayoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayoutsRequest.ProtoReflect.Descriptor instead.
+func (*ListPayoutsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListPayoutsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListPayoutsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListPayoutsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPayoutsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPayoutsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payouts       []*Payout              `protobuf:"bytes,1,rep,name=payouts,proto3" json:"payouts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayoutsResponse) Reset() {
+	*x = ListPayoutsResponse{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayoutsResponse) ProtoMessage() {}
+
+func (x *ListPayoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayoutsResponse.ProtoReflect.Descriptor instead.
+func (*ListPayoutsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListPayoutsResponse) GetPayouts() []*Payout {
+	if x != nil {
+		return x.Payouts
+	}
+	return nil
+}
+
+func (x *ListPayoutsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ApprovePayoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayoutId      int64                  `protobuf:"varint,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovePayoutRequest) Reset() {
+	*x = ApprovePayoutRequest{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovePayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovePayoutRequest) ProtoMessage() {}
+
+func (x *ApprovePayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovePayoutRequest.ProtoReflect.Descriptor instead.
+func (*ApprovePayoutRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ApprovePayoutRequest) GetPayoutId() int64 {
+	if x != nil {
+		return x.PayoutId
+	}
+	return 0
+}
+
+func (x *ApprovePayoutRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type PayoutAuditRecord struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// action - CREATED, CREDITED, CREDIT_FAILED или APPROVED.
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// actor_id - администратор; 0 - действие самого сервиса.
+	ActorId       int64                  `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	FromStatus    string                 `protobuf:"bytes,3,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,4,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayoutAuditRecord) Reset() {
+	*x = PayoutAuditRecord{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayoutAuditRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutAuditRecord) ProtoMessage() {}
+
+func (x *PayoutAuditRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoutAuditRecord.ProtoReflect.Descriptor instead.
+func (*PayoutAuditRecord) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PayoutAuditRecord) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *PayoutAuditRecord) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *PayoutAuditRecord) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *PayoutAuditRecord) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *PayoutAuditRecord) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *PayoutAuditRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Payout struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TicketId int64                  `protobuf:"varint,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	UserId   int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// syndicate_id - синдикат, от которого участнику пришла доля выигрыша; 0 - личный билет.
+	SyndicateId int64        `protobuf:"varint,4,opt,name=syndicate_id,json=syndicateId,proto3" json:"syndicate_id,omitempty"`
+	DrawId      int64        `protobuf:"varint,5,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	Amount      *money.Money `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// status - PENDING, AWAITING_APPROVAL или PAID.
+	Status string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	// transaction_id - проводка зачисления на кошелёк.
+	TransactionId int64                  `protobuf:"varint,8,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Audit         []*PayoutAuditRecord   `protobuf:"bytes,10,rep,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payout) Reset() {
+	*x = Payout{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payout) ProtoMessage() {}
+
+func (x *Payout) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payout.ProtoReflect.Descriptor instead.
+func (*Payout) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Payout) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Payout) GetTicketId() int64 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *Payout) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Payout) GetSyndicateId() int64 {
+	if x != nil {
+		return x.SyndicateId
+	}
+	return 0
+}
+
+func (x *Payout) GetDrawId() int64 {
+	if x != nil {
+		return x.DrawId
+	}
+	return 0
+}
+
+func (x *Payout) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Payout) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Payout) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *Payout) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Payout) GetAudit() []*PayoutAuditRecord {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+type SetPriceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// lottery_type, draw_id - на что цена; оба пустые - базовая цена любого билета.
+	LotteryType string       `protobuf:"bytes,1,opt,name=lottery_type,json=lotteryType,proto3" json:"lottery_type,omitempty"`
+	DrawId      int64        `protobuf:"varint,2,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	Amount      *money.Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// valid_from - начало действия, по умолчанию сейчас; valid_to - конец, пустой - бессрочно.
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPriceRequest) Reset() {
+	*x = SetPriceRequest{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceRequest) ProtoMessage() {}
+
+func (x *SetPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceRequest.ProtoReflect.Descriptor instead.
+func (*SetPriceRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetPriceRequest) GetLotteryType() string {
+	if x != nil {
+		return x.LotteryType
+	}
+	return ""
+}
+
+func (x *SetPriceRequest) GetDrawId() int64 {
+	if x != nil {
+		return x.DrawId
+	}
+	return 0
+}
+
+func (x *SetPriceRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *SetPriceRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *SetPriceRequest) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+type Price struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LotteryType   string                 `protobuf:"bytes,2,opt,name=lottery_type,json=lotteryType,proto3" json:"lottery_type,omitempty"`
+	DrawId        int64                  `protobuf:"varint,3,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	Amount        *money.Money           `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Price) Reset() {
+	*x = Price{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Price) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Price) ProtoMessage() {}
+
+func (x *Price) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Price.ProtoReflect.Descriptor instead.
+func (*Price) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Price) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Price) GetLotteryType() string {
+	if x != nil {
+		return x.LotteryType
+	}
+	return ""
+}
+
+func (x *Price) GetDrawId() int64 {
+	if x != nil {
+		return x.DrawId
+	}
+	return 0
+}
+
+func (x *Price) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Price) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *Price) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+type CreatePromotionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// kind - BUNDLE (из buy билетов оплачиваются pay) или PERCENT (скидка percent процентов).
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// lottery_type, draw_id - на какие билеты действует акция; оба пустые - на все.
+	LotteryType   string                 `protobuf:"bytes,3,opt,name=lottery_type,json=lotteryType,proto3" json:"lottery_type,omitempty"`
+	DrawId        int64                  `protobuf:"varint,4,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	Buy           int32                  `protobuf:"varint,5,opt,name=buy,proto3" json:"buy,omitempty"`
+	Pay           int32                  `protobuf:"varint,6,opt,name=pay,proto3" json:"pay,omitempty"`
+	Percent       float64                `protobuf:"fixed64,7,opt,name=percent,proto3" json:"percent,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromotionRequest) Reset() {
+	*x = CreatePromotionRequest{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionRequest) ProtoMessage() {}
+
+func (x *CreatePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreatePromotionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetLotteryType() string {
+	if x != nil {
+		return x.LotteryType
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetDrawId() int64 {
+	if x != nil {
+		return x.DrawId
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetBuy() int32 {
+	if x != nil {
+		return x.Buy
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetPay() int32 {
+	if x != nil {
+		return x.Pay
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CreatePromotionRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+type Promotion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	LotteryType   string                 `protobuf:"bytes,4,opt,name=lottery_type,json=lotteryType,proto3" json:"lottery_type,omitempty"`
+	DrawId        int64                  `protobuf:"varint,5,opt,name=draw_id,json=drawId,proto3" json:"draw_id,omitempty"`
+	Buy           int32                  `protobuf:"varint,6,opt,name=buy,proto3" json:"buy,omitempty"`
+	Pay           int32                  `protobuf:"varint,7,opt,name=pay,proto3" json:"pay,omitempty"`
+	Percent       float64                `protobuf:"fixed64,8,opt,name=percent,proto3" json:"percent,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Promotion) Reset() {
+	*x = Promotion{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Promotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Promotion) ProtoMessage() {}
+
+func (x *Promotion) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Promotion.ProtoReflect.Descriptor instead.
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Promotion) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Promotion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Promotion) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Promotion) GetLotteryType() string {
+	if x != nil {
+		return x.LotteryType
+	}
+	return ""
+}
+
+func (x *Promotion) GetDrawId() int64 {
+	if x != nil {
+		return x.DrawId
+	}
+	return 0
+}
+
+func (x *Promotion) GetBuy() int32 {
+	if x != nil {
+		return x.Buy
+	}
+	return 0
+}
+
+func (x *Promotion) GetPay() int32 {
+	if x != nil {
+		return x.Pay
+	}
+	return 0
+}
+
+func (x *Promotion) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *Promotion) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Promotion) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+type CreateVoucherRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// kind - PERCENT (скидка percent процентов) или FIXED (скидка amount).
+	Kind    string       `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Percent float64      `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"`
+	Amount  *money.Money `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// max_redemptions, per_user_limit - сколько раз код можно погасить всего и одному пользователю; 0 - без ограничений.
+	MaxRedemptions int32                  `protobuf:"varint,5,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	PerUserLimit   int32                  `protobuf:"varint,6,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateVoucherRequest) Reset() {
+	*x = CreateVoucherRequest{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVoucherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVoucherRequest) ProtoMessage() {}
+
+func (x *CreateVoucherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVoucherRequest.ProtoReflect.Descriptor instead.
+func (*CreateVoucherRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateVoucherRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateVoucherRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateVoucherRequest) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *CreateVoucherRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CreateVoucherRequest) GetMaxRedemptions() int32 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *CreateVoucherRequest) GetPerUserLimit() int32 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *CreateVoucherRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type GetVoucherRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVoucherRequest) Reset() {
+	*x = GetVoucherRequest{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVoucherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVoucherRequest) ProtoMessage() {}
+
+func (x *GetVoucherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVoucherRequest.ProtoReflect.Descriptor instead.
+func (*GetVoucherRequest) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetVoucherRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type Voucher struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Kind           string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Percent        float64                `protobuf:"fixed64,4,opt,name=percent,proto3" json:"percent,omitempty"`
+	Amount         *money.Money           `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	MaxRedemptions int32                  `protobuf:"varint,6,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	PerUserLimit   int32                  `protobuf:"varint,7,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"`
+	// redeemed - сколько раз код погашен, не считая просроченных и отменённых счетов.
+	Redeemed      int32                  `protobuf:"varint,8,opt,name=redeemed,proto3" json:"redeemed,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Voucher) Reset() {
+	*x = Voucher{}
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Voucher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Voucher) ProtoMessage() {}
+
+func (x *Voucher) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_service_v1_payment_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Voucher.ProtoReflect.Descriptor instead.
+func (*Voucher) Descriptor() ([]byte, []int) {
+	return file_payment_service_v1_payment_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Voucher) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Voucher) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Voucher) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Voucher) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *Voucher) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Voucher) GetMaxRedemptions() int32 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *Voucher) GetPerUserLimit() int32 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *Voucher) GetRedeemed() int32 {
+	if x != nil {
+		return x.Redeemed
+	}
+	return 0
+}
+
+func (x *Voucher) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Voucher) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_payment_service_v1_payment_service_proto protoreflect.FileDescriptor
+
+const file_payment_service_v1_payment_service_proto_rawDesc = "" +
+	"\n" +
+	"(payment-service/v1/payment-service.proto\x12\x12payment_service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17google/type/money.proto\"\xb7\x01\n" +
+	"\x14CreateInvoiceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tticket_id\x18\x02 \x01(\x03R\bticketId\x12\x1d\n" +
+	"\n" +
+	"ticket_ids\x18\x03 \x03(\x03R\tticketIds\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\x12!\n" +
+	"\fvoucher_code\x18\x05 \x01(\tR\vvoucherCode\"\x88\x01\n" +
+	"\x15CreateInvoiceResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12(\n" +
+	"\x05price\x18\x02 \x01(\v2\x12.google.type.MoneyR\x05price\x125\n" +
+	"\apricing\x18\x03 \x01(\v2\x1b.payment_service.v1.PricingR\apricing\"\xb1\x01\n" +
+	"\tPriceLine\x12\x19\n" +
+	"\bprice_id\x18\x01 \x01(\x03R\apriceId\x12\x17\n" +
+	"\adraw_id\x18\x02 \x01(\x03R\x06drawId\x12!\n" +
+	"\flottery_type\x18\x03 \x01(\tR\vlotteryType\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x121\n" +
+	"\n" +
+	"unit_price\x18\x05 \x01(\v2\x12.google.type.MoneyR\tunitPrice\"\xe2\x02\n" +
+	"\aPricing\x123\n" +
+	"\x05lines\x18\x01 \x03(\v2\x1d.payment_service.v1.PriceLineR\x05lines\x12.\n" +
+	"\bsubtotal\x18\x02 \x01(\v2\x12.google.type.MoneyR\bsubtotal\x12!\n" +
+	"\fpromotion_id\x18\x03 \x01(\x03R\vpromotionId\x12\x1c\n" +
+	"\tpromotion\x18\x04 \x01(\tR\tpromotion\x12.\n" +
+	"\bdiscount\x18\x05 \x01(\v2\x12.google.type.MoneyR\bdiscount\x12(\n" +
+	"\x05total\x18\x06 \x01(\v2\x12.google.type.MoneyR\x05total\x12\x18\n" +
+	"\avoucher\x18\a \x01(\tR\avoucher\x12=\n" +
+	"\x10voucher_discount\x18\b \x01(\v2\x12.google.type.MoneyR\x0fvoucherDiscount\"5\n" +
+	"\x14CancelInvoiceRequest\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\x03R\tinvoiceId\"\xdc\x01\n" +
+	"\n" +
+	"PayRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x02 \x01(\x03R\tinvoiceId\x12\x1f\n" +
+	"\vcard_number\x18\x03 \x01(\tR\n" +
+	"cardNumber\x12\x19\n" +
+	"\bexp_date\x18\x04 \x01(\tR\aexpDate\x12\x10\n" +
+	"\x03CVV\x18\x05 \x01(\tR\x03CVV\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\x12\x1f\n" +
+	"\vfrom_wallet\x18\a \x01(\bR\n" +
+	"fromWallet\"2\n" +
+	"\x11GetInvoiceRequest\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\x03R\tinvoiceId\"\xfd\x02\n" +
+	"\aInvoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12*\n" +
+	"\x06amount\x18\x03 \x01(\v2\x12.google.type.MoneyR\x06amount\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"ticket_ids\x18\x05 \x03(\x03R\tticketIds\x12?\n" +
+	"\rregister_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fregisterTime\x125\n" +
+	"\bdue_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x127\n" +
+	"\bpayments\x18\b \x03(\v2\x1b.payment_service.v1.PaymentR\bpayments\x125\n" +
+	"\apricing\x18\t \x01(\v2\x1b.payment_service.v1.PricingR\apricing\"\xde\x02\n" +
+	"\aPayment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x02 \x01(\x03R\tinvoiceId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12*\n" +
+	"\x06amount\x18\x05 \x01(\v2\x12.google.type.MoneyR\x06amount\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"ticket_ids\x18\a \x03(\x03R\tticketIds\x12=\n" +
+	"\fpayment_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vpaymentTime\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06method\x18\n" +
+	" \x01(\tR\x06method\"\xc8\x01\n" +
+	"\x13ListInvoicesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bstatuses\x18\x02 \x03(\tR\bstatuses\x12!\n" +
+	"\fcreated_from\x18\x03 \x01(\tR\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\x04 \x01(\tR\tcreatedTo\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"w\n" +
+	"\x14ListInvoicesResponse\x127\n" +
+	"\binvoices\x18\x01 \x03(\v2\x1b.payment_service.v1.InvoiceR\binvoices\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xe7\x01\n" +
+	"\x13ListPaymentsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x02 \x01(\x03R\tinvoiceId\x12\x1a\n" +
+	"\bstatuses\x18\x03 \x03(\tR\bstatuses\x12!\n" +
+	"\fcreated_from\x18\x04 \x01(\tR\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\x05 \x01(\tR\tcreatedTo\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\"w\n" +
+	"\x14ListPaymentsResponse\x127\n" +
+	"\bpayments\x18\x01 \x03(\v2\x1b.payment_service.v1.PaymentR\bpayments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xb1\x01\n" +
+	"\fTopUpRequest\x12*\n" +
+	"\x06amount\x18\x01 \x01(\v2\x12.google.type.MoneyR\x06amount\x12\x1f\n" +
+	"\vcard_number\x18\x02 \x01(\tR\n" +
+	"cardNumber\x12\x19\n" +
+	"\bexp_date\x18\x03 \x01(\tR\aexpDate\x12\x10\n" +
+	"\x03CVV\x18\x04 \x01(\tR\x03CVV\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\"z\n" +
+	"\x15CreditWinningsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12*\n" +
+	"\x06amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x06amount\x12\x1c\n" +
+	"\treference\x18\x03 \x01(\tR\treference\"\xdb\x01\n" +
+	"\x11WalletTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12*\n" +
+	"\x06amount\x18\x03 \x01(\v2\x12.google.type.MoneyR\x06amount\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x04 \x01(\x03R\tinvoiceId\x12\x1c\n" +
+	"\treference\x18\x05 \x01(\tR\treference\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x90\x01\n" +
+	"\x17WalletOperationResponse\x12G\n" +
+	"\vtransaction\x18\x01 \x01(\v2%.payment_service.v1.WalletTransactionR\vtransaction\x12,\n" +
+	"\abalance\x18\x02 \x01(\v2\x12.google.type.MoneyR\abalance\",\n" +
+	"\x11GetBalanceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"P\n" +
+	"\aBalance\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12,\n" +
+	"\abalance\x18\x02 \x01(\v2\x12.google.type.MoneyR\abalance\"n\n" +
+	"\x17ListTransactionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x8d\x01\n" +
+	"\x18ListTransactionsResponse\x12I\n" +
+	"\ftransactions\x18\x01 \x03(\v2%.payment_service.v1.WalletTransactionR\ftransactions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa2\x01\n" +
+	"\x14RefundInvoiceRequest\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\x03R\tinvoiceId\x12*\n" +
+	"\x06amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x06amount\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"\x89\x02\n" +
+	"\x06Refund\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x02 \x01(\x03R\tinvoiceId\x12*\n" +
+	"\x06amount\x18\x03 \x01(\v2\x12.google.type.MoneyR\x06amount\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x85\x01\n" +
+	"\x12ListPayoutsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bstatuses\x18\x02 \x03(\tR\bstatuses\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"s\n" +
+	"\x13ListPayoutsResponse\x124\n" +
+	"\apayouts\x18\x01 \x03(\v2\x1a.payment_service.v1.PayoutR\apayouts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"G\n" +
+	"\x14ApprovePayoutRequest\x12\x1b\n" +
+	"\tpayout_id\x18\x01 \x01(\x03R\bpayoutId\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\"\xd3\x01\n" +
+	"\x11PayoutAuditRecord\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\x03R\aactorId\x12\x1f\n" +
+	"\vfrom_status\x18\x03 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x04 \x01(\tR\btoStatus\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xed\x02\n" +
+	"\x06Payout\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tticket_id\x18\x02 \x01(\x03R\bticketId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12!\n" +
+	"\fsyndicate_id\x18\x04 \x01(\x03R\vsyndicateId\x12\x17\n" +
+	"\adraw_id\x18\x05 \x01(\x03R\x06drawId\x12*\n" +
+	"\x06amount\x18\x06 \x01(\v2\x12.google.type.MoneyR\x06amount\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12%\n" +
+	"\x0etransaction_id\x18\b \x01(\x03R\rtransactionId\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\x05audit\x18\n" +
+	" \x03(\v2%.payment_service.v1.PayoutAuditRecordR\x05audit\"\xeb\x01\n" +
+	"\x0fSetPriceRequest\x12!\n" +
+	"\flottery_type\x18\x01 \x01(\tR\vlotteryType\x12\x17\n" +
+	"\adraw_id\x18\x02 \x01(\x03R\x06drawId\x12*\n" +
+	"\x06amount\x18\x03 \x01(\v2\x12.google.type.MoneyR\x06amount\x129\n" +
+	"\n" +
+	"valid_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\"\xf1\x01\n" +
+	"\x05Price\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\flottery_type\x18\x02 \x01(\tR\vlotteryType\x12\x17\n" +
+	"\adraw_id\x18\x03 \x01(\x03R\x06drawId\x12*\n" +
+	"\x06amount\x18\x04 \x01(\v2\x12.google.type.MoneyR\x06amount\x129\n" +
+	"\n" +
+	"valid_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\"\xa8\x02\n" +
+	"\x16CreatePromotionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12!\n" +
+	"\flottery_type\x18\x03 \x01(\tR\vlotteryType\x12\x17\n" +
+	"\adraw_id\x18\x04 \x01(\x03R\x06drawId\x12\x10\n" +
+	"\x03buy\x18\x05 \x01(\x05R\x03buy\x12\x10\n" +
+	"\x03pay\x18\x06 \x01(\x05R\x03pay\x12\x18\n" +
+	"\apercent\x18\a \x01(\x01R\apercent\x127\n" +
+	"\tstarts_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\"\xab\x02\n" +
+	"\tPromotion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12!\n" +
+	"\flottery_type\x18\x04 \x01(\tR\vlotteryType\x12\x17\n" +
+	"\adraw_id\x18\x05 \x01(\x03R\x06drawId\x12\x10\n" +
+	"\x03buy\x18\x06 \x01(\x05R\x03buy\x12\x10\n" +
+	"\x03pay\x18\a \x01(\x05R\x03pay\x12\x18\n" +
+	"\apercent\x18\b \x01(\x01R\apercent\x127\n" +
+	"\tstarts_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\"\x8e\x02\n" +
+	"\x14CreateVoucherRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x18\n" +
+	"\apercent\x18\x03 \x01(\x01R\apercent\x12*\n" +
+	"\x06amount\x18\x04 \x01(\v2\x12.google.type.MoneyR\x06amount\x12'\n" +
+	"\x0fmax_redemptions\x18\x05 \x01(\x05R\x0emaxRedemptions\x12$\n" +
+	"\x0eper_user_limit\x18\x06 \x01(\x05R\fperUserLimit\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"'\n" +
+	"\x11GetVoucherRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\xe8\x02\n" +
+	"\aVoucher\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x18\n" +
+	"\apercent\x18\x04 \x01(\x01R\apercent\x12*\n" +
+	"\x06amount\x18\x05 \x01(\v2\x12.google.type.MoneyR\x06amount\x12'\n" +
+	"\x0fmax_redemptions\x18\x06 \x01(\x05R\x0emaxRedemptions\x12$\n" +
+	"\x0eper_user_limit\x18\a \x01(\x05R\fperUserLimit\x12\x1a\n" +
+	"\bredeemed\x18\b \x01(\x05R\bredeemed\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt2\x94\x11\n" +
+	"\x0ePaymentService\x12}\n" +
+	"\rCreateInvoice\x12(.payment_service.v1.CreateInvoiceRequest\x1a).payment_service.v1.CreateInvoiceResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/api/invoice\x12\x81\x01\n" +
+	"\x15CreateInvoiceInternal\x12(.payment_service.v1.CreateInvoiceRequest\x1a).payment_service.v1.CreateInvoiceResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/invoice\x12\x82\x01\n" +
+	"\x15CancelInvoiceInternal\x12(.payment_service.v1.CancelInvoiceRequest\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/invoice/{invoice_id}/cancel\x12R\n" +
+	"\x03Pay\x12\x1e.payment_service.v1.PayRequest\x1a\x16.google.protobuf.Empty\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/api/pay\x12s\n" +
+	"\n" +
+	"GetInvoice\x12%.payment_service.v1.GetInvoiceRequest\x1a\x1b.payment_service.v1.Invoice\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/invoice/{invoice_id}\x12x\n" +
+	"\fListInvoices\x12'.payment_service.v1.ListInvoicesRequest\x1a(.payment_service.v1.ListInvoicesResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/invoices\x12x\n" +
+	"\fListPayments\x12'.payment_service.v1.ListPaymentsRequest\x1a(.payment_service.v1.ListPaymentsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/payments\x12u\n" +
+	"\x05TopUp\x12 .payment_service.v1.TopUpRequest\x1a+.payment_service.v1.WalletOperationResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/wallet/top-up\x12e\n" +
+	"\n" +
+	"GetBalance\x12%.payment_service.v1.GetBalanceRequest\x1a\x1b.payment_service.v1.Balance\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/api/wallet\x12\x8f\x01\n" +
+	"\x10ListTransactions\x12+.payment_service.v1.ListTransactionsRequest\x1a,.payment_service.v1.ListTransactionsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/wallet/transactions\x12\x8d\x01\n" +
+	"\x16CreditWinningsInternal\x12).payment_service.v1.CreditWinningsRequest\x1a+.payment_service.v1.WalletOperationResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/wallet/winnings\x12\x82\x01\n" +
+	"\rRefundInvoice\x12(.payment_service.v1.RefundInvoiceRequest\x1a\x1a.payment_service.v1.Refund\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/invoice/{invoice_id}/refund\x12t\n" +
+	"\vListPayouts\x12&.payment_service.v1.ListPayoutsRequest\x1a'.payment_service.v1.ListPayoutsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/api/payouts\x12\x81\x01\n" +
+	"\rApprovePayout\x12(.payment_service.v1.ApprovePayoutRequest\x1a\x1a.payment_service.v1.Payout\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/payout/{payout_id}/approve\x12b\n" +
+	"\bSetPrice\x12#.payment_service.v1.SetPriceRequest\x1a\x19.payment_service.v1.Price\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/api/prices\x12x\n" +
+	"\x0fCreatePromotion\x12*.payment_service.v1.CreatePromotionRequest\x1a\x1d.payment_service.v1.Promotion\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/promotions\x12p\n" +
+	"\rCreateVoucher\x12(.payment_service.v1.CreateVoucherRequest\x1a\x1b.payment_service.v1.Voucher\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/vouchers\x12n\n" +
+	"\n" +
+	"GetVoucher\x12%.payment_service.v1.GetVoucherRequest\x1a\x1b.payment_service.v1.Voucher\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/vouchers/{code}B\xdf\x01\n" +
+	"\x16com.payment_service.v1B\x13PaymentServiceProtoP\x01ZKgithub.com/MaxFando/lms/ticket-service/payment-service/v1;payment_servicev1\xa2\x02\x03PXX\xaa\x02\x11PaymentService.V1\xca\x02\x11PaymentService\\V1\xe2\x02\x1dPaymentService\\V1\\GPBMetadata\xea\x02\x12PaymentService::V1b\x06proto3"
+
+var (
+	file_payment_service_v1_payment_service_proto_rawDescOnce sync.Once
+	file_payment_service_v1_payment_service_proto_rawDescData []byte
+)
+
+func file_payment_service_v1_payment_service_proto_rawDescGZIP() []byte {
+	file_payment_service_v1_payment_service_proto_rawDescOnce.Do(func() {
+		file_payment_service_v1_payment_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_payment_service_v1_payment_service_proto_rawDesc), len(file_payment_service_v1_payment_service_proto_rawDesc)))
+	})
+	return file_payment_service_v1_payment_service_proto_rawDescData
+}
+
+var file_payment_service_v1_payment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_payment_service_v1_payment_service_proto_goTypes = []any{
+	(*CreateInvoiceRequest)(nil),     // 0: payment_service.v1.CreateInvoiceRequest
+	(*CreateInvoiceResponse)(nil),    // 1: payment_service.v1.CreateInvoiceResponse
+	(*PriceLine)(nil),                // 2: payment_service.v1.PriceLine
+	(*Pricing)(nil),                  // 3: payment_service.v1.Pricing
+	(*CancelInvoiceRequest)(nil),     // 4: payment_service.v1.CancelInvoiceRequest
+	(*PayRequest)(nil),               // 5: payment_service.v1.PayRequest
+	(*GetInvoiceRequest)(nil),        // 6: payment_service.v1.GetInvoiceRequest
+	(*Invoice)(nil),                  // 7: payment_service.v1.Invoice
+	(*Payment)(nil),                  // 8: payment_service.v1.Payment
+	(*ListInvoicesRequest)(nil),      // 9: payment_service.v1.ListInvoicesRequest
+	(*ListInvoicesResponse)(nil),     // 10: payment_service.v1.ListInvoicesResponse
+	(*ListPaymentsRequest)(nil),      // 11: payment_service.v1.ListPaymentsRequest
+	(*ListPaymentsResponse)(nil),     // 12: payment_service.v1.ListPaymentsResponse
+	(*TopUpRequest)(nil),             // 13: payment_service.v1.TopUpRequest
+	(*CreditWinningsRequest)(nil),    // 14: payment_service.v1.CreditWinningsRequest
+	(*WalletTransaction)(nil),        // 15: payment_service.v1.WalletTransaction
+	(*WalletOperationResponse)(nil),  // 16: payment_service.v1.WalletOperationResponse
+	(*GetBalanceRequest)(nil),        // 17: payment_service.v1.GetBalanceRequest
+	(*Balance)(nil),                  // 18: payment_service.v1.Balance
+	(*ListTransactionsRequest)(nil),  // 19: payment_service.v1.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil), // 20: payment_service.v1.ListTransactionsResponse
+	(*RefundInvoiceRequest)(nil),     // 21: payment_service.v1.RefundInvoiceRequest
+	(*Refund)(nil),                   // 22: payment_service.v1.Refund
+	(*ListPayoutsRequest)(nil),       // 23: payment_service.v1.ListPayoutsRequest
+	(*ListPayoutsResponse)(nil),      // 24: payment_service.v1.ListPayoutsResponse
+	(*ApprovePayoutRequest)(nil),     // 25: payment_service.v1.ApprovePayoutRequest
+	(*PayoutAuditRecord)(nil),        // 26: payment_service.v1.PayoutAuditRecord
+	(*Payout)(nil),                   // 27: payment_service.v1.Payout
+	(*SetPriceRequest)(nil),          // 28: payment_service.v1.SetPriceRequest
+	(*Price)(nil),                    // 29: payment_service.v1.Price
+	(*CreatePromotionRequest)(nil),   // 30: payment_service.v1.CreatePromotionRequest
+	(*Promotion)(nil),                // 31: payment_service.v1.Promotion
+	(*CreateVoucherRequest)(nil),     // 32: payment_service.v1.CreateVoucherRequest
+	(*GetVoucherRequest)(nil),        // 33: payment_service.v1.GetVoucherRequest
+	(*Voucher)(nil),                  // 34: payment_service.v1.Voucher
+	(*money.Money)(nil),              // 35: google.type.Money
+	(*timestamppb.Timestamp)(nil),    // 36: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),            // 37: google.protobuf.Empty
+}
+var file_payment_service_v1_payment_service_proto_depIdxs = []int32{
+	35, // 0: payment_service.v1.CreateInvoiceResponse.price:type_name -> google.type.Money
+	3,  // 1: payment_service.v1.CreateInvoiceResponse.pricing:type_name -> payment_service.v1.Pricing
+	35, // 2: payment_service.v1.PriceLine.unit_price:type_name -> google.type.Money
+	2,  // 3: payment_service.v1.Pricing.lines:type_name -> payment_service.v1.PriceLine
+	35, // 4: payment_service.v1.Pricing.subtotal:type_name -> google.type.Money
+	35, // 5: payment_service.v1.Pricing.discount:type_name -> google.type.Money
+	35, // 6: payment_service.v1.Pricing.total:type_name -> google.type.Money
+	35, // 7: payment_service.v1.Pricing.voucher_discount:type_name -> google.type.Money
+	35, // 8: payment_service.v1.Invoice.amount:type_name -> google.type.Money
+	36, // 9: payment_service.v1.Invoice.register_time:type_name -> google.protobuf.Timestamp
+	36, // 10: payment_service.v1.Invoice.due_date:type_name -> google.protobuf.Timestamp
+	8,  // 11: payment_service.v1.Invoice.payments:type_name -> payment_service.v1.Payment
+	3,  // 12: payment_service.v1.Invoice.pricing:type_name -> payment_service.v1.Pricing
+	35, // 13: payment_service.v1.Payment.amount:type_name -> google.type.Money
+	36, // 14: payment_service.v1.Payment.payment_time:type_name -> google.protobuf.Timestamp
+	36, // 15: payment_service.v1.Payment.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 16: payment_service.v1.ListInvoicesResponse.invoices:type_name -> payment_service.v1.Invoice
+	8,  // 17: payment_service.v1.ListPaymentsResponse.payments:type_name -> payment_service.v1.Payment
+	35, // 18: payment_service.v1.TopUpRequest.amount:type_name -> google.type.Money
+	35, // 19: payment_service.v1.CreditWinningsRequest.amount:type_name -> google.type.Money
+	35, // 20: payment_service.v1.WalletTransaction.amount:type_name -> google.type.Money
+	36, // 21: payment_service.v1.WalletTransaction.created_at:type_name -> google.protobuf.Timestamp
+	15, // 22: payment_service.v1.WalletOperationResponse.transaction:type_name -> payment_service.v1.WalletTransaction
+	35, // 23: payment_service.v1.WalletOperationResponse.balance:type_name -> google.type.Money
+	35, // 24: payment_service.v1.Balance.balance:type_name -> google.type.Money
+	15, // 25: payment_service.v1.ListTransactionsResponse.transactions:type_name -> payment_service.v1.WalletTransaction
+	35, // 26: payment_service.v1.RefundInvoiceRequest.amount:type_name -> google.type.Money
+	35, // 27: payment_service.v1.Refund.amount:type_name -> google.type.Money
+	36, // 28: payment_service.v1.Refund.created_at:type_name -> google.protobuf.Timestamp
+	27, // 29: payment_service.v1.ListPayoutsResponse.payouts:type_name -> payment_service.v1.Payout
+	36, // 30: payment_service.v1.PayoutAuditRecord.created_at:type_name -> google.protobuf.Timestamp
+	35, // 31: payment_service.v1.Payout.amount:type_name -> google.type.Money
+	36, // 32: payment_service.v1.Payout.created_at:type_name -> google.protobuf.Timestamp
+	26, // 33: payment_service.v1.Payout.audit:type_name -> payment_service.v1.PayoutAuditRecord
+	35, // 34: payment_service.v1.SetPriceRequest.amount:type_name -> google.type.Money
+	36, // 35: payment_service.v1.SetPriceRequest.valid_from:type_name -> google.protobuf.Timestamp
+	36, // 36: payment_service.v1.SetPriceRequest.valid_to:type_name -> google.protobuf.Timestamp
+	35, // 37: payment_service.v1.Price.amount:type_name -> google.type.Money
+	36, // 38: payment_service.v1.Price.valid_from:type_name -> google.protobuf.Timestamp
+	36, // 39: payment_service.v1.Price.valid_to:type_name -> google.protobuf.Timestamp
+	36, // 40: payment_service.v1.CreatePromotionRequest.starts_at:type_name -> google.protobuf.Timestamp
+	36, // 41: payment_service.v1.CreatePromotionRequest.ends_at:type_name -> google.protobuf.Timestamp
+	36, // 42: payment_service.v1.Promotion.starts_at:type_name -> google.protobuf.Timestamp
+	36, // 43: payment_service.v1.Promotion.ends_at:type_name -> google.protobuf.Timestamp
+	35, // 44: payment_service.v1.CreateVoucherRequest.amount:type_name -> google.type.Money
+	36, // 45: payment_service.v1.CreateVoucherRequest.expires_at:type_name -> google.protobuf.Timestamp
+	35, // 46: payment_service.v1.Voucher.amount:type_name -> google.type.Money
+	36, // 47: payment_service.v1.Voucher.expires_at:type_name -> google.protobuf.Timestamp
+	36, // 48: payment_service.v1.Voucher.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 49: payment_service.v1.PaymentService.CreateInvoice:input_type -> payment_service.v1.CreateInvoiceRequest
+	0,  // 50: payment_service.v1.PaymentService.CreateInvoiceInternal:input_type -> payment_service.v1.CreateInvoiceRequest
+	4,  // 51: payment_service.v1.PaymentService.CancelInvoiceInternal:input_type -> payment_service.v1.CancelInvoiceRequest
+	5,  // 52: payment_service.v1.PaymentService.Pay:input_type -> payment_service.v1.PayRequest
+	6,  // 53: payment_service.v1.PaymentService.GetInvoice:input_type -> payment_service.v1.GetInvoiceRequest
+	9,  // 54: payment_service.v1.PaymentService.ListInvoices:input_type -> payment_service.v1.ListInvoicesRequest
+	11, // 55: payment_service.v1.PaymentService.ListPayments:input_type -> payment_service.v1.ListPaymentsRequest
+	13, // 56: payment_service.v1.PaymentService.TopUp:input_type -> payment_service.v1.TopUpRequest
+	17, // 57: payment_service.v1.PaymentService.GetBalance:input_type -> payment_service.v1.GetBalanceRequest
+	19, // 58: payment_service.v1.PaymentService.ListTransactions:input_type -> payment_service.v1.ListTransactionsRequest
+	14, // 59: payment_service.v1.PaymentService.CreditWinningsInternal:input_type -> payment_service.v1.CreditWinningsRequest
+	21, // 60: payment_service.v1.PaymentService.RefundInvoice:input_type -> payment_service.v1.RefundInvoiceRequest
+	23, // 61: payment_service.v1.PaymentService.ListPayouts:input_type -> payment_service.v1.ListPayoutsRequest
+	25, // 62: payment_service.v1.PaymentService.ApprovePayout:input_type -> payment_service.v1.ApprovePayoutRequest
+	28, // 63: payment_service.v1.PaymentService.SetPrice:input_type -> payment_service.v1.SetPriceRequest
+	30, // 64: payment_service.v1.PaymentService.CreatePromotion:input_type -> payment_service.v1.CreatePromotionRequest
+	32, // 65: payment_service.v1.PaymentService.CreateVoucher:input_type -> payment_service.v1.CreateVoucherRequest
+	33, // 66: payment_service.v1.PaymentService.GetVoucher:input_type -> payment_service.v1.GetVoucherRequest
+	1,  // 67: payment_service.v1.PaymentService.CreateInvoice:output_type -> payment_service.v1.CreateInvoiceResponse
+	1,  // 68: payment_service.v1.PaymentService.CreateInvoiceInternal:output_type -> payment_service.v1.CreateInvoiceResponse
+	37, // 69: payment_service.v1.PaymentService.CancelInvoiceInternal:output_type -> google.protobuf.Empty
+	37, // 70: payment_service.v1.PaymentService.Pay:output_type -> google.protobuf.Empty
+	7,  // 71: payment_service.v1.PaymentService.GetInvoice:output_type -> payment_service.v1.Invoice
+	10, // 72: payment_service.v1.PaymentService.ListInvoices:output_type -> payment_service.v1.ListInvoicesResponse
+	12, // 73: payment_service.v1.PaymentService.ListPayments:output_type -> payment_service.v1.ListPaymentsResponse
+	16, // 74: payment_service.v1.PaymentService.TopUp:output_type -> payment_service.v1.WalletOperationResponse
+	18, // 75: payment_service.v1.PaymentService.GetBalance:output_type -> payment_service.v1.Balance
+	20, // 76: payment_service.v1.PaymentService.ListTransactions:output_type -> payment_service.v1.ListTransactionsResponse
+	16, // 77: payment_service.v1.PaymentService.CreditWinningsInternal:output_type -> payment_service.v1.WalletOperationResponse
+	22, // 78: payment_service.v1.PaymentService.RefundInvoice:output_type -> payment_service.v1.Refund
+	24, // 79: payment_service.v1.PaymentService.ListPayouts:output_type -> payment_service.v1.ListPayoutsResponse
+	27, // 80: payment_service.v1.PaymentService.ApprovePayout:output_type -> payment_service.v1.Payout
+	29, // 81: payment_service.v1.PaymentService.SetPrice:output_type -> payment_service.v1.Price
+	31, // 82: payment_service.v1.PaymentService.CreatePromotion:output_type -> payment_service.v1.Promotion
+	34, // 83: payment_service.v1.PaymentService.CreateVoucher:output_type -> payment_service.v1.Voucher
+	34, // 84: payment_service.v1.PaymentService.GetVoucher:output_type -> payment_service.v1.Voucher
+	67, // [67:85] is the sub-list for method output_type
+	49, // [49:67] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
+}
+
+func init() { file_payment_service_v1_payment_service_proto_init() }
+func file_payment_service_v1_payment_service_proto_init() {
+	if File_payment_service_v1_payment_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_service_v1_payment_service_proto_rawDesc), len(file_payment_service_v1_payment_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   35,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_payment_service_v1_payment_service_proto_goTypes,
+		DependencyIndexes: file_payment_service_v1_payment_service_proto_depIdxs,
+		MessageInfos:      file_payment_service_v1_payment_service_proto_msgTypes,
+	}.Build()
+	File_payment_service_v1_payment_service_proto = out.File
+	file_payment_service_v1_payment_service_proto_goTypes = nil
+	file_payment_service_v1_payment_service_proto_depIdxs = nil
+}
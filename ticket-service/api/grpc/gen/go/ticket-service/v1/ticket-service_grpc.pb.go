@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,13 +20,44 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TicketService_GetTicket_FullMethodName            = "/ticket_service.v1.TicketService/GetTicket"
-	TicketService_CreateTicket_FullMethodName         = "/ticket_service.v1.TicketService/CreateTicket"
-	TicketService_ReserveTicket_FullMethodName        = "/ticket_service.v1.TicketService/ReserveTicket"
-	TicketService_ListUserTickets_FullMethodName      = "/ticket_service.v1.TicketService/ListUserTickets"
-	TicketService_ListAvailableTickets_FullMethodName = "/ticket_service.v1.TicketService/ListAvailableTickets"
-	TicketService_SetWinningTickets_FullMethodName    = "/ticket_service.v1.TicketService/SetWinningTickets"
-	TicketService_CheckResult_FullMethodName          = "/ticket_service.v1.TicketService/CheckResult"
+	TicketService_GetTicket_FullMethodName              = "/ticket_service.v1.TicketService/GetTicket"
+	TicketService_VerifyTicket_FullMethodName           = "/ticket_service.v1.TicketService/VerifyTicket"
+	TicketService_CreateTicket_FullMethodName           = "/ticket_service.v1.TicketService/CreateTicket"
+	TicketService_ReserveTicket_FullMethodName          = "/ticket_service.v1.TicketService/ReserveTicket"
+	TicketService_BookTicket_FullMethodName             = "/ticket_service.v1.TicketService/BookTicket"
+	TicketService_BookAnyTicket_FullMethodName          = "/ticket_service.v1.TicketService/BookAnyTicket"
+	TicketService_BookTicketInternal_FullMethodName     = "/ticket_service.v1.TicketService/BookTicketInternal"
+	TicketService_ReleaseTicketsInternal_FullMethodName = "/ticket_service.v1.TicketService/ReleaseTicketsInternal"
+	TicketService_ListUserTickets_FullMethodName        = "/ticket_service.v1.TicketService/ListUserTickets"
+	TicketService_ListAvailableTickets_FullMethodName   = "/ticket_service.v1.TicketService/ListAvailableTickets"
+	TicketService_SetWinningTickets_FullMethodName      = "/ticket_service.v1.TicketService/SetWinningTickets"
+	TicketService_CheckResult_FullMethodName            = "/ticket_service.v1.TicketService/CheckResult"
+	TicketService_CreateSubscription_FullMethodName     = "/ticket_service.v1.TicketService/CreateSubscription"
+	TicketService_ListUserSubscriptions_FullMethodName  = "/ticket_service.v1.TicketService/ListUserSubscriptions"
+	TicketService_CancelSubscription_FullMethodName     = "/ticket_service.v1.TicketService/CancelSubscription"
+	TicketService_ResumeSubscription_FullMethodName     = "/ticket_service.v1.TicketService/ResumeSubscription"
+	TicketService_GetCart_FullMethodName                = "/ticket_service.v1.TicketService/GetCart"
+	TicketService_AddToCart_FullMethodName              = "/ticket_service.v1.TicketService/AddToCart"
+	TicketService_RemoveFromCart_FullMethodName         = "/ticket_service.v1.TicketService/RemoveFromCart"
+	TicketService_CheckoutCart_FullMethodName           = "/ticket_service.v1.TicketService/CheckoutCart"
+	TicketService_TransferTicket_FullMethodName         = "/ticket_service.v1.TicketService/TransferTicket"
+	TicketService_AcceptTransfer_FullMethodName         = "/ticket_service.v1.TicketService/AcceptTransfer"
+	TicketService_DeclineTransfer_FullMethodName        = "/ticket_service.v1.TicketService/DeclineTransfer"
+	TicketService_CancelTransfer_FullMethodName         = "/ticket_service.v1.TicketService/CancelTransfer"
+	TicketService_ListTransfers_FullMethodName          = "/ticket_service.v1.TicketService/ListTransfers"
+	TicketService_GetDrawSummary_FullMethodName         = "/ticket_service.v1.TicketService/GetDrawSummary"
+	TicketService_GetNumberStats_FullMethodName         = "/ticket_service.v1.TicketService/GetNumberStats"
+	TicketService_ListDrawWinners_FullMethodName        = "/ticket_service.v1.TicketService/ListDrawWinners"
+	TicketService_SaveFavourite_FullMethodName          = "/ticket_service.v1.TicketService/SaveFavourite"
+	TicketService_ListFavourites_FullMethodName         = "/ticket_service.v1.TicketService/ListFavourites"
+	TicketService_DeleteFavourite_FullMethodName        = "/ticket_service.v1.TicketService/DeleteFavourite"
+	TicketService_CreateSyndicate_FullMethodName        = "/ticket_service.v1.TicketService/CreateSyndicate"
+	TicketService_GetSyndicate_FullMethodName           = "/ticket_service.v1.TicketService/GetSyndicate"
+	TicketService_ListSyndicates_FullMethodName         = "/ticket_service.v1.TicketService/ListSyndicates"
+	TicketService_InviteToSyndicate_FullMethodName      = "/ticket_service.v1.TicketService/InviteToSyndicate"
+	TicketService_AcceptSyndicateInvite_FullMethodName  = "/ticket_service.v1.TicketService/AcceptSyndicateInvite"
+	TicketService_DeclineSyndicateInvite_FullMethodName = "/ticket_service.v1.TicketService/DeclineSyndicateInvite"
+	TicketService_AddSyndicateTicket_FullMethodName     = "/ticket_service.v1.TicketService/AddSyndicateTicket"
 )
 
 // TicketServiceClient is the client API for TicketService service.
@@ -33,12 +65,54 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TicketServiceClient interface {
 	GetTicket(ctx context.Context, in *GetTicketRequest, opts ...grpc.CallOption) (*Ticket, error)
+	// Публичная проверка билета по серийному номеру: владелец билета не раскрывается.
+	VerifyTicket(ctx context.Context, in *VerifyTicketRequest, opts ...grpc.CallOption) (*TicketVerification, error)
 	CreateTicket(ctx context.Context, in *CreateTicketRequest, opts ...grpc.CallOption) (*Ticket, error)
 	ReserveTicket(ctx context.Context, in *ReserveTicketRequest, opts ...grpc.CallOption) (*Ticket, error)
+	BookTicket(ctx context.Context, in *BookTicketRequest, opts ...grpc.CallOption) (*Ticket, error)
+	// Бронирует любой свободный билет тиража; билеты с предпочтительными числами выбираются первыми.
+	BookAnyTicket(ctx context.Context, in *BookAnyTicketRequest, opts ...grpc.CallOption) (*Ticket, error)
+	// Нужны для вызова из paymentService, наружу не торчат.
+	BookTicketInternal(ctx context.Context, in *BookTicketRequest, opts ...grpc.CallOption) (*Ticket, error)
+	ReleaseTicketsInternal(ctx context.Context, in *ReleaseTicketsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListUserTickets(ctx context.Context, in *ListUserTicketsRequest, opts ...grpc.CallOption) (*ListUserTicketsResponse, error)
 	ListAvailableTickets(ctx context.Context, in *ListAvailableTicketsRequest, opts ...grpc.CallOption) (*ListAvailableTicketsResponse, error)
 	SetWinningTickets(ctx context.Context, in *SetWinningTicketsRequest, opts ...grpc.CallOption) (*SetWinningTicketsResponse, error)
 	CheckResult(ctx context.Context, in *CheckResultRequest, opts ...grpc.CallOption) (*CheckResultResponse, error)
+	CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error)
+	ListUserSubscriptions(ctx context.Context, in *ListUserSubscriptionsRequest, opts ...grpc.CallOption) (*ListUserSubscriptionsResponse, error)
+	CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error)
+	ResumeSubscription(ctx context.Context, in *ResumeSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error)
+	GetCart(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*Cart, error)
+	AddToCart(ctx context.Context, in *AddToCartRequest, opts ...grpc.CallOption) (*Cart, error)
+	RemoveFromCart(ctx context.Context, in *RemoveFromCartRequest, opts ...grpc.CallOption) (*Cart, error)
+	CheckoutCart(ctx context.Context, in *CheckoutCartRequest, opts ...grpc.CallOption) (*Cart, error)
+	// Отправитель и получатель передачи берутся из токена вызывающего пользователя.
+	TransferTicket(ctx context.Context, in *TransferTicketRequest, opts ...grpc.CallOption) (*Transfer, error)
+	AcceptTransfer(ctx context.Context, in *TransferActionRequest, opts ...grpc.CallOption) (*Transfer, error)
+	DeclineTransfer(ctx context.Context, in *TransferActionRequest, opts ...grpc.CallOption) (*Transfer, error)
+	CancelTransfer(ctx context.Context, in *TransferActionRequest, opts ...grpc.CallOption) (*Transfer, error)
+	ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error)
+	// Публичные итоги тиража: без билетов и владельцев.
+	GetDrawSummary(ctx context.Context, in *GetDrawSummaryRequest, opts ...grpc.CallOption) (*DrawSummary, error)
+	// Горячие и холодные числа: как часто число выпадало и выбиралось игроками за период.
+	GetNumberStats(ctx context.Context, in *GetNumberStatsRequest, opts ...grpc.CallOption) (*GetNumberStatsResponse, error)
+	// Полный список выигрышных билетов, только для ADMIN и внутренних сервисов.
+	ListDrawWinners(ctx context.Context, in *ListDrawWinnersRequest, opts ...grpc.CallOption) (*ListDrawWinnersResponse, error)
+	// Сохранённые наборы чисел принадлежат вызывающему пользователю из токена.
+	SaveFavourite(ctx context.Context, in *SaveFavouriteRequest, opts ...grpc.CallOption) (*Favourite, error)
+	ListFavourites(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListFavouritesResponse, error)
+	DeleteFavourite(ctx context.Context, in *DeleteFavouriteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Синдикаты: совместная покупка билетов с делением выигрыша по долям.
+	// Участник определяется по токену вызывающего пользователя.
+	CreateSyndicate(ctx context.Context, in *CreateSyndicateRequest, opts ...grpc.CallOption) (*Syndicate, error)
+	GetSyndicate(ctx context.Context, in *SyndicateRequest, opts ...grpc.CallOption) (*Syndicate, error)
+	ListSyndicates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSyndicatesResponse, error)
+	// Приглашать участников и добавлять билеты может только владелец синдиката.
+	InviteToSyndicate(ctx context.Context, in *InviteToSyndicateRequest, opts ...grpc.CallOption) (*Syndicate, error)
+	AcceptSyndicateInvite(ctx context.Context, in *SyndicateRequest, opts ...grpc.CallOption) (*Syndicate, error)
+	DeclineSyndicateInvite(ctx context.Context, in *SyndicateRequest, opts ...grpc.CallOption) (*Syndicate, error)
+	AddSyndicateTicket(ctx context.Context, in *AddSyndicateTicketRequest, opts ...grpc.CallOption) (*Syndicate, error)
 }
 
 type ticketServiceClient struct {
@@ -59,6 +133,16 @@ func (c *ticketServiceClient) GetTicket(ctx context.Context, in *GetTicketReques
 	return out, nil
 }
 
+func (c *ticketServiceClient) VerifyTicket(ctx context.Context, in *VerifyTicketRequest, opts ...grpc.CallOption) (*TicketVerification, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TicketVerification)
+	err := c.cc.Invoke(ctx, TicketService_VerifyTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ticketServiceClient) CreateTicket(ctx context.Context, in *CreateTicketRequest, opts ...grpc.CallOption) (*Ticket, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Ticket)
@@ -79,6 +163,46 @@ func (c *ticketServiceClient) ReserveTicket(ctx context.Context, in *ReserveTick
 	return out, nil
 }
 
+func (c *ticketServiceClient) BookTicket(ctx context.Context, in *BookTicketRequest, opts ...grpc.CallOption) (*Ticket, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ticket)
+	err := c.cc.Invoke(ctx, TicketService_BookTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) BookAnyTicket(ctx context.Context, in *BookAnyTicketRequest, opts ...grpc.CallOption) (*Ticket, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ticket)
+	err := c.cc.Invoke(ctx, TicketService_BookAnyTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) BookTicketInternal(ctx context.Context, in *BookTicketRequest, opts ...grpc.CallOption) (*Ticket, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ticket)
+	err := c.cc.Invoke(ctx, TicketService_BookTicketInternal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ReleaseTicketsInternal(ctx context.Context, in *ReleaseTicketsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TicketService_ReleaseTicketsInternal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ticketServiceClient) ListUserTickets(ctx context.Context, in *ListUserTicketsRequest, opts ...grpc.CallOption) (*ListUserTicketsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListUserTicketsResponse)
@@ -119,17 +243,319 @@ func (c *ticketServiceClient) CheckResult(ctx context.Context, in *CheckResultRe
 	return out, nil
 }
 
+func (c *ticketServiceClient) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscription)
+	err := c.cc.Invoke(ctx, TicketService_CreateSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListUserSubscriptions(ctx context.Context, in *ListUserSubscriptionsRequest, opts ...grpc.CallOption) (*ListUserSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, TicketService_ListUserSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscription)
+	err := c.cc.Invoke(ctx, TicketService_CancelSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ResumeSubscription(ctx context.Context, in *ResumeSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscription)
+	err := c.cc.Invoke(ctx, TicketService_ResumeSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetCart(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*Cart, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cart)
+	err := c.cc.Invoke(ctx, TicketService_GetCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) AddToCart(ctx context.Context, in *AddToCartRequest, opts ...grpc.CallOption) (*Cart, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cart)
+	err := c.cc.Invoke(ctx, TicketService_AddToCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) RemoveFromCart(ctx context.Context, in *RemoveFromCartRequest, opts ...grpc.CallOption) (*Cart, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cart)
+	err := c.cc.Invoke(ctx, TicketService_RemoveFromCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) CheckoutCart(ctx context.Context, in *CheckoutCartRequest, opts ...grpc.CallOption) (*Cart, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cart)
+	err := c.cc.Invoke(ctx, TicketService_CheckoutCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) TransferTicket(ctx context.Context, in *TransferTicketRequest, opts ...grpc.CallOption) (*Transfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, TicketService_TransferTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) AcceptTransfer(ctx context.Context, in *TransferActionRequest, opts ...grpc.CallOption) (*Transfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, TicketService_AcceptTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) DeclineTransfer(ctx context.Context, in *TransferActionRequest, opts ...grpc.CallOption) (*Transfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, TicketService_DeclineTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) CancelTransfer(ctx context.Context, in *TransferActionRequest, opts ...grpc.CallOption) (*Transfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, TicketService_CancelTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransfersResponse)
+	err := c.cc.Invoke(ctx, TicketService_ListTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetDrawSummary(ctx context.Context, in *GetDrawSummaryRequest, opts ...grpc.CallOption) (*DrawSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrawSummary)
+	err := c.cc.Invoke(ctx, TicketService_GetDrawSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetNumberStats(ctx context.Context, in *GetNumberStatsRequest, opts ...grpc.CallOption) (*GetNumberStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNumberStatsResponse)
+	err := c.cc.Invoke(ctx, TicketService_GetNumberStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListDrawWinners(ctx context.Context, in *ListDrawWinnersRequest, opts ...grpc.CallOption) (*ListDrawWinnersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDrawWinnersResponse)
+	err := c.cc.Invoke(ctx, TicketService_ListDrawWinners_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) SaveFavourite(ctx context.Context, in *SaveFavouriteRequest, opts ...grpc.CallOption) (*Favourite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Favourite)
+	err := c.cc.Invoke(ctx, TicketService_SaveFavourite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListFavourites(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListFavouritesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFavouritesResponse)
+	err := c.cc.Invoke(ctx, TicketService_ListFavourites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) DeleteFavourite(ctx context.Context, in *DeleteFavouriteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TicketService_DeleteFavourite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) CreateSyndicate(ctx context.Context, in *CreateSyndicateRequest, opts ...grpc.CallOption) (*Syndicate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Syndicate)
+	err := c.cc.Invoke(ctx, TicketService_CreateSyndicate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetSyndicate(ctx context.Context, in *SyndicateRequest, opts ...grpc.CallOption) (*Syndicate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Syndicate)
+	err := c.cc.Invoke(ctx, TicketService_GetSyndicate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListSyndicates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSyndicatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSyndicatesResponse)
+	err := c.cc.Invoke(ctx, TicketService_ListSyndicates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) InviteToSyndicate(ctx context.Context, in *InviteToSyndicateRequest, opts ...grpc.CallOption) (*Syndicate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Syndicate)
+	err := c.cc.Invoke(ctx, TicketService_InviteToSyndicate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) AcceptSyndicateInvite(ctx context.Context, in *SyndicateRequest, opts ...grpc.CallOption) (*Syndicate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Syndicate)
+	err := c.cc.Invoke(ctx, TicketService_AcceptSyndicateInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) DeclineSyndicateInvite(ctx context.Context, in *SyndicateRequest, opts ...grpc.CallOption) (*Syndicate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Syndicate)
+	err := c.cc.Invoke(ctx, TicketService_DeclineSyndicateInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) AddSyndicateTicket(ctx context.Context, in *AddSyndicateTicketRequest, opts ...grpc.CallOption) (*Syndicate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Syndicate)
+	err := c.cc.Invoke(ctx, TicketService_AddSyndicateTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TicketServiceServer is the server API for TicketService service.
 // All implementations must embed UnimplementedTicketServiceServer
 // for forward compatibility.
 type TicketServiceServer interface {
 	GetTicket(context.Context, *GetTicketRequest) (*Ticket, error)
+	// Публичная проверка билета по серийному номеру: владелец билета не раскрывается.
+	VerifyTicket(context.Context, *VerifyTicketRequest) (*TicketVerification, error)
 	CreateTicket(context.Context, *CreateTicketRequest) (*Ticket, error)
 	ReserveTicket(context.Context, *ReserveTicketRequest) (*Ticket, error)
+	BookTicket(context.Context, *BookTicketRequest) (*Ticket, error)
+	// Бронирует любой свободный билет тиража; билеты с предпочтительными числами выбираются первыми.
+	BookAnyTicket(context.Context, *BookAnyTicketRequest) (*Ticket, error)
+	// Нужны для вызова из paymentService, наружу не торчат.
+	BookTicketInternal(context.Context, *BookTicketRequest) (*Ticket, error)
+	ReleaseTicketsInternal(context.Context, *ReleaseTicketsRequest) (*emptypb.Empty, error)
 	ListUserTickets(context.Context, *ListUserTicketsRequest) (*ListUserTicketsResponse, error)
 	ListAvailableTickets(context.Context, *ListAvailableTicketsRequest) (*ListAvailableTicketsResponse, error)
 	SetWinningTickets(context.Context, *SetWinningTicketsRequest) (*SetWinningTicketsResponse, error)
 	CheckResult(context.Context, *CheckResultRequest) (*CheckResultResponse, error)
+	CreateSubscription(context.Context, *CreateSubscriptionRequest) (*Subscription, error)
+	ListUserSubscriptions(context.Context, *ListUserSubscriptionsRequest) (*ListUserSubscriptionsResponse, error)
+	CancelSubscription(context.Context, *CancelSubscriptionRequest) (*Subscription, error)
+	ResumeSubscription(context.Context, *ResumeSubscriptionRequest) (*Subscription, error)
+	GetCart(context.Context, *GetCartRequest) (*Cart, error)
+	AddToCart(context.Context, *AddToCartRequest) (*Cart, error)
+	RemoveFromCart(context.Context, *RemoveFromCartRequest) (*Cart, error)
+	CheckoutCart(context.Context, *CheckoutCartRequest) (*Cart, error)
+	// Отправитель и получатель передачи берутся из токена вызывающего пользователя.
+	TransferTicket(context.Context, *TransferTicketRequest) (*Transfer, error)
+	AcceptTransfer(context.Context, *TransferActionRequest) (*Transfer, error)
+	DeclineTransfer(context.Context, *TransferActionRequest) (*Transfer, error)
+	CancelTransfer(context.Context, *TransferActionRequest) (*Transfer, error)
+	ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error)
+	// Публичные итоги тиража: без билетов и владельцев.
+	GetDrawSummary(context.Context, *GetDrawSummaryRequest) (*DrawSummary, error)
+	// Горячие и холодные числа: как часто число выпадало и выбиралось игроками за период.
+	GetNumberStats(context.Context, *GetNumberStatsRequest) (*GetNumberStatsResponse, error)
+	// Полный список выигрышных билетов, только для ADMIN и внутренних сервисов.
+	ListDrawWinners(context.Context, *ListDrawWinnersRequest) (*ListDrawWinnersResponse, error)
+	// Сохранённые наборы чисел принадлежат вызывающему пользователю из токена.
+	SaveFavourite(context.Context, *SaveFavouriteRequest) (*Favourite, error)
+	ListFavourites(context.Context, *emptypb.Empty) (*ListFavouritesResponse, error)
+	DeleteFavourite(context.Context, *DeleteFavouriteRequest) (*emptypb.Empty, error)
+	// Синдикаты: совместная покупка билетов с делением выигрыша по долям.
+	// Участник определяется по токену вызывающего пользователя.
+	CreateSyndicate(context.Context, *CreateSyndicateRequest) (*Syndicate, error)
+	GetSyndicate(context.Context, *SyndicateRequest) (*Syndicate, error)
+	ListSyndicates(context.Context, *emptypb.Empty) (*ListSyndicatesResponse, error)
+	// Приглашать участников и добавлять билеты может только владелец синдиката.
+	InviteToSyndicate(context.Context, *InviteToSyndicateRequest) (*Syndicate, error)
+	AcceptSyndicateInvite(context.Context, *SyndicateRequest) (*Syndicate, error)
+	DeclineSyndicateInvite(context.Context, *SyndicateRequest) (*Syndicate, error)
+	AddSyndicateTicket(context.Context, *AddSyndicateTicketRequest) (*Syndicate, error)
 	mustEmbedUnimplementedTicketServiceServer()
 }
 
@@ -143,12 +569,27 @@ type UnimplementedTicketServiceServer struct{}
 func (UnimplementedTicketServiceServer) GetTicket(context.Context, *GetTicketRequest) (*Ticket, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTicket not implemented")
 }
+func (UnimplementedTicketServiceServer) VerifyTicket(context.Context, *VerifyTicketRequest) (*TicketVerification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTicket not implemented")
+}
 func (UnimplementedTicketServiceServer) CreateTicket(context.Context, *CreateTicketRequest) (*Ticket, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTicket not implemented")
 }
 func (UnimplementedTicketServiceServer) ReserveTicket(context.Context, *ReserveTicketRequest) (*Ticket, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReserveTicket not implemented")
 }
+func (UnimplementedTicketServiceServer) BookTicket(context.Context, *BookTicketRequest) (*Ticket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookTicket not implemented")
+}
+func (UnimplementedTicketServiceServer) BookAnyTicket(context.Context, *BookAnyTicketRequest) (*Ticket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookAnyTicket not implemented")
+}
+func (UnimplementedTicketServiceServer) BookTicketInternal(context.Context, *BookTicketRequest) (*Ticket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookTicketInternal not implemented")
+}
+func (UnimplementedTicketServiceServer) ReleaseTicketsInternal(context.Context, *ReleaseTicketsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseTicketsInternal not implemented")
+}
 func (UnimplementedTicketServiceServer) ListUserTickets(context.Context, *ListUserTicketsRequest) (*ListUserTicketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUserTickets not implemented")
 }
@@ -161,6 +602,84 @@ func (UnimplementedTicketServiceServer) SetWinningTickets(context.Context, *SetW
 func (UnimplementedTicketServiceServer) CheckResult(context.Context, *CheckResultRequest) (*CheckResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckResult not implemented")
 }
+func (UnimplementedTicketServiceServer) CreateSubscription(context.Context, *CreateSubscriptionRequest) (*Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
+}
+func (UnimplementedTicketServiceServer) ListUserSubscriptions(context.Context, *ListUserSubscriptionsRequest) (*ListUserSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserSubscriptions not implemented")
+}
+func (UnimplementedTicketServiceServer) CancelSubscription(context.Context, *CancelSubscriptionRequest) (*Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSubscription not implemented")
+}
+func (UnimplementedTicketServiceServer) ResumeSubscription(context.Context, *ResumeSubscriptionRequest) (*Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSubscription not implemented")
+}
+func (UnimplementedTicketServiceServer) GetCart(context.Context, *GetCartRequest) (*Cart, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCart not implemented")
+}
+func (UnimplementedTicketServiceServer) AddToCart(context.Context, *AddToCartRequest) (*Cart, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToCart not implemented")
+}
+func (UnimplementedTicketServiceServer) RemoveFromCart(context.Context, *RemoveFromCartRequest) (*Cart, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromCart not implemented")
+}
+func (UnimplementedTicketServiceServer) CheckoutCart(context.Context, *CheckoutCartRequest) (*Cart, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckoutCart not implemented")
+}
+func (UnimplementedTicketServiceServer) TransferTicket(context.Context, *TransferTicketRequest) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTicket not implemented")
+}
+func (UnimplementedTicketServiceServer) AcceptTransfer(context.Context, *TransferActionRequest) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTransfer not implemented")
+}
+func (UnimplementedTicketServiceServer) DeclineTransfer(context.Context, *TransferActionRequest) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineTransfer not implemented")
+}
+func (UnimplementedTicketServiceServer) CancelTransfer(context.Context, *TransferActionRequest) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTransfer not implemented")
+}
+func (UnimplementedTicketServiceServer) ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransfers not implemented")
+}
+func (UnimplementedTicketServiceServer) GetDrawSummary(context.Context, *GetDrawSummaryRequest) (*DrawSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDrawSummary not implemented")
+}
+func (UnimplementedTicketServiceServer) GetNumberStats(context.Context, *GetNumberStatsRequest) (*GetNumberStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNumberStats not implemented")
+}
+func (UnimplementedTicketServiceServer) ListDrawWinners(context.Context, *ListDrawWinnersRequest) (*ListDrawWinnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrawWinners not implemented")
+}
+func (UnimplementedTicketServiceServer) SaveFavourite(context.Context, *SaveFavouriteRequest) (*Favourite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveFavourite not implemented")
+}
+func (UnimplementedTicketServiceServer) ListFavourites(context.Context, *emptypb.Empty) (*ListFavouritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavourites not implemented")
+}
+func (UnimplementedTicketServiceServer) DeleteFavourite(context.Context, *DeleteFavouriteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFavourite not implemented")
+}
+func (UnimplementedTicketServiceServer) CreateSyndicate(context.Context, *CreateSyndicateRequest) (*Syndicate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSyndicate not implemented")
+}
+func (UnimplementedTicketServiceServer) GetSyndicate(context.Context, *SyndicateRequest) (*Syndicate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyndicate not implemented")
+}
+func (UnimplementedTicketServiceServer) ListSyndicates(context.Context, *emptypb.Empty) (*ListSyndicatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSyndicates not implemented")
+}
+func (UnimplementedTicketServiceServer) InviteToSyndicate(context.Context, *InviteToSyndicateRequest) (*Syndicate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteToSyndicate not implemented")
+}
+func (UnimplementedTicketServiceServer) AcceptSyndicateInvite(context.Context, *SyndicateRequest) (*Syndicate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptSyndicateInvite not implemented")
+}
+func (UnimplementedTicketServiceServer) DeclineSyndicateInvite(context.Context, *SyndicateRequest) (*Syndicate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineSyndicateInvite not implemented")
+}
+func (UnimplementedTicketServiceServer) AddSyndicateTicket(context.Context, *AddSyndicateTicketRequest) (*Syndicate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSyndicateTicket not implemented")
+}
 func (UnimplementedTicketServiceServer) mustEmbedUnimplementedTicketServiceServer() {}
 func (UnimplementedTicketServiceServer) testEmbeddedByValue()                       {}
 
@@ -200,20 +719,38 @@ func _TicketService_GetTicket_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TicketService_CreateTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTicketRequest)
+func _TicketService_VerifyTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTicketRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TicketServiceServer).CreateTicket(ctx, in)
+		return srv.(TicketServiceServer).VerifyTicket(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TicketService_CreateTicket_FullMethodName,
+		FullMethod: TicketService_VerifyTicket_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TicketServiceServer).CreateTicket(ctx, req.(*CreateTicketRequest))
+		return srv.(TicketServiceServer).VerifyTicket(ctx, req.(*VerifyTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_CreateTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CreateTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_CreateTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CreateTicket(ctx, req.(*CreateTicketRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -236,6 +773,78 @@ func _TicketService_ReserveTicket_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TicketService_BookTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).BookTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_BookTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).BookTicket(ctx, req.(*BookTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_BookAnyTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookAnyTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).BookAnyTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_BookAnyTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).BookAnyTicket(ctx, req.(*BookAnyTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_BookTicketInternal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).BookTicketInternal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_BookTicketInternal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).BookTicketInternal(ctx, req.(*BookTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ReleaseTicketsInternal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ReleaseTicketsInternal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ReleaseTicketsInternal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ReleaseTicketsInternal(ctx, req.(*ReleaseTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TicketService_ListUserTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListUserTicketsRequest)
 	if err := dec(in); err != nil {
@@ -308,6 +917,474 @@ func _TicketService_CheckResult_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TicketService_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CreateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_CreateSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CreateSubscription(ctx, req.(*CreateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListUserSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListUserSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListUserSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListUserSubscriptions(ctx, req.(*ListUserSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CancelSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_CancelSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CancelSubscription(ctx, req.(*CancelSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ResumeSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ResumeSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ResumeSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ResumeSubscription(ctx, req.(*ResumeSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetCart(ctx, req.(*GetCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_AddToCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).AddToCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_AddToCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).AddToCart(ctx, req.(*AddToCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_RemoveFromCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).RemoveFromCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_RemoveFromCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).RemoveFromCart(ctx, req.(*RemoveFromCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_CheckoutCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckoutCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CheckoutCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_CheckoutCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CheckoutCart(ctx, req.(*CheckoutCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_TransferTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).TransferTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_TransferTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).TransferTicket(ctx, req.(*TransferTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_AcceptTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).AcceptTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_AcceptTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).AcceptTransfer(ctx, req.(*TransferActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_DeclineTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).DeclineTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_DeclineTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).DeclineTransfer(ctx, req.(*TransferActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_CancelTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CancelTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_CancelTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CancelTransfer(ctx, req.(*TransferActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListTransfers(ctx, req.(*ListTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetDrawSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDrawSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetDrawSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetDrawSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetDrawSummary(ctx, req.(*GetDrawSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetNumberStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNumberStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetNumberStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetNumberStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetNumberStats(ctx, req.(*GetNumberStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListDrawWinners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDrawWinnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListDrawWinners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListDrawWinners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListDrawWinners(ctx, req.(*ListDrawWinnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_SaveFavourite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveFavouriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).SaveFavourite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_SaveFavourite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).SaveFavourite(ctx, req.(*SaveFavouriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListFavourites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListFavourites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListFavourites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListFavourites(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_DeleteFavourite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFavouriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).DeleteFavourite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_DeleteFavourite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).DeleteFavourite(ctx, req.(*DeleteFavouriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_CreateSyndicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSyndicateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CreateSyndicate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_CreateSyndicate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CreateSyndicate(ctx, req.(*CreateSyndicateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetSyndicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyndicateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetSyndicate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetSyndicate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetSyndicate(ctx, req.(*SyndicateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListSyndicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListSyndicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListSyndicates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListSyndicates(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_InviteToSyndicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteToSyndicateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).InviteToSyndicate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_InviteToSyndicate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).InviteToSyndicate(ctx, req.(*InviteToSyndicateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_AcceptSyndicateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyndicateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).AcceptSyndicateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_AcceptSyndicateInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).AcceptSyndicateInvite(ctx, req.(*SyndicateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_DeclineSyndicateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyndicateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).DeclineSyndicateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_DeclineSyndicateInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).DeclineSyndicateInvite(ctx, req.(*SyndicateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_AddSyndicateTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSyndicateTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).AddSyndicateTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_AddSyndicateTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).AddSyndicateTicket(ctx, req.(*AddSyndicateTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TicketService_ServiceDesc is the grpc.ServiceDesc for TicketService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -319,6 +1396,10 @@ var TicketService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTicket",
 			Handler:    _TicketService_GetTicket_Handler,
 		},
+		{
+			MethodName: "VerifyTicket",
+			Handler:    _TicketService_VerifyTicket_Handler,
+		},
 		{
 			MethodName: "CreateTicket",
 			Handler:    _TicketService_CreateTicket_Handler,
@@ -327,6 +1408,22 @@ var TicketService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReserveTicket",
 			Handler:    _TicketService_ReserveTicket_Handler,
 		},
+		{
+			MethodName: "BookTicket",
+			Handler:    _TicketService_BookTicket_Handler,
+		},
+		{
+			MethodName: "BookAnyTicket",
+			Handler:    _TicketService_BookAnyTicket_Handler,
+		},
+		{
+			MethodName: "BookTicketInternal",
+			Handler:    _TicketService_BookTicketInternal_Handler,
+		},
+		{
+			MethodName: "ReleaseTicketsInternal",
+			Handler:    _TicketService_ReleaseTicketsInternal_Handler,
+		},
 		{
 			MethodName: "ListUserTickets",
 			Handler:    _TicketService_ListUserTickets_Handler,
@@ -343,6 +1440,110 @@ var TicketService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckResult",
 			Handler:    _TicketService_CheckResult_Handler,
 		},
+		{
+			MethodName: "CreateSubscription",
+			Handler:    _TicketService_CreateSubscription_Handler,
+		},
+		{
+			MethodName: "ListUserSubscriptions",
+			Handler:    _TicketService_ListUserSubscriptions_Handler,
+		},
+		{
+			MethodName: "CancelSubscription",
+			Handler:    _TicketService_CancelSubscription_Handler,
+		},
+		{
+			MethodName: "ResumeSubscription",
+			Handler:    _TicketService_ResumeSubscription_Handler,
+		},
+		{
+			MethodName: "GetCart",
+			Handler:    _TicketService_GetCart_Handler,
+		},
+		{
+			MethodName: "AddToCart",
+			Handler:    _TicketService_AddToCart_Handler,
+		},
+		{
+			MethodName: "RemoveFromCart",
+			Handler:    _TicketService_RemoveFromCart_Handler,
+		},
+		{
+			MethodName: "CheckoutCart",
+			Handler:    _TicketService_CheckoutCart_Handler,
+		},
+		{
+			MethodName: "TransferTicket",
+			Handler:    _TicketService_TransferTicket_Handler,
+		},
+		{
+			MethodName: "AcceptTransfer",
+			Handler:    _TicketService_AcceptTransfer_Handler,
+		},
+		{
+			MethodName: "DeclineTransfer",
+			Handler:    _TicketService_DeclineTransfer_Handler,
+		},
+		{
+			MethodName: "CancelTransfer",
+			Handler:    _TicketService_CancelTransfer_Handler,
+		},
+		{
+			MethodName: "ListTransfers",
+			Handler:    _TicketService_ListTransfers_Handler,
+		},
+		{
+			MethodName: "GetDrawSummary",
+			Handler:    _TicketService_GetDrawSummary_Handler,
+		},
+		{
+			MethodName: "GetNumberStats",
+			Handler:    _TicketService_GetNumberStats_Handler,
+		},
+		{
+			MethodName: "ListDrawWinners",
+			Handler:    _TicketService_ListDrawWinners_Handler,
+		},
+		{
+			MethodName: "SaveFavourite",
+			Handler:    _TicketService_SaveFavourite_Handler,
+		},
+		{
+			MethodName: "ListFavourites",
+			Handler:    _TicketService_ListFavourites_Handler,
+		},
+		{
+			MethodName: "DeleteFavourite",
+			Handler:    _TicketService_DeleteFavourite_Handler,
+		},
+		{
+			MethodName: "CreateSyndicate",
+			Handler:    _TicketService_CreateSyndicate_Handler,
+		},
+		{
+			MethodName: "GetSyndicate",
+			Handler:    _TicketService_GetSyndicate_Handler,
+		},
+		{
+			MethodName: "ListSyndicates",
+			Handler:    _TicketService_ListSyndicates_Handler,
+		},
+		{
+			MethodName: "InviteToSyndicate",
+			Handler:    _TicketService_InviteToSyndicate_Handler,
+		},
+		{
+			MethodName: "AcceptSyndicateInvite",
+			Handler:    _TicketService_AcceptSyndicateInvite_Handler,
+		},
+		{
+			MethodName: "DeclineSyndicateInvite",
+			Handler:    _TicketService_DeclineSyndicateInvite_Handler,
+		},
+		{
+			MethodName: "AddSyndicateTicket",
+			Handler:    _TicketService_AddSyndicateTicket_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ticket-service/v1/ticket-service.proto",
@@ -1,17 +1,31 @@
 package config
 
-import "github.com/spf13/viper"
+import (
+	"time"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+	"github.com/spf13/viper"
+)
 
 type Config struct {
-	ServiceName         string
-	Env                 string
-	LogLevel            string
-	GRPCPort            string
-	DatabaseDSN         string
-	TracerDSN           string
-	RedisDSN            string
-	RedisDrawChannel    string
-	RedisInvoiceChannel string
+	ServiceName             string
+	Env                     string
+	LogLevel                string
+	GRPCPort                string
+	DatabaseDSN             string
+	TracerDSN               string
+	RedisDSN                string
+	RedisDrawChannel        string
+	RedisInvoiceChannel     string
+	RedisTicketChannel      string
+	PaymentServiceAddr      string
+	UserServiceAddr         string
+	JWTSecret               string
+	TicketHoldTTL           time.Duration
+	HoldSweepInterval       time.Duration
+	StatsSyncInterval       time.Duration
+	SyndicateSettleInterval time.Duration
+	BookingLimits           entity.BookingLimits
 }
 
 func Load() *Config {
@@ -19,16 +33,39 @@ func Load() *Config {
 	viper.SetConfigType("env")
 	viper.AddConfigPath(".")
 	viper.AutomaticEnv()
+	viper.SetDefault("TICKET_HOLD_TTL", 15*time.Minute)
+	viper.SetDefault("HOLD_SWEEP_INTERVAL", time.Minute)
+	viper.SetDefault("REDIS_TICKET_CHANNEL", "ticket_channel")
+	viper.SetDefault("STATS_SYNC_INTERVAL", 5*time.Minute)
+	viper.SetDefault("SYNDICATE_SETTLE_INTERVAL", 5*time.Minute)
+	viper.SetDefault("BOOKING_MAX_HELD_PER_DRAW", 10)
+	viper.SetDefault("BOOKING_MAX_PURCHASED_PER_DRAW", 100)
+	viper.SetDefault("BOOKING_OVERDUE_STRIKES", 3)
+	viper.SetDefault("BOOKING_OVERDUE_COOLDOWN", time.Hour)
 
 	return &Config{
-		ServiceName:         viper.GetString("SERVICE_NAME"),
-		Env:                 viper.GetString("APP_ENV"),
-		LogLevel:            viper.GetString("LOG_LEVEL"),
-		GRPCPort:            viper.GetString("GRPC_PORT"),
-		DatabaseDSN:         viper.GetString("DATABASE_DSN"),
-		TracerDSN:           viper.GetString("TRACER_DSN"),
-		RedisDSN:            viper.GetString("REDIS_DSN"),
-		RedisDrawChannel:    viper.GetString("REDIS_DRAW_CHANNEL"),
-		RedisInvoiceChannel: viper.GetString("REDIS_INVOICE_CHANNEL"),
+		ServiceName:             viper.GetString("SERVICE_NAME"),
+		Env:                     viper.GetString("APP_ENV"),
+		LogLevel:                viper.GetString("LOG_LEVEL"),
+		GRPCPort:                viper.GetString("GRPC_PORT"),
+		DatabaseDSN:             viper.GetString("DATABASE_DSN"),
+		TracerDSN:               viper.GetString("TRACER_DSN"),
+		RedisDSN:                viper.GetString("REDIS_DSN"),
+		RedisDrawChannel:        viper.GetString("REDIS_DRAW_CHANNEL"),
+		RedisInvoiceChannel:     viper.GetString("REDIS_INVOICE_CHANNEL"),
+		RedisTicketChannel:      viper.GetString("REDIS_TICKET_CHANNEL"),
+		PaymentServiceAddr:      viper.GetString("PAYMENT_SERVICE_ADDR"),
+		UserServiceAddr:         viper.GetString("USER_SERVICE_ADDR"),
+		JWTSecret:               viper.GetString("JWT_SECRET"),
+		TicketHoldTTL:           viper.GetDuration("TICKET_HOLD_TTL"),
+		HoldSweepInterval:       viper.GetDuration("HOLD_SWEEP_INTERVAL"),
+		StatsSyncInterval:       viper.GetDuration("STATS_SYNC_INTERVAL"),
+		SyndicateSettleInterval: viper.GetDuration("SYNDICATE_SETTLE_INTERVAL"),
+		BookingLimits: entity.BookingLimits{
+			MaxHeld:        viper.GetInt("BOOKING_MAX_HELD_PER_DRAW"),
+			MaxPurchased:   viper.GetInt("BOOKING_MAX_PURCHASED_PER_DRAW"),
+			OverdueStrikes: viper.GetInt("BOOKING_OVERDUE_STRIKES"),
+			Cooldown:       viper.GetDuration("BOOKING_OVERDUE_COOLDOWN"),
+		},
 	}
 }
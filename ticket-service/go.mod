@@ -3,13 +3,19 @@ module github.com/MaxFando/lms/ticket-service
 go 1.23.8
 
 require (
+	github.com/MaxFando/lms/platform/auth v0.0.0-00010101000000-000000000000
 	github.com/MaxFando/lms/platform/closer v0.0.0-20250416211236-1e46c0b76245
 	github.com/MaxFando/lms/platform/logger v0.0.0-20250416211236-1e46c0b76245
+	github.com/MaxFando/lms/platform/scheduler v0.0.0-00010101000000-000000000000
 	github.com/MaxFando/lms/platform/sqlext v0.0.0-20250416211236-1e46c0b76245
 	github.com/MaxFando/lms/platform/tracer v0.0.0-20250416211236-1e46c0b76245
+	github.com/golang-jwt/jwt/v5 v5.2.2 // indirect
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.26.1
 	github.com/jmoiron/sqlx v1.4.0
 	github.com/redis/go-redis/v9 v9.8.0
+	github.com/shopspring/decimal v1.4.0
 	github.com/spf13/viper v1.20.1
+	google.golang.org/genproto v0.0.0-20241118233622-e639e219e697
 	google.golang.org/genproto/googleapis/api v0.0.0-20250218202821-56aae31c358a
 	google.golang.org/grpc v1.71.0
 	google.golang.org/protobuf v1.36.5
@@ -25,7 +31,6 @@ require (
 	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/go-viper/mapstructure/v2 v2.2.1 // indirect
 	github.com/google/uuid v1.6.0 // indirect
-	github.com/grpc-ecosystem/grpc-gateway/v2 v2.26.1 // indirect
 	github.com/jackc/pgpassfile v1.0.0 // indirect
 	github.com/jackc/pgservicefile v0.0.0-20240606120523-5a60cdf6a761 // indirect
 	github.com/jackc/pgx/v5 v5.7.4 // indirect
@@ -56,3 +61,8 @@ require (
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250218202821-56aae31c358a // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace (
+	github.com/MaxFando/lms/platform/auth => ../platform/auth
+	github.com/MaxFando/lms/platform/scheduler => ../platform/scheduler
+)
@@ -3,15 +3,22 @@ package app
 import (
 	"context"
 	"fmt"
+	"github.com/MaxFando/lms/platform/auth"
 	"github.com/MaxFando/lms/platform/closer"
 	"github.com/MaxFando/lms/platform/logger"
+	"github.com/MaxFando/lms/platform/scheduler"
 	"github.com/MaxFando/lms/platform/sqlext"
 	"github.com/MaxFando/lms/platform/tracer"
+	"github.com/MaxFando/lms/ticket-service/internal/client/payment"
+	"github.com/MaxFando/lms/ticket-service/internal/client/user"
 	"github.com/MaxFando/lms/ticket-service/internal/repository/postgres"
+	redisrepo "github.com/MaxFando/lms/ticket-service/internal/repository/redis"
 	"github.com/MaxFando/lms/ticket-service/internal/service"
 	"github.com/MaxFando/lms/ticket-service/internal/usecase"
 	"github.com/jmoiron/sqlx"
 	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"syscall"
 
 	"github.com/MaxFando/lms/ticket-service/config"
@@ -20,10 +27,12 @@ import (
 )
 
 type App struct {
-	logger   logger.Logger
-	config   *config.Config
-	database *sqlx.DB
-	srv      *server.Server
+	logger      logger.Logger
+	config      *config.Config
+	database    *sqlx.DB
+	paymentConn *grpc.ClientConn
+	userConn    *grpc.ClientConn
+	srv         *server.Server
 }
 
 func New(cfg *config.Config) *App {
@@ -50,6 +59,14 @@ func (a *App) Init(ctx context.Context) error {
 		return fmt.Errorf("ошибка при инициализации подключения к базе данных: %w", err)
 	}
 
+	if err := a.initPaymentServiceConnection(ctx); err != nil {
+		return fmt.Errorf("ошибка при инициализации подключения к сервису платежей: %w", err)
+	}
+
+	if err := a.initUserServiceConnection(ctx); err != nil {
+		return fmt.Errorf("ошибка при инициализации подключения к сервису пользователей: %w", err)
+	}
+
 	a.logger.Info(ctx, "Инициализация приложения завершена успешно")
 
 	return nil
@@ -63,10 +80,29 @@ func (a *App) Run(ctx context.Context) error {
 	}
 
 	rdb := redis.NewClient(opt)
-	uc := usecase.NewTicketUsecase(repo)
+	payments := payment.New(a.paymentConn)
+	limiter := usecase.NewBookingLimiter(postgres.NewLimitRepository(a.database), a.config.BookingLimits)
+	publisher := redisrepo.NewPublisher(rdb, a.config.RedisTicketChannel)
+	uc := usecase.NewTicketUsecase(repo, payments, publisher, limiter, a.config.TicketHoldTTL)
+	subscriptions := usecase.NewSubscriptionUsecase(
+		postgres.NewSubscriptionRepository(a.database),
+		repo,
+		payments,
+		a.config.TicketHoldTTL,
+	)
+	carts := usecase.NewCartUsecase(postgres.NewCartRepository(a.database), payments, limiter, a.config.TicketHoldTTL)
+
+	users := user.New(a.userConn)
+	transfers := usecase.NewTransferUsecase(postgres.NewTransferRepository(a.database), users, publisher)
 
-	serviceServer := v1.NewServer(uc)
-	srv := server.NewServer(a.logger, serviceServer)
+	stats := usecase.NewStatsUsecase(postgres.NewStatsRepository(a.database))
+
+	favourites := usecase.NewFavouriteUsecase(postgres.NewFavouriteRepository(a.database))
+
+	syndicates := usecase.NewSyndicateUsecase(postgres.NewSyndicateRepository(a.database), users, publisher)
+
+	serviceServer := v1.NewServer(uc, subscriptions, carts, transfers, stats, favourites, syndicates)
+	srv := server.NewServer(a.logger, serviceServer, a.config.JWTSecret)
 
 	go func() {
 		srv.Serve(ctx)
@@ -76,16 +112,28 @@ func (a *App) Run(ctx context.Context) error {
 
 	errChan := make(chan error, 1)
 
-	drawHandler := service.NewDrawEventHandler(rdb, uc, a.config.RedisDrawChannel, 50)
+	drawHandler := service.NewDrawEventHandler(rdb, uc, subscriptions, stats, a.config.RedisDrawChannel, 50)
 	go func() {
 		errChan <- drawHandler.Run(ctx)
 	}()
 
-	invoiceHandler := service.NewInvoiceEventHandler(rdb, uc, a.config.RedisInvoiceChannel)
+	invoiceHandler := service.NewInvoiceEventHandler(rdb, uc, subscriptions, carts, limiter, a.config.RedisInvoiceChannel)
 	go func() {
 		errChan <- invoiceHandler.Run(ctx)
 	}()
 
+	go func() {
+		errChan <- scheduler.Schedule(ctx, uc.ExpireHolds, a.config.HoldSweepInterval)
+	}()
+
+	go func() {
+		errChan <- scheduler.Schedule(ctx, stats.Sync, a.config.StatsSyncInterval)
+	}()
+
+	go func() {
+		errChan <- scheduler.Schedule(ctx, syndicates.Settle, a.config.SyndicateSettleInterval)
+	}()
+
 	select {
 	case s := <-srv.Notify():
 		return fmt.Errorf("ошибка сервера: %w", s)
@@ -159,3 +207,49 @@ func (a *App) initDatabaseConnection(ctx context.Context) error {
 
 	return nil
 }
+
+func (a *App) initPaymentServiceConnection(ctx context.Context) error {
+	conn, err := grpc.NewClient(a.config.PaymentServiceAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(auth.NewServiceCredentials(a.config.JWTSecret, "ticket-service")),
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка при создании клиента сервиса платежей: %w", err)
+	}
+
+	a.paymentConn = conn
+	closer.Add(func() error {
+		if err := conn.Close(); err != nil {
+			return fmt.Errorf("ошибка при закрытии подключения к сервису платежей: %w", err)
+		}
+
+		return nil
+	})
+
+	a.logger.Info(ctx, "Клиент сервиса платежей инициализирован")
+
+	return nil
+}
+
+func (a *App) initUserServiceConnection(ctx context.Context) error {
+	conn, err := grpc.NewClient(a.config.UserServiceAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(auth.NewServiceCredentials(a.config.JWTSecret, "ticket-service")),
+	)
+	if err != nil {
+		return fmt.Errorf("ошибка при создании клиента сервиса пользователей: %w", err)
+	}
+
+	a.userConn = conn
+	closer.Add(func() error {
+		if err := conn.Close(); err != nil {
+			return fmt.Errorf("ошибка при закрытии подключения к сервису пользователей: %w", err)
+		}
+
+		return nil
+	})
+
+	a.logger.Info(ctx, "Клиент сервиса пользователей инициализирован")
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	paymentservicev1 "github.com/MaxFando/lms/ticket-service/api/grpc/gen/go/payment-service/v1"
+	"google.golang.org/grpc"
+)
+
+type Client struct {
+	api paymentservicev1.PaymentServiceClient
+}
+
+func New(conn grpc.ClientConnInterface) *Client {
+	return &Client{
+		api: paymentservicev1.NewPaymentServiceClient(conn),
+	}
+}
+
+// CreateInvoice выставляет один счёт на уже забронированные за пользователем билеты
+func (c *Client) CreateInvoice(ctx context.Context, userID int32, ticketIDs ...int32) (int64, error) {
+	ids := make([]int64, len(ticketIDs))
+	for i, id := range ticketIDs {
+		ids[i] = int64(id)
+	}
+
+	resp, err := c.api.CreateInvoiceInternal(ctx, &paymentservicev1.CreateInvoiceRequest{
+		UserId:    int64(userID),
+		TicketIds: ids,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("create invoice: %w", err)
+	}
+
+	return resp.GetId(), nil
+}
+
+// CancelInvoice отменяет неоплаченный счёт, если покупку не удалось завершить
+func (c *Client) CancelInvoice(ctx context.Context, invoiceID int64) error {
+	_, err := c.api.CancelInvoiceInternal(ctx, &paymentservicev1.CancelInvoiceRequest{
+		InvoiceId: invoiceID,
+	})
+	if err != nil {
+		return fmt.Errorf("cancel invoice: %w", err)
+	}
+
+	return nil
+}
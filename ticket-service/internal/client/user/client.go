@@ -0,0 +1,34 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	userservicev1 "github.com/MaxFando/lms/ticket-service/api/grpc/gen/go/user-service/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Client struct {
+	api userservicev1.UserServiceClient
+}
+
+func New(conn grpc.ClientConnInterface) *Client {
+	return &Client{
+		api: userservicev1.NewUserServiceClient(conn),
+	}
+}
+
+// Exists сообщает, зарегистрирован ли пользователь
+func (c *Client) Exists(ctx context.Context, userID int32) (bool, error) {
+	resp, err := c.api.GetUser(ctx, &userservicev1.GetUserRequest{Id: int64(userID)})
+	if status.Code(err) == codes.NotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("get user: %w", err)
+	}
+
+	return resp.GetUser() != nil, nil
+}
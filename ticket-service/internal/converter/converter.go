@@ -3,6 +3,9 @@ package converter
 import (
 	ticketservicev1 "github.com/MaxFando/lms/ticket-service/api/grpc/gen/go/ticket-service/v1"
 	"github.com/MaxFando/lms/ticket-service/internal/entity"
+	"github.com/MaxFando/lms/ticket-service/pkg/serial"
+	"github.com/shopspring/decimal"
+	"google.golang.org/genproto/googleapis/type/money"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"time"
 )
@@ -19,9 +22,18 @@ func ToTicketServiceFromEntity(t *entity.Ticket) *ticketservicev1.Ticket {
 		Numbers:   t.Numbers,
 		Status:    string(t.Status),
 		CreatedAt: t.CreatedAt.Format(time.RFC3339),
+		HeldUntil: formatOptionalTime(t.HeldUntil),
+		Serial:    serial.Format(t.Serial),
 	}
 }
 
+// ToPurchasedTicketServiceFromEntity - билет вместе со счётом, выставленным при покупке
+func ToPurchasedTicketServiceFromEntity(t *entity.Ticket, invoiceID int64) *ticketservicev1.Ticket {
+	out := ToTicketServiceFromEntity(t)
+	out.InvoiceId = &wrapperspb.Int64Value{Value: invoiceID}
+	return out
+}
+
 func ToTicketWithDrawServiceFromEntity(t *entity.TicketWithDraw) *ticketservicev1.TicketWithDraw {
 	return &ticketservicev1.TicketWithDraw{
 		TicketId:  t.ID,
@@ -31,6 +43,35 @@ func ToTicketWithDrawServiceFromEntity(t *entity.TicketWithDraw) *ticketservicev
 		Status:    string(t.Status),
 		CreatedAt: t.CreatedAt.Format(time.RFC3339),
 		Draw:      ToDrawServiceFromEntity(&t.Draw),
+		HeldUntil: formatOptionalTime(t.HeldUntil),
+		Serial:    serial.Format(t.Serial),
+		Syndicate: toSyndicateShareService(t.Syndicate),
+	}
+}
+
+func toSyndicateShareService(s *entity.SyndicateShare) *ticketservicev1.SyndicateShare {
+	if s == nil {
+		return nil
+	}
+	out := &ticketservicev1.SyndicateShare{
+		SyndicateId: s.SyndicateID,
+		Shares:      s.Shares,
+		TotalShares: s.TotalShares,
+	}
+	if s.Payout != nil {
+		out.Payout = decimalToMoney(*s.Payout)
+	}
+	return out
+}
+
+// ToTicketVerificationServiceFromEntity - результат проверки билета без данных владельца
+func ToTicketVerificationServiceFromEntity(t *entity.TicketWithDraw) *ticketservicev1.TicketVerification {
+	return &ticketservicev1.TicketVerification{
+		Serial:    serial.Format(t.Serial),
+		Draw:      ToDrawServiceFromEntity(&t.Draw),
+		Numbers:   t.Numbers,
+		Status:    string(t.Status),
+		CreatedAt: t.CreatedAt.Format(time.RFC3339),
 	}
 }
 
@@ -43,3 +84,140 @@ func ToDrawServiceFromEntity(d *entity.Draw) *ticketservicev1.Draw {
 		EndTime:     d.EndTime.Format(time.RFC3339),
 	}
 }
+
+func ToSubscriptionServiceFromEntity(s *entity.Subscription) *ticketservicev1.Subscription {
+	return &ticketservicev1.Subscription{
+		SubscriptionId: s.ID,
+		UserId:         s.UserID,
+		LotteryType:    s.LotteryType,
+		Numbers:        s.Numbers,
+		DrawsTotal:     s.DrawsTotal,
+		DrawsRemaining: s.DrawsRemaining,
+		Status:         string(s.Status),
+		CreatedAt:      s.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      s.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToCartServiceFromEntity(c *entity.Cart) *ticketservicev1.Cart {
+	var invoiceID *wrapperspb.Int64Value
+	if c.InvoiceID != nil {
+		invoiceID = &wrapperspb.Int64Value{Value: *c.InvoiceID}
+	}
+	tickets := make([]*ticketservicev1.Ticket, 0, len(c.Tickets))
+	for _, t := range c.Tickets {
+		tickets = append(tickets, ToTicketServiceFromEntity(t))
+	}
+	return &ticketservicev1.Cart{
+		CartId:    c.ID,
+		UserId:    c.UserID,
+		Status:    string(c.Status),
+		InvoiceId: invoiceID,
+		Tickets:   tickets,
+		CreatedAt: c.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: c.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToDrawSummaryServiceFromEntity(s *entity.DrawSummary) *ticketservicev1.DrawSummary {
+	out := &ticketservicev1.DrawSummary{
+		Draw:        ToDrawServiceFromEntity(&s.Draw),
+		TicketsSold: int32(s.TicketsSold),
+		Winners:     int32(s.Winners),
+		TotalPrize:  decimalToMoney(s.TotalPrize),
+	}
+	for _, t := range s.Tiers {
+		out.Tiers = append(out.Tiers, &ticketservicev1.PrizeTier{
+			Matched: int32(t.Matched),
+			Winners: int32(t.Winners),
+			Prize:   decimalToMoney(t.Prize),
+			Total:   decimalToMoney(t.Total),
+		})
+	}
+	return out
+}
+
+func ToWinnerServiceFromEntity(w *entity.Winner) *ticketservicev1.Winner {
+	var userID *wrapperspb.Int32Value
+	if w.UserID != nil {
+		userID = &wrapperspb.Int32Value{Value: *w.UserID}
+	}
+	resp := &ticketservicev1.Winner{
+		TicketId: w.TicketID,
+		UserId:   userID,
+		Serial:   serial.Format(w.Serial),
+		Numbers:  w.Numbers,
+		Matched:  int32(w.Matched),
+		Prize:    decimalToMoney(w.Prize),
+	}
+	if w.SyndicateID != nil {
+		resp.SyndicateId = &wrapperspb.Int32Value{Value: *w.SyndicateID}
+	}
+	for _, p := range w.Payouts {
+		resp.SyndicatePayouts = append(resp.SyndicatePayouts, &ticketservicev1.SyndicatePayout{
+			UserId: p.UserID,
+			Amount: decimalToMoney(p.Amount),
+		})
+	}
+	return resp
+}
+
+func decimalToMoney(d decimal.Decimal) *money.Money {
+	units := d.Truncate(0).IntPart()
+	nanos := d.Sub(decimal.NewFromInt(units)).Mul(decimal.NewFromInt(1_000_000_000)).IntPart()
+
+	return &money.Money{
+		CurrencyCode: "RUB",
+		Units:        units,
+		Nanos:        int32(nanos),
+	}
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func ToTransferServiceFromEntity(t *entity.Transfer) *ticketservicev1.Transfer {
+	return &ticketservicev1.Transfer{
+		TransferId: t.ID,
+		TicketId:   t.TicketID,
+		FromUserId: t.FromUserID,
+		ToUserId:   t.ToUserID,
+		Status:     string(t.Status),
+		CreatedAt:  t.CreatedAt.Format(time.RFC3339),
+		ResolvedAt: formatOptionalTime(t.ResolvedAt),
+	}
+}
+
+func ToFavouriteServiceFromEntity(f *entity.Favourite) *ticketservicev1.Favourite {
+	return &ticketservicev1.Favourite{
+		FavouriteId: f.ID,
+		Name:        f.Name,
+		LotteryType: f.LotteryType,
+		Numbers:     f.Numbers,
+		CreatedAt:   f.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToSyndicateServiceFromEntity(s *entity.Syndicate) *ticketservicev1.Syndicate {
+	out := &ticketservicev1.Syndicate{
+		SyndicateId: s.ID,
+		Name:        s.Name,
+		OwnerId:     s.OwnerID,
+		CreatedAt:   s.CreatedAt.Format(time.RFC3339),
+		TicketIds:   s.TicketIDs,
+	}
+	for _, m := range s.Members {
+		out.Members = append(out.Members, &ticketservicev1.SyndicateMember{
+			UserId:    m.UserID,
+			Shares:    m.Shares,
+			Status:    string(m.Status),
+			InvitedAt: m.InvitedAt.Format(time.RFC3339),
+			JoinedAt:  formatOptionalTime(m.JoinedAt),
+		})
+	}
+	return out
+}
@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+type CartStatus string
+
+const (
+	CartStatusOpen       CartStatus = "OPEN"
+	CartStatusCheckedOut CartStatus = "CHECKED_OUT"
+	CartStatusReleased   CartStatus = "RELEASED"
+	CartStatusPaid       CartStatus = "PAID"
+)
+
+// Cart - корзина билетов, оплачиваемых одним счётом
+type Cart struct {
+	ID        int32
+	UserID    int32
+	Status    CartStatus
+	InvoiceID *int64
+	Tickets   []*Ticket
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (c *Cart) TicketIDs() []int32 {
+	ids := make([]int32, len(c.Tickets))
+	for i, t := range c.Tickets {
+		ids[i] = t.ID
+	}
+	return ids
+}
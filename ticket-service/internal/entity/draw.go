@@ -3,9 +3,9 @@ package entity
 import "time"
 
 type Draw struct {
-	ID          int32
-	LotteryType string
-	StartTime   time.Time
-	EndTime     time.Time
-	Status      string
+	ID          int32     `json:"id"`
+	LotteryType string    `json:"lottery_type"`
+	StartTime   time.Time `json:"start_time"`
+	EndTime     time.Time `json:"end_time"`
+	Status      string    `json:"status"`
 }
@@ -1,3 +1,26 @@
 package entity
 
-const EventTypeDrawActivated string = "draw_activated"
+const (
+	EventTypeDrawActivated string = "draw_activated"
+	EventTypeDrawCancelled string = "draw_cancelled"
+	EventTypeDrawCompleted string = "draw_completed"
+)
+
+const (
+	EventTypeTransferRequested string = "ticket_transfer_requested"
+	EventTypeTransferAccepted  string = "ticket_transfer_accepted"
+	EventTypeTransferDeclined  string = "ticket_transfer_declined"
+	EventTypeTransferCancelled string = "ticket_transfer_cancelled"
+)
+
+const (
+	EventTypeSyndicateInvited string = "syndicate_invited"
+	EventTypeSyndicatePayout  string = "syndicate_payout"
+)
+
+// EventTypeTicketWon - билет выиграл, приз причитается его владельцу
+const EventTypeTicketWon string = "ticket_won"
+
+// EventTypeTicketsUndelivered - счёт оплачен, но его билеты уже не забронированы за покупателем;
+// сервис платежей возвращает за них деньги
+const EventTypeTicketsUndelivered string = "tickets_undelivered"
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// Favourite - сохранённый пользователем набор чисел для быстрой покупки билета
+type Favourite struct {
+	ID          int32
+	UserID      int32
+	Name        string
+	LotteryType string
+	Numbers     []string
+	CreatedAt   time.Time
+}
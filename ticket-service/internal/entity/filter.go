@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+type SortOrder int
+
+const (
+	SortNewestFirst SortOrder = iota
+	SortOldestFirst
+)
+
+// TicketCursor - позиция последнего билета страницы в порядке (created_at, ticket_id)
+type TicketCursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        int32     `json:"i"`
+}
+
+// TicketFilter - условия выборки и страница списка билетов
+type TicketFilter struct {
+	DrawID      *int32
+	Statuses    []Status
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Order       SortOrder
+	Limit       int
+	After       *TicketCursor
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// BookingLimits - ограничения на билеты одного пользователя в одном тираже; нулевое поле снимает ограничение
+type BookingLimits struct {
+	MaxHeld        int
+	MaxPurchased   int
+	OverdueStrikes int
+	Cooldown       time.Duration
+}
+
+// BookingUsage - билеты пользователя в тираже и число его просроченных счетов за последние Cooldown
+type BookingUsage struct {
+	Held      int
+	Purchased int
+	Overdues  int
+}
+
+// Violation возвращает причину, по которой пользователь не может взять ещё pending билетов, или пустую строку
+func (l BookingLimits) Violation(u BookingUsage, pending int) string {
+	if l.OverdueStrikes > 0 && l.Cooldown > 0 && u.Overdues >= l.OverdueStrikes {
+		return fmt.Sprintf("%d overdue invoices within %s, booking is paused", u.Overdues, l.Cooldown)
+	}
+	if l.MaxHeld > 0 && u.Held+pending > l.MaxHeld {
+		return fmt.Sprintf("at most %d unpaid tickets per draw", l.MaxHeld)
+	}
+	if l.MaxPurchased > 0 && u.Held+u.Purchased+pending > l.MaxPurchased {
+		return fmt.Sprintf("at most %d tickets per draw", l.MaxPurchased)
+	}
+	return ""
+}
@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"slices"
+
+	"github.com/shopspring/decimal"
+)
+
+// Winner - выигрышный билет тиража с числом угаданных номеров и призом разряда
+type Winner struct {
+	TicketID int32
+	DrawID   int32
+	UserID   *int32
+	Serial   string
+	Numbers  []string
+	Matched  int
+	Prize    decimal.Decimal
+	// SyndicateID, Payouts - синдикат билета и доли участников; пусто, пока приз не распределён
+	SyndicateID *int32
+	Payouts     []SyndicatePayout
+}
+
+// TierResult - итоги одного призового разряда: сколько билетов угадали Matched чисел и сколько им выплачивается
+type TierResult struct {
+	Matched int
+	Winners int
+	Prize   decimal.Decimal
+	Total   decimal.Decimal
+}
+
+// DrawSummary - публичные итоги тиража без данных о владельцах билетов
+type DrawSummary struct {
+	Draw        Draw
+	TicketsSold int
+	Winners     int
+	Tiers       []TierResult
+	TotalPrize  decimal.Decimal
+}
+
+// SummarizeWinners раскладывает победителей по разрядам, начиная со старшего
+func SummarizeWinners(winners []*Winner) (tiers []TierResult, total decimal.Decimal) {
+	byMatched := make(map[int]*TierResult)
+	for _, w := range winners {
+		tier, ok := byMatched[w.Matched]
+		if !ok {
+			tier = &TierResult{Matched: w.Matched, Prize: w.Prize}
+			byMatched[w.Matched] = tier
+		}
+		tier.Winners++
+		tier.Total = tier.Total.Add(w.Prize)
+		total = total.Add(w.Prize)
+	}
+
+	for _, tier := range byMatched {
+		tiers = append(tiers, *tier)
+	}
+	slices.SortFunc(tiers, func(a, b TierResult) int { return b.Matched - a.Matched })
+	return tiers, total
+}
@@ -0,0 +1,77 @@
+package entity
+
+import "time"
+
+// CompletedDraw - разыгранный тираж, итоги которого ещё не учтены в статистике чисел
+type CompletedDraw struct {
+	ID                 int32
+	LotteryType        string
+	DrawnAt            time.Time
+	WinningCombination string
+}
+
+// DrawNumberStat - одно число в одном тираже: выпало ли оно и сколько раз его выбрали игроки
+type DrawNumberStat struct {
+	DrawID int32
+	Number int
+	Drawn  bool
+	Picks  int
+}
+
+// NumberStat - частота числа за период; пропуски считаются в тиражах
+type NumberStat struct {
+	Number     int
+	Drawn      int
+	Picks      int
+	CurrentGap int
+	LongestGap int
+}
+
+// AggregateNumberStats сворачивает построчную статистику тиражей, упорядоченную по времени розыгрыша,
+// в статистику чисел от 1 до maxNum и возвращает её вместе с числом тиражей
+func AggregateNumberStats(maxNum int, rows []DrawNumberStat) ([]NumberStat, int) {
+	stats := make([]NumberStat, maxNum)
+	for i := range stats {
+		stats[i].Number = i + 1
+	}
+
+	draws := 0
+	var (
+		current int32
+		seen    = make([]bool, maxNum)
+	)
+	flush := func() {
+		for i := range stats {
+			if seen[i] {
+				stats[i].CurrentGap = 0
+			} else {
+				stats[i].CurrentGap++
+				stats[i].LongestGap = max(stats[i].LongestGap, stats[i].CurrentGap)
+			}
+			seen[i] = false
+		}
+	}
+
+	for _, r := range rows {
+		if draws == 0 || r.DrawID != current {
+			if draws > 0 {
+				flush()
+			}
+			current = r.DrawID
+			draws++
+		}
+		if r.Number < 1 || r.Number > maxNum {
+			continue
+		}
+		s := &stats[r.Number-1]
+		s.Picks += r.Picks
+		if r.Drawn {
+			s.Drawn++
+			seen[r.Number-1] = true
+		}
+	}
+	if draws > 0 {
+		flush()
+	}
+	return stats, draws
+}
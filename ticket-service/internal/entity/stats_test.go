@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAggregateNumberStats(t *testing.T) {
+	tests := []struct {
+		name      string
+		rows      []DrawNumberStat
+		want      []NumberStat
+		wantDraws int
+	}{
+		{
+			name: "no draws",
+			want: []NumberStat{{Number: 1}, {Number: 2}, {Number: 3}},
+		},
+		{
+			name: "gaps across draws",
+			rows: []DrawNumberStat{
+				{DrawID: 1, Number: 1, Drawn: true, Picks: 3},
+				{DrawID: 1, Number: 2, Drawn: true},
+				{DrawID: 1, Number: 3, Picks: 2},
+				{DrawID: 2, Number: 1, Drawn: true, Picks: 1},
+				{DrawID: 2, Number: 3, Drawn: true},
+				{DrawID: 3, Number: 2, Drawn: true},
+			},
+			want: []NumberStat{
+				{Number: 1, Drawn: 2, Picks: 4, CurrentGap: 1, LongestGap: 1},
+				{Number: 2, Drawn: 2, CurrentGap: 0, LongestGap: 1},
+				{Number: 3, Drawn: 1, Picks: 2, CurrentGap: 1, LongestGap: 1},
+			},
+			wantDraws: 3,
+		},
+		{
+			name: "longest gap survives a hit",
+			rows: []DrawNumberStat{
+				{DrawID: 1, Number: 1, Drawn: true},
+				{DrawID: 2, Number: 1, Drawn: true},
+				{DrawID: 3, Number: 2, Drawn: true},
+				{DrawID: 3, Number: 3, Drawn: true},
+			},
+			want: []NumberStat{
+				{Number: 1, Drawn: 2, CurrentGap: 1, LongestGap: 1},
+				{Number: 2, Drawn: 1, CurrentGap: 0, LongestGap: 2},
+				{Number: 3, Drawn: 1, CurrentGap: 0, LongestGap: 2},
+			},
+			wantDraws: 3,
+		},
+		{
+			name: "out of range numbers ignored but draw counted",
+			rows: []DrawNumberStat{
+				{DrawID: 5, Number: 0, Drawn: true, Picks: 1},
+				{DrawID: 5, Number: 4, Drawn: true, Picks: 1},
+			},
+			want: []NumberStat{
+				{Number: 1, CurrentGap: 1, LongestGap: 1},
+				{Number: 2, CurrentGap: 1, LongestGap: 1},
+				{Number: 3, CurrentGap: 1, LongestGap: 1},
+			},
+			wantDraws: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, draws := AggregateNumberStats(3, tt.rows)
+			if draws != tt.wantDraws {
+				t.Errorf("draws = %d, want %d", draws, tt.wantDraws)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("stats = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+type SubscriptionStatus string
+
+const (
+	SubscriptionStatusActive    SubscriptionStatus = "ACTIVE"
+	SubscriptionStatusPaused    SubscriptionStatus = "PAUSED"
+	SubscriptionStatusCompleted SubscriptionStatus = "COMPLETED"
+	SubscriptionStatusCancelled SubscriptionStatus = "CANCELLED"
+)
+
+// Subscription - игра одной и той же комбинацией чисел в нескольких тиражах подряд
+type Subscription struct {
+	ID             int32
+	UserID         int32
+	LotteryType    string
+	Numbers        []string
+	DrawsTotal     int32
+	DrawsRemaining int32
+	Status         SubscriptionStatus
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type MemberStatus string
+
+const (
+	MemberInvited  MemberStatus = "INVITED"
+	MemberActive   MemberStatus = "ACTIVE"
+	MemberDeclined MemberStatus = "DECLINED"
+)
+
+// Syndicate - группа пользователей, совместно владеющая билетами; выигрыш делится по долям участников
+type Syndicate struct {
+	ID        int32
+	Name      string
+	OwnerID   int32
+	CreatedAt time.Time
+	Members   []SyndicateMember
+	TicketIDs []int32
+}
+
+type SyndicateMember struct {
+	UserID    int32
+	Shares    int32
+	Status    MemberStatus
+	InvitedAt time.Time
+	JoinedAt  *time.Time
+}
+
+// Member возвращает участника синдиката с любым статусом
+func (s *Syndicate) Member(userID int32) (SyndicateMember, bool) {
+	for _, m := range s.Members {
+		if m.UserID == userID {
+			return m, true
+		}
+	}
+	return SyndicateMember{}, false
+}
+
+// ActiveMembers - участники, принявшие приглашение, в порядке вступления
+func (s *Syndicate) ActiveMembers() []SyndicateMember {
+	var out []SyndicateMember
+	for _, m := range s.Members {
+		if m.Status == MemberActive {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// SyndicateShare - доля пользователя в билете синдиката и его часть выигрыша после расчёта
+type SyndicateShare struct {
+	SyndicateID int32
+	Shares      int32
+	TotalShares int32
+	Payout      *decimal.Decimal
+}
+
+// SyndicateWin - выигрышный билет синдиката, по которому ещё не распределён приз
+type SyndicateWin struct {
+	SyndicateID int32
+	TicketID    int32
+	Prize       decimal.Decimal
+}
+
+type SyndicatePayout struct {
+	UserID int32
+	Amount decimal.Decimal
+}
+
+// Apportion делит сумму по долям с точностью до копейки методом наибольшего остатка:
+// каждый получает округлённую вниз часть, а оставшиеся копейки по одной достаются
+// участникам с наибольшими отброшенными остатками, при равенстве - тем, кто раньше в списке.
+// Сумма частей всегда равна исходной сумме.
+func Apportion(total decimal.Decimal, shares []int32) []decimal.Decimal {
+	out := make([]decimal.Decimal, len(shares))
+	var sum int64
+	for _, s := range shares {
+		if s > 0 {
+			sum += int64(s)
+		}
+	}
+	if sum == 0 {
+		return out
+	}
+
+	cents := total.Shift(2).Round(0).BigInt()
+	type part struct {
+		idx int
+		rem int64
+	}
+	parts := make([]part, 0, len(shares))
+	distributed := decimal.Zero
+	for i, s := range shares {
+		if s <= 0 {
+			out[i] = decimal.Zero
+			continue
+		}
+		q, r := decimal.NewFromBigInt(cents, 0).Mul(decimal.NewFromInt(int64(s))).QuoRem(decimal.NewFromInt(sum), 0)
+		out[i] = q
+		distributed = distributed.Add(q)
+		parts = append(parts, part{idx: i, rem: r.IntPart()})
+	}
+
+	slices.SortStableFunc(parts, func(a, b part) int { return cmp.Compare(b.rem, a.rem) })
+	left := decimal.NewFromBigInt(cents, 0).Sub(distributed).IntPart()
+	for i := 0; i < int(left); i++ {
+		out[parts[i].idx] = out[parts[i].idx].Add(decimal.NewFromInt(1))
+	}
+
+	for i := range out {
+		out[i] = out[i].Shift(-2)
+	}
+	return out
+}
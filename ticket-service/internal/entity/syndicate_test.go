@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestApportion(t *testing.T) {
+	tests := []struct {
+		name   string
+		total  string
+		shares []int32
+		want   []string
+	}{
+		{"even", "300", []int32{1, 1, 1}, []string{"100", "100", "100"}},
+		{"remainder to first on tie", "100", []int32{1, 1, 1}, []string{"33.34", "33.33", "33.33"}},
+		{"largest remainder wins", "1", []int32{1, 2, 4}, []string{"0.14", "0.29", "0.57"}},
+		{"proportional", "2000", []int32{3, 1}, []string{"1500", "500"}},
+		{"single member", "123.45", []int32{7}, []string{"123.45"}},
+		{"zero shares skipped", "10", []int32{0, 1}, []string{"0", "10"}},
+		{"no shares", "10", []int32{0}, []string{"0"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total := decimal.RequireFromString(tt.total)
+			got := Apportion(total, tt.shares)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d parts, want %d", len(got), len(tt.want))
+			}
+			sum := decimal.Zero
+			for i, w := range tt.want {
+				if !got[i].Equal(decimal.RequireFromString(w)) {
+					t.Errorf("part %d = %s, want %s", i, got[i], w)
+				}
+				sum = sum.Add(got[i])
+			}
+			if sum.IsPositive() && !sum.Equal(total) {
+				t.Errorf("parts sum to %s, want %s", sum, total)
+			}
+		})
+	}
+}
@@ -1,21 +1,66 @@
 package entity
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 type Status string
 
 const (
-	StatusPending Status = "PENDING"
-	StatusWin     Status = "WIN"
-	StatusLose    Status = "LOSE"
+	StatusAvailable Status = "AVAILABLE"
+	StatusHeld      Status = "HELD"
+	StatusPaid      Status = "PAID"
+	StatusCancelled Status = "CANCELLED"
+	StatusWin       Status = "WIN"
+	StatusLose      Status = "LOSE"
+	StatusRefunded  Status = "REFUNDED"
 )
 
+// MaxHoldExtensions - сколько раз владелец может продлить бронь билета: дальше билет нужно оплатить,
+// иначе бронь истечёт и он вернётся в продажу
+const MaxHoldExtensions = 3
+
+// transitions - допустимые переходы жизненного цикла билета
+var transitions = map[Status][]Status{
+	StatusAvailable: {StatusHeld, StatusCancelled},
+	StatusHeld:      {StatusAvailable, StatusPaid, StatusCancelled},
+	StatusPaid:      {StatusWin, StatusLose, StatusCancelled, StatusRefunded},
+	StatusCancelled: {StatusRefunded},
+}
+
+func (s Status) Valid() bool {
+	switch s {
+	case StatusAvailable, StatusHeld, StatusPaid, StatusCancelled, StatusWin, StatusLose, StatusRefunded:
+		return true
+	}
+	return false
+}
+
+func (s Status) CanTransitionTo(to Status) bool {
+	return slices.Contains(transitions[s], to)
+}
+
+// TransitionSources возвращает статусы, из которых билет может перейти в to
+func TransitionSources(to Status) []Status {
+	var from []Status
+	for s, targets := range transitions {
+		if slices.Contains(targets, to) {
+			from = append(from, s)
+		}
+	}
+	slices.Sort(from)
+	return from
+}
+
 type Ticket struct {
 	ID        int32
 	UserID    *int32
 	DrawID    int32
 	Numbers   []string
 	Status    Status
+	HeldUntil *time.Time
+	Serial    string
 	CreatedAt time.Time
 }
 
@@ -25,6 +70,10 @@ type TicketWithDraw struct {
 	DrawID    int32
 	Numbers   []string
 	Status    Status
+	HeldUntil *time.Time
+	Serial    string
 	CreatedAt time.Time
 	Draw      Draw
+	// Syndicate - доля пользователя, если билет принадлежит синдикату, где он участвует
+	Syndicate *SyndicateShare
 }
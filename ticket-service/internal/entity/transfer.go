@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+type TransferStatus string
+
+const (
+	TransferStatusPending   TransferStatus = "PENDING"
+	TransferStatusAccepted  TransferStatus = "ACCEPTED"
+	TransferStatusDeclined  TransferStatus = "DECLINED"
+	TransferStatusCancelled TransferStatus = "CANCELLED"
+)
+
+// Transfer - передача оплаченного билета другому пользователю; билет меняет владельца только после принятия
+type Transfer struct {
+	ID         int32
+	TicketID   int32
+	FromUserID int32
+	ToUserID   int32
+	Status     TransferStatus
+	CreatedAt  time.Time
+	ResolvedAt *time.Time
+}
@@ -0,0 +1,339 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+	"github.com/MaxFando/lms/ticket-service/internal/repository"
+	"github.com/jmoiron/sqlx"
+)
+
+const cartColumns = `cart_id, user_id, status, invoice_id, created_at, updated_at`
+
+type CartRepository struct {
+	db *sqlx.DB
+}
+
+func NewCartRepository(db *sqlx.DB) repository.CartRepository {
+	return &CartRepository{
+		db: db,
+	}
+}
+
+func scanCart(row rowScanner) (*entity.Cart, error) {
+	var (
+		c         entity.Cart
+		st        string
+		invoiceID sql.NullInt64
+	)
+	if err := row.Scan(&c.ID, &c.UserID, &st, &invoiceID, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if invoiceID.Valid {
+		id := invoiceID.Int64
+		c.InvoiceID = &id
+	}
+	c.Status = entity.CartStatus(st)
+	return &c, nil
+}
+
+func (r *CartRepository) GetOpen(ctx context.Context, userID int32) (*entity.Cart, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin: %w", err)
+	}
+	defer tx.Rollback()
+
+	c, err := r.lockOpen(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if c.Tickets, err = r.listTickets(ctx, tx, c.ID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+	return c, nil
+}
+
+func (r *CartRepository) AddTicket(ctx context.Context, userID, ticketID int32, heldUntil time.Time, limits entity.BookingLimits) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin: %w", err)
+	}
+	defer tx.Rollback()
+
+	c, err := r.lockOpen(ctx, tx, userID)
+	if err != nil {
+		return err
+	}
+
+	t, err := holdTicket(ctx, tx, ticketID, userID, heldUntil)
+	if err != nil {
+		return err
+	}
+
+	usage, err := bookingUsage(ctx, tx, userID, t.DrawID, time.Now().Add(-limits.Cooldown))
+	if err != nil {
+		return err
+	}
+	if reason := limits.Violation(usage, 0); reason != "" {
+		return fmt.Errorf("%w: %s", repository.ErrLimitExceeded, reason)
+	}
+
+	const addQuery = `INSERT INTO ticket.cart_items (cart_id, ticket_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	if _, err := tx.ExecContext(ctx, addQuery, c.ID, ticketID); err != nil {
+		return fmt.Errorf("add cart item: %w", err)
+	}
+	if err := r.touch(ctx, tx, c.ID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+	return nil
+}
+
+func (r *CartRepository) RemoveTicket(ctx context.Context, userID, ticketID int32) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin: %w", err)
+	}
+	defer tx.Rollback()
+
+	const removeQuery = `
+        DELETE FROM ticket.cart_items ci
+        USING ticket.carts c
+        WHERE ci.cart_id = c.cart_id
+          AND c.user_id = $1
+          AND c.status = 'OPEN'
+          AND ci.ticket_id = $2
+        RETURNING c.cart_id
+    `
+	var cartID int32
+	if err := tx.QueryRowxContext(ctx, removeQuery, userID, ticketID).Scan(&cartID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("ticket %d in cart: %w", ticketID, repository.ErrNotFound)
+		}
+		return fmt.Errorf("remove cart item: %w", err)
+	}
+
+	const releaseQuery = `
+        UPDATE ticket.tickets
+        SET user_id = NULL, status = 'AVAILABLE', held_until = NULL
+        WHERE ticket_id = $1 AND user_id = $2 AND status = 'HELD'
+    `
+	if _, err := tx.ExecContext(ctx, releaseQuery, ticketID, userID); err != nil {
+		return fmt.Errorf("release ticket: %w", err)
+	}
+	if err := r.touch(ctx, tx, cartID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+	return nil
+}
+
+func (r *CartRepository) StartCheckout(ctx context.Context, userID int32) (*entity.Cart, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin: %w", err)
+	}
+	defer tx.Rollback()
+
+	const query = `
+        UPDATE ticket.carts
+        SET status = 'CHECKED_OUT', updated_at = NOW()
+        WHERE user_id = $1 AND status = 'OPEN'
+        RETURNING ` + cartColumns
+
+	c, err := scanCart(tx.QueryRowxContext(ctx, query, userID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("open cart of user %d: %w", userID, repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("check out cart: %w", err)
+	}
+
+	const pinQuery = `
+        UPDATE ticket.tickets t
+        SET held_until = NULL
+        FROM ticket.cart_items ci
+        WHERE ci.cart_id = $1 AND t.ticket_id = ci.ticket_id AND t.status = 'HELD'
+    `
+	if _, err := tx.ExecContext(ctx, pinQuery, c.ID); err != nil {
+		return nil, fmt.Errorf("pin cart holds: %w", err)
+	}
+	if c.Tickets, err = r.listTickets(ctx, tx, c.ID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+	return c, nil
+}
+
+func (r *CartRepository) CompleteCheckout(ctx context.Context, cartID int32, invoiceID int64) (*entity.Cart, error) {
+	const query = `
+        UPDATE ticket.carts
+        SET invoice_id = $2, updated_at = NOW()
+        WHERE cart_id = $1 AND status = 'CHECKED_OUT' AND invoice_id IS NULL
+        RETURNING ` + cartColumns
+
+	c, err := scanCart(r.db.QueryRowxContext(ctx, query, cartID, invoiceID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("cart %d awaiting invoice: %w", cartID, repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("attach invoice: %w", err)
+	}
+	if c.Tickets, err = r.listTickets(ctx, r.db, c.ID); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *CartRepository) AbortCheckout(ctx context.Context, cartID int32, heldUntil time.Time) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin: %w", err)
+	}
+	defer tx.Rollback()
+
+	const lockQuery = `
+        SELECT user_id
+        FROM ticket.carts
+        WHERE cart_id = $1 AND status = 'CHECKED_OUT' AND invoice_id IS NULL
+        FOR UPDATE
+    `
+	var userID int32
+	if err := tx.QueryRowxContext(ctx, lockQuery, cartID).Scan(&userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("cart %d awaiting invoice: %w", cartID, repository.ErrNotFound)
+		}
+		return fmt.Errorf("lock cart: %w", err)
+	}
+
+	const unpinQuery = `
+        UPDATE ticket.tickets t
+        SET held_until = $2
+        FROM ticket.cart_items ci
+        WHERE ci.cart_id = $1 AND t.ticket_id = ci.ticket_id AND t.status = 'HELD'
+    `
+	if _, err := tx.ExecContext(ctx, unpinQuery, cartID, heldUntil); err != nil {
+		return fmt.Errorf("restore cart holds: %w", err)
+	}
+
+	// Пока корзина оформлялась, пользователь мог начать новую: переносим билеты в неё.
+	const openQuery = `SELECT cart_id FROM ticket.carts WHERE user_id = $1 AND status = 'OPEN' FOR UPDATE`
+	var openID int32
+	err = tx.QueryRowxContext(ctx, openQuery, userID).Scan(&openID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		const reopenQuery = `UPDATE ticket.carts SET status = 'OPEN', updated_at = NOW() WHERE cart_id = $1`
+		if _, err := tx.ExecContext(ctx, reopenQuery, cartID); err != nil {
+			return fmt.Errorf("reopen cart: %w", err)
+		}
+	case err != nil:
+		return fmt.Errorf("find open cart: %w", err)
+	default:
+		const moveQuery = `UPDATE ticket.cart_items SET cart_id = $2 WHERE cart_id = $1`
+		if _, err := tx.ExecContext(ctx, moveQuery, cartID, openID); err != nil {
+			return fmt.Errorf("move cart items: %w", err)
+		}
+		const releaseQuery = `UPDATE ticket.carts SET status = 'RELEASED', updated_at = NOW() WHERE cart_id = $1`
+		if _, err := tx.ExecContext(ctx, releaseQuery, cartID); err != nil {
+			return fmt.Errorf("release cart: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+	return nil
+}
+
+func (r *CartRepository) ReleaseByInvoice(ctx context.Context, invoiceID int64) error {
+	const query = `
+        UPDATE ticket.carts
+        SET status = 'RELEASED', updated_at = NOW()
+        WHERE invoice_id = $1 AND status = 'CHECKED_OUT'
+    `
+	if _, err := r.db.ExecContext(ctx, query, invoiceID); err != nil {
+		return fmt.Errorf("release cart: %w", err)
+	}
+	return nil
+}
+
+func (r *CartRepository) MarkPaidByInvoice(ctx context.Context, invoiceID int64) error {
+	const query = `
+        UPDATE ticket.carts
+        SET status = 'PAID', updated_at = NOW()
+        WHERE invoice_id = $1 AND status = 'CHECKED_OUT'
+    `
+	if _, err := r.db.ExecContext(ctx, query, invoiceID); err != nil {
+		return fmt.Errorf("mark cart paid: %w", err)
+	}
+	return nil
+}
+
+func (r *CartRepository) lockOpen(ctx context.Context, tx *sqlx.Tx, userID int32) (*entity.Cart, error) {
+	const createQuery = `
+        INSERT INTO ticket.carts (user_id)
+        VALUES ($1)
+        ON CONFLICT (user_id) WHERE status = 'OPEN' DO NOTHING
+    `
+	if _, err := tx.ExecContext(ctx, createQuery, userID); err != nil {
+		return nil, fmt.Errorf("create cart: %w", err)
+	}
+
+	const lockQuery = `SELECT ` + cartColumns + ` FROM ticket.carts WHERE user_id = $1 AND status = 'OPEN' FOR UPDATE`
+	c, err := scanCart(tx.QueryRowxContext(ctx, lockQuery, userID))
+	if err != nil {
+		return nil, fmt.Errorf("lock cart: %w", err)
+	}
+	return c, nil
+}
+
+func (r *CartRepository) touch(ctx context.Context, tx *sqlx.Tx, cartID int32) error {
+	const query = `UPDATE ticket.carts SET updated_at = NOW() WHERE cart_id = $1`
+	if _, err := tx.ExecContext(ctx, query, cartID); err != nil {
+		return fmt.Errorf("touch cart: %w", err)
+	}
+	return nil
+}
+
+func (r *CartRepository) listTickets(ctx context.Context, q sqlx.QueryerContext, cartID int32) ([]*entity.Ticket, error) {
+	const query = `
+        SELECT ` + qualifiedTicketColumns + `
+        FROM ticket.cart_items ci
+        JOIN ticket.tickets t ON t.ticket_id = ci.ticket_id
+        WHERE ci.cart_id = $1
+        ORDER BY ci.added_at
+    `
+	rows, err := q.QueryxContext(ctx, query, cartID)
+	if err != nil {
+		return nil, fmt.Errorf("query cart tickets: %w", err)
+	}
+	defer rows.Close()
+
+	var tickets []*entity.Ticket
+	for rows.Next() {
+		t, err := scanTicket(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan cart ticket: %w", err)
+		}
+		tickets = append(tickets, t)
+	}
+	return tickets, rows.Err()
+}
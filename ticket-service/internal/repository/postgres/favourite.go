@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+	"github.com/MaxFando/lms/ticket-service/internal/repository"
+	"github.com/jmoiron/sqlx"
+)
+
+const favouriteColumns = `favourite_id, user_id, name, lottery_type, numbers, created_at`
+
+type FavouriteRepository struct {
+	db *sqlx.DB
+}
+
+func NewFavouriteRepository(db *sqlx.DB) repository.FavouriteRepository {
+	return &FavouriteRepository{
+		db: db,
+	}
+}
+
+func scanFavourite(row rowScanner) (*entity.Favourite, error) {
+	var (
+		f       entity.Favourite
+		numsArr string
+	)
+	if err := row.Scan(&f.ID, &f.UserID, &f.Name, &f.LotteryType, &numsArr, &f.CreatedAt); err != nil {
+		return nil, err
+	}
+	f.Numbers = parseNumbersArray(numsArr)
+	return &f, nil
+}
+
+func (r *FavouriteRepository) Create(ctx context.Context, f *entity.Favourite) (*entity.Favourite, error) {
+	const query = `
+        INSERT INTO ticket.favourites (user_id, name, lottery_type, numbers)
+        VALUES ($1, $2, $3, $4::text[])
+        ON CONFLICT (user_id, name) DO NOTHING
+        RETURNING ` + favouriteColumns
+
+	saved, err := scanFavourite(r.db.QueryRowxContext(ctx, query, f.UserID, f.Name, f.LotteryType, formatNumbersArray(f.Numbers)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("favourite %q: %w", f.Name, repository.ErrAlreadyExists)
+		}
+		return nil, fmt.Errorf("insert favourite: %w", err)
+	}
+	return saved, nil
+}
+
+func (r *FavouriteRepository) GetByID(ctx context.Context, id int32) (*entity.Favourite, error) {
+	const query = `SELECT ` + favouriteColumns + ` FROM ticket.favourites WHERE favourite_id = $1`
+
+	f, err := scanFavourite(r.db.QueryRowxContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("favourite %d: %w", id, repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("scan favourite: %w", err)
+	}
+	return f, nil
+}
+
+func (r *FavouriteRepository) ListByUser(ctx context.Context, userID int32) ([]*entity.Favourite, error) {
+	const query = `
+        SELECT ` + favouriteColumns + `
+        FROM ticket.favourites
+        WHERE user_id = $1
+        ORDER BY name
+    `
+	rows, err := r.db.QueryxContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query favourites: %w", err)
+	}
+	defer rows.Close()
+
+	var out []*entity.Favourite
+	for rows.Next() {
+		f, err := scanFavourite(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan favourite: %w", err)
+		}
+		out = append(out, f)
+	}
+	return out, rows.Err()
+}
+
+func (r *FavouriteRepository) Delete(ctx context.Context, id, userID int32) error {
+	const query = `DELETE FROM ticket.favourites WHERE favourite_id = $1 AND user_id = $2`
+
+	res, err := r.db.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return fmt.Errorf("delete favourite: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("favourite %d: %w", id, repository.ErrNotFound)
+	}
+	return nil
+}
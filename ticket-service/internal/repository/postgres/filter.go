@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+)
+
+// ticketQuery собирает условия WHERE, сортировку и LIMIT списка билетов по фильтру
+type ticketQuery struct {
+	conds []string
+	args  []any
+	// limitArg - номер аргумента LIMIT; повторный tail использует тот же аргумент
+	limitArg int
+}
+
+func (q *ticketQuery) add(cond string, arg any) {
+	q.args = append(q.args, arg)
+	q.conds = append(q.conds, fmt.Sprintf(cond, len(q.args)))
+}
+
+func (q *ticketQuery) apply(f entity.TicketFilter) {
+	if f.DrawID != nil {
+		q.add("t.draw_id = $%d", *f.DrawID)
+	}
+	if len(f.Statuses) > 0 {
+		q.add("t.status::text = ANY($%d::text[])", formatStatusesArray(f.Statuses))
+	}
+	if f.CreatedFrom != nil {
+		q.add("t.created_at >= $%d", *f.CreatedFrom)
+	}
+	if f.CreatedTo != nil {
+		q.add("t.created_at < $%d", *f.CreatedTo)
+	}
+	if f.After != nil {
+		cmp := "<"
+		if f.Order == entity.SortOldestFirst {
+			cmp = ">"
+		}
+		q.args = append(q.args, f.After.CreatedAt, f.After.ID)
+		q.conds = append(q.conds, fmt.Sprintf("(t.created_at, t.ticket_id) %s ($%d, $%d)", cmp, len(q.args)-1, len(q.args)))
+	}
+}
+
+// tail возвращает WHERE, ORDER BY и LIMIT
+func (q *ticketQuery) tail(f entity.TicketFilter) string {
+	dir := "DESC"
+	if f.Order == entity.SortOldestFirst {
+		dir = "ASC"
+	}
+
+	var b strings.Builder
+	if len(q.conds) > 0 {
+		b.WriteString(" WHERE ")
+		b.WriteString(strings.Join(q.conds, " AND "))
+	}
+	fmt.Fprintf(&b, " ORDER BY t.created_at %s, t.ticket_id %s", dir, dir)
+	if f.Limit > 0 {
+		if q.limitArg == 0 {
+			q.args = append(q.args, f.Limit)
+			q.limitArg = len(q.args)
+		}
+		fmt.Fprintf(&b, " LIMIT $%d", q.limitArg)
+	}
+	return b.String()
+}
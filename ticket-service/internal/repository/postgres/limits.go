@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+	"github.com/MaxFando/lms/ticket-service/internal/repository"
+	"github.com/jmoiron/sqlx"
+)
+
+type LimitRepository struct {
+	db *sqlx.DB
+}
+
+func NewLimitRepository(db *sqlx.DB) repository.LimitRepository {
+	return &LimitRepository{
+		db: db,
+	}
+}
+
+func (r *LimitRepository) Usage(ctx context.Context, userID, drawID int32, overdueSince time.Time) (entity.BookingUsage, error) {
+	return bookingUsage(ctx, r.db, userID, drawID, overdueSince)
+}
+
+func bookingUsage(ctx context.Context, q sqlx.QueryerContext, userID, drawID int32, overdueSince time.Time) (entity.BookingUsage, error) {
+	const query = `
+        SELECT
+            count(*) FILTER (WHERE status = 'HELD'),
+            count(*) FILTER (WHERE status IN ('PAID', 'WIN', 'LOSE')),
+            (SELECT count(*)
+             FROM ticket.invoice_overdues
+             WHERE user_id = $1 AND draw_id = $2 AND created_at >= $3)
+        FROM ticket.tickets
+        WHERE user_id = $1 AND draw_id = $2
+    `
+	var u entity.BookingUsage
+	if err := q.QueryRowxContext(ctx, query, userID, drawID, overdueSince).Scan(&u.Held, &u.Purchased, &u.Overdues); err != nil {
+		return u, fmt.Errorf("query booking usage: %w", err)
+	}
+	return u, nil
+}
+
+func (r *LimitRepository) RecordOverdue(ctx context.Context, userID int32, invoiceID int64, ticketIDs []int32) error {
+	if len(ticketIDs) == 0 {
+		return nil
+	}
+	const query = `
+        INSERT INTO ticket.invoice_overdues (invoice_id, draw_id, user_id)
+        SELECT DISTINCT $1::bigint, draw_id, $2::int
+        FROM ticket.tickets
+        WHERE ticket_id = ANY($3::int[])
+        ON CONFLICT DO NOTHING
+    `
+	if _, err := r.db.ExecContext(ctx, query, invoiceID, userID, formatIDsArray(ticketIDs)); err != nil {
+		return fmt.Errorf("record overdue: %w", err)
+	}
+	return nil
+}
@@ -5,11 +5,12 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strconv"
-	"strings"
+	"time"
 
 	"github.com/MaxFando/lms/ticket-service/internal/entity"
 	"github.com/MaxFando/lms/ticket-service/internal/repository"
+	"github.com/MaxFando/lms/ticket-service/pkg/lottery"
+	"github.com/MaxFando/lms/ticket-service/pkg/serial"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -23,73 +24,32 @@ func NewTicketRepository(db *sqlx.DB) repository.TicketRepository {
 	}
 }
 
-func (r *TicketRepository) parseNumbersArray(arr string) []string {
-	arr = strings.Trim(arr, "{}")
-	if arr == "" {
-		return []string{}
-	}
-	parts := strings.Split(arr, ",")
-	for i := range parts {
-		parts[i] = strings.Trim(parts[i], `"`)
-	}
-	return parts
-}
-
-func (r *TicketRepository) formatNumbersArray(nums []string) string {
-	quoted := make([]string, len(nums))
-	for i, s := range nums {
-		quoted[i] = `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
-	}
-	return "{" + strings.Join(quoted, ",") + "}"
-}
-
 func (r *TicketRepository) GetByID(ctx context.Context, id int32) (*entity.Ticket, error) {
-	const query = `
-        SELECT ticket_id, user_id, draw_id, numbers, status, created_at
-        FROM ticket.tickets
-        WHERE ticket_id = $1
-    `
-	var (
-		t       entity.Ticket
-		userID  sql.NullInt32
-		numsArr string
-		status  string
-	)
-	row := r.db.QueryRowxContext(ctx, query, id)
-	if err := row.Scan(
-		&t.ID,
-		&userID,
-		&t.DrawID,
-		&numsArr,
-		&status,
-		&t.CreatedAt,
-	); err != nil {
+	const query = `SELECT ` + ticketColumns + ` FROM ticket.tickets WHERE ticket_id = $1`
+
+	t, err := scanTicket(r.db.QueryRowxContext(ctx, query, id))
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.New("ticket not found")
+			return nil, fmt.Errorf("ticket %d: %w", id, repository.ErrNotFound)
 		}
 		return nil, fmt.Errorf("scan ticket: %w", err)
 	}
-	if userID.Valid {
-		uid := userID.Int32
-		t.UserID = &uid
-	}
-	t.Numbers = r.parseNumbersArray(numsArr)
-	t.Status = entity.Status(status)
-	return &t, nil
+	return t, nil
 }
 
 func (r *TicketRepository) Create(ctx context.Context, t *entity.Ticket) (*entity.Ticket, error) {
 	const query = `
-        INSERT INTO ticket.tickets (user_id, draw_id, numbers, status)
-        VALUES ($1, $2, $3::text[], $4)
+        INSERT INTO ticket.tickets (user_id, draw_id, numbers, status, held_until)
+        VALUES ($1, $2, $3::text[], $4, $5)
         RETURNING ticket_id, created_at
     `
-	numsLiteral := r.formatNumbersArray(t.Numbers)
+	numsLiteral := formatNumbersArray(t.Numbers)
 	row := r.db.QueryRowxContext(ctx, query,
 		t.UserID,
 		t.DrawID,
 		numsLiteral,
 		string(t.Status),
+		t.HeldUntil,
 	)
 	if err := row.Scan(&t.ID, &t.CreatedAt); err != nil {
 		return nil, fmt.Errorf("insert ticket: %w", err)
@@ -97,46 +57,30 @@ func (r *TicketRepository) Create(ctx context.Context, t *entity.Ticket) (*entit
 	return t, nil
 }
 
-func (r *TicketRepository) UpdateStatus(ctx context.Context, id int32, status entity.Status) (*entity.Ticket, error) {
-	const query = `
-        UPDATE ticket.tickets
-        SET status = $1
-        WHERE ticket_id = $2
-        RETURNING ticket_id, user_id, draw_id, numbers, status, created_at
-    `
-	var t entity.Ticket
-	var numsArr string
-	var st string
-	row := r.db.QueryRowxContext(ctx, query, string(status), id)
-	if err := row.Scan(
-		&t.ID,
-		&t.UserID,
-		&t.DrawID,
-		&numsArr,
-		&st,
-		&t.CreatedAt,
-	); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.New("ticket not found")
-		}
-		return nil, fmt.Errorf("update status: %w", err)
-	}
-	t.Numbers = r.parseNumbersArray(numsArr)
-	t.Status = entity.Status(st)
-	return &t, nil
-}
+func (r *TicketRepository) ListByUser(ctx context.Context, userID int32, f entity.TicketFilter) ([]*entity.TicketWithDraw, error) {
+	// свои билеты и билеты синдикатов пользователя выбираются отдельными ветками, каждая по своему индексу;
+	// билет синдиката, купленный самим пользователем, попадает только в первую ветку
+	q := &ticketQuery{}
+	q.add("t.user_id = $%d", userID)
+	q.apply(f)
+	owned := q.tail(f)
+	q.conds[0] = "m.user_id = $1 AND m.status = 'ACTIVE' AND t.user_id IS DISTINCT FROM $1"
+	shared := q.tail(f)
+	q.conds = nil
 
-func (r *TicketRepository) ListByUser(ctx context.Context, userID int32) ([]*entity.TicketWithDraw, error) {
-	const query = `
-        SELECT
-          t.ticket_id, t.user_id, t.draw_id, t.numbers, t.status, t.created_at,
-          d.id, d.lottery_type, d.status, d.start_time, d.end_time AS draw_status
-        FROM ticket.tickets t
-        JOIN draw.draws d ON d.id = t.draw_id
-        WHERE t.user_id = $1
-        ORDER BY t.created_at DESC
-    `
-	rows, err := r.db.QueryxContext(ctx, query, userID)
+	query := `SELECT ` + ticketWithDrawColumns + `
+        FROM (
+            (SELECT t.ticket_id FROM ticket.tickets t` + owned + `)
+            UNION ALL
+            (SELECT t.ticket_id
+             FROM ticket.syndicate_members m
+             JOIN ticket.syndicate_tickets st ON st.syndicate_id = m.syndicate_id
+             JOIN ticket.tickets t ON t.ticket_id = st.ticket_id` + shared + `)
+        ) own
+        JOIN ticket.tickets t ON t.ticket_id = own.ticket_id
+        JOIN draw.draws d ON d.id = t.draw_id` + q.tail(f)
+
+	rows, err := r.db.QueryxContext(ctx, query, q.args...)
 	if err != nil {
 		return nil, fmt.Errorf("query tickets: %w", err)
 	}
@@ -144,30 +88,32 @@ func (r *TicketRepository) ListByUser(ctx context.Context, userID int32) ([]*ent
 
 	var out []*entity.TicketWithDraw
 	for rows.Next() {
-		var (
-			t       entity.TicketWithDraw
-			uID     sql.NullInt32
-			numsArr string
-			st      string
-		)
-		if err := rows.Scan(
-			&t.ID, &uID, &t.DrawID, &numsArr, &st, &t.CreatedAt,
-			&t.Draw.ID, &t.Draw.LotteryType, &t.Draw.Status, &t.Draw.StartTime, &t.Draw.EndTime,
-		); err != nil {
+		t, err := scanTicketWithDraw(rows)
+		if err != nil {
 			return nil, fmt.Errorf("scan ticket: %w", err)
 		}
-		if uID.Valid {
-			u := uID.Int32
-			t.UserID = &u
-		}
-		t.Numbers = r.parseNumbersArray(numsArr)
-		t.Status = entity.Status(st)
-		out = append(out, &t)
+		out = append(out, t)
 	}
 
 	return out, rows.Err()
 }
 
+func (r *TicketRepository) GetBySerial(ctx context.Context, code string) (*entity.TicketWithDraw, error) {
+	const query = `SELECT ` + ticketWithDrawColumns + `
+        FROM ticket.tickets t
+        JOIN draw.draws d ON d.id = t.draw_id
+        WHERE t.serial = $1`
+
+	t, err := scanTicketWithDraw(r.db.QueryRowxContext(ctx, query, code))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("ticket %s: %w", code, repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("scan ticket: %w", err)
+	}
+	return t, nil
+}
+
 func (r *TicketRepository) IsDrawActive(ctx context.Context, drawID int32) (bool, error) {
 	const query = `
         SELECT status
@@ -195,147 +141,247 @@ func (r *TicketRepository) GetDrawLotteryType(ctx context.Context, drawID int32)
 		return 0, 0, fmt.Errorf("scan draw config: %w", err)
 	}
 
-	parts := strings.Fields(lt)
-	if len(parts) != 3 || parts[1] != "from" {
-		return 0, 0, fmt.Errorf("invalid draw config format: %q", lt)
-	}
-	if count, err = strconv.Atoi(parts[0]); err != nil {
-		return 0, 0, fmt.Errorf("parse count: %w", err)
-	}
-	if maxNum, err = strconv.Atoi(parts[2]); err != nil {
-		return 0, 0, fmt.Errorf("parse max: %w", err)
+	if count, maxNum, err = lottery.ParseType(lt); err != nil {
+		return 0, 0, fmt.Errorf("parse draw config: %w", err)
 	}
 	return count, maxNum, nil
 }
 
-func (r *TicketRepository) BookTicket(ctx context.Context, ticketID, userID int32) (*entity.Ticket, error) {
-	const q = `
-        UPDATE ticket.tickets
-        SET user_id = $1
-        WHERE ticket_id = $2
-          AND user_id IS NULL
-        RETURNING ticket_id, user_id, draw_id, numbers, status, created_at
+func (r *TicketRepository) Hold(ctx context.Context, ticketID, userID int32, heldUntil time.Time) (*entity.Ticket, error) {
+	return holdTicket(ctx, r.db, ticketID, userID, heldUntil)
+}
+
+func (r *TicketRepository) HoldAny(ctx context.Context, drawID, userID int32, preferred []string, heldUntil time.Time) (*entity.Ticket, error) {
+	const query = `
+        WITH picked AS (
+            SELECT t.ticket_id
+            FROM ticket.tickets t
+            JOIN draw.draws d ON d.id = t.draw_id
+            WHERE t.draw_id = $1
+              AND d.status = 'ACTIVE'
+              AND t.status = 'AVAILABLE'
+            ORDER BY (SELECT count(*) FROM unnest(t.numbers) n WHERE n = ANY($4::text[])) DESC, random()
+            LIMIT 1
+            FOR UPDATE OF t SKIP LOCKED
+        )
+        UPDATE ticket.tickets t
+        SET user_id = $2, status = 'HELD', held_until = $3, hold_extensions = 0
+        FROM picked p
+        WHERE t.ticket_id = p.ticket_id
+        RETURNING ` + qualifiedTicketColumns + `
     `
-	var (
-		t       entity.Ticket
-		uID     sql.NullInt32
-		numsArr string
-		st      string
-	)
-	row := r.db.QueryRowxContext(ctx, q, userID, ticketID)
-	if err := row.Scan(&t.ID, &uID, &t.DrawID, &numsArr, &st, &t.CreatedAt); err != nil {
+	t, err := scanTicket(r.db.QueryRowxContext(ctx, query, drawID, userID, heldUntil, formatNumbersArray(preferred)))
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, fmt.Errorf("ticket %d is already booked or not found", ticketID)
+			return nil, fmt.Errorf("draw %d: %w", drawID, repository.ErrNoFreeTickets)
 		}
-		return nil, fmt.Errorf("book ticket: %w", err)
+		return nil, fmt.Errorf("hold any ticket: %w", err)
+	}
+	return t, nil
+}
+
+func (r *TicketRepository) ExtendHold(ctx context.Context, ticketID, userID int32, heldUntil time.Time) (*entity.Ticket, error) {
+	const query = `
+        UPDATE ticket.tickets
+        SET held_until = $3, hold_extensions = hold_extensions + 1
+        WHERE ticket_id = $1 AND user_id = $2 AND status = 'HELD' AND held_until IS NOT NULL
+          AND hold_extensions < $4
+        RETURNING ` + ticketColumns
+
+	t, err := scanTicket(r.db.QueryRowxContext(ctx, query, ticketID, userID, heldUntil, entity.MaxHoldExtensions))
+	if err == nil {
+		return t, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("extend hold: %w", err)
+	}
+
+	current, err := r.GetByID(ctx, ticketID)
+	if err != nil {
+		return nil, err
 	}
-	if uID.Valid {
-		u := uID.Int32
-		t.UserID = &u
+	if current.UserID == nil || *current.UserID != userID {
+		return nil, fmt.Errorf("ticket %d: %w", ticketID, repository.ErrNotTicketOwner)
 	}
-	t.Numbers = r.parseNumbersArray(numsArr)
-	t.Status = entity.Status(st)
-	return &t, nil
+	if current.Status == entity.StatusHeld && current.HeldUntil != nil {
+		return nil, fmt.Errorf("ticket %d: %w", ticketID, repository.ErrHoldLimit)
+	}
+	return nil, fmt.Errorf("ticket %d is %s without expiring hold: %w", ticketID, current.Status, repository.ErrInvalidTransition)
 }
 
-func (r *TicketRepository) ClearBooking(ctx context.Context, ticketID int32) error {
-	const q = `
+func (r *TicketRepository) PinHolds(ctx context.Context, ticketIDs []int32) error {
+	if len(ticketIDs) == 0 {
+		return nil
+	}
+	const query = `
         UPDATE ticket.tickets
-        SET user_id = NULL, status = 'PENDING'
-        WHERE ticket_id = $1
+        SET held_until = NULL
+        WHERE ticket_id = ANY($1::int[]) AND status = 'HELD'
     `
-	if _, err := r.db.ExecContext(ctx, q, ticketID); err != nil {
-		return fmt.Errorf("clear booking: %w", err)
+	if _, err := r.db.ExecContext(ctx, query, formatIDsArray(ticketIDs)); err != nil {
+		return fmt.Errorf("pin holds: %w", err)
 	}
 	return nil
 }
 
-func (r *TicketRepository) ListFreeByActiveDraw(ctx context.Context) ([]*entity.Ticket, error) {
+func (r *TicketRepository) ReleaseHolds(ctx context.Context, ticketIDs []int32) error {
+	if len(ticketIDs) == 0 {
+		return nil
+	}
 	const query = `
-        SELECT
-            t.ticket_id, t.user_id, t.draw_id, t.numbers, t.status, t.created_at
-        FROM ticket.tickets t
-        JOIN draw.draws d ON d.id = t.draw_id
-        WHERE d.status = 'ACTIVE' AND t.user_id IS NULL
-        ORDER BY t.created_at DESC
+        UPDATE ticket.tickets
+        SET user_id = NULL, status = 'AVAILABLE', held_until = NULL
+        WHERE ticket_id = ANY($1::int[]) AND status = 'HELD'
     `
-	rows, err := r.db.QueryxContext(ctx, query)
+	if _, err := r.db.ExecContext(ctx, query, formatIDsArray(ticketIDs)); err != nil {
+		return fmt.Errorf("release holds: %w", err)
+	}
+	return nil
+}
+
+func (r *TicketRepository) ExpireHolds(ctx context.Context, now time.Time) ([]int32, error) {
+	const query = `
+        WITH expired AS (
+            UPDATE ticket.tickets
+            SET user_id = NULL, status = 'AVAILABLE', held_until = NULL
+            WHERE status = 'HELD' AND held_until < $1
+            RETURNING ticket_id
+        ), dropped AS (
+            DELETE FROM ticket.cart_items ci
+            USING ticket.carts c, expired e
+            WHERE ci.cart_id = c.cart_id AND c.status = 'OPEN' AND ci.ticket_id = e.ticket_id
+        )
+        SELECT ticket_id FROM expired
+    `
+	var ids []int32
+	if err := r.db.SelectContext(ctx, &ids, query, now); err != nil {
+		return nil, fmt.Errorf("expire holds: %w", err)
+	}
+	return ids, nil
+}
+
+func (r *TicketRepository) ListAvailable(ctx context.Context, f entity.TicketFilter) ([]*entity.Ticket, error) {
+	q := &ticketQuery{conds: []string{"d.status = 'ACTIVE'", "t.status = 'AVAILABLE'"}}
+	q.apply(f)
+
+	query := `
+        SELECT ` + qualifiedTicketColumns + `
+        FROM ticket.tickets t
+        JOIN draw.draws d ON d.id = t.draw_id` + q.tail(f)
+
+	rows, err := r.db.QueryxContext(ctx, query, q.args...)
 	if err != nil {
 		return nil, fmt.Errorf("query free tickets: %w", err)
 	}
-	defer rows.Close()
+	return collectTickets(rows)
+}
 
-	var tickets []*entity.Ticket
-	for rows.Next() {
-		var (
-			t       entity.Ticket
-			uID     sql.NullInt32
-			numsArr string
-			st      string
-		)
-		if err := rows.Scan(
-			&t.ID, &uID, &t.DrawID, &numsArr, &st, &t.CreatedAt,
-		); err != nil {
-			return nil, fmt.Errorf("scan ticket: %w", err)
-		}
-		if uID.Valid {
-			u := uID.Int32
-			t.UserID = &u
-		}
-		t.Numbers = r.parseNumbersArray(numsArr)
-		t.Status = entity.Status(st)
-		tickets = append(tickets, &t)
+func (r *TicketRepository) Transition(ctx context.Context, ids []int32, to entity.Status) ([]*entity.Ticket, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	const query = `
+        UPDATE ticket.tickets
+        SET status = $1, held_until = NULL
+        WHERE ticket_id = ANY($2::int[])
+          AND status::text = ANY($3::text[])
+        RETURNING ` + ticketColumns
+
+	rows, err := r.db.QueryxContext(ctx, query, string(to), formatIDsArray(ids), formatStatusesArray(entity.TransitionSources(to)))
+	if err != nil {
+		return nil, fmt.Errorf("exec transition: %w", err)
 	}
-	return tickets, rows.Err()
+	return collectTickets(rows)
 }
 
-func (r *TicketRepository) BulkUpdateStatus(ctx context.Context, ids []int32, status entity.Status) ([]*entity.Ticket, error) {
-	if len(ids) == 0 {
+func (r *TicketRepository) ConfirmPaid(ctx context.Context, userID int32, ticketIDs []int32) ([]*entity.Ticket, error) {
+	if len(ticketIDs) == 0 {
 		return nil, nil
 	}
-	placeholders := make([]string, len(ids))
-	args := make([]interface{}, len(ids)+1)
-	args[0] = string(status)
-	for i, id := range ids {
-		placeholders[i] = fmt.Sprintf("$%d", i+2)
-		args[i+1] = id
+	const query = `
+        UPDATE ticket.tickets t
+        SET status = 'PAID', held_until = NULL, serial = s.serial
+        FROM unnest($1::int[], $3::text[]) AS s(ticket_id, serial)
+        WHERE t.ticket_id = s.ticket_id AND t.user_id = $2 AND t.status = 'HELD'
+        RETURNING ` + qualifiedTicketColumns
+
+	// серийный номер выдаётся только при оплате: до продажи его не увидит никто, кроме покупателя
+	serials := make([]string, len(ticketIDs))
+	for i := range serials {
+		code, err := serial.New()
+		if err != nil {
+			return nil, fmt.Errorf("ticket serial: %w", err)
+		}
+		serials[i] = code
 	}
-	query := fmt.Sprintf(
-		`UPDATE ticket.tickets
-         SET status = $1
-         WHERE ticket_id IN (%s)
-         RETURNING ticket_id, user_id, draw_id, numbers, status, created_at`,
-		strings.Join(placeholders, ","),
-	)
-	rows, err := r.db.QueryxContext(ctx, query, args...)
+
+	rows, err := r.db.QueryxContext(ctx, query, formatIDsArray(ticketIDs), userID, formatNumbersArray(serials))
 	if err != nil {
-		return nil, fmt.Errorf("exec bulk update: %w", err)
+		return nil, fmt.Errorf("confirm paid: %w", err)
 	}
+	return collectTickets(rows)
+}
+
+func (r *TicketRepository) CancelDraw(ctx context.Context, drawID int32) ([]*entity.Ticket, error) {
+	const query = `
+        WITH cancelled AS (
+            UPDATE ticket.tickets
+            SET status = 'CANCELLED', held_until = NULL
+            WHERE draw_id = $1
+              AND status::text = ANY($2::text[])
+            RETURNING ` + ticketColumns + `
+        ), dropped AS (
+            DELETE FROM ticket.cart_items ci
+            USING ticket.carts c, cancelled t
+            WHERE ci.cart_id = c.cart_id AND c.status = 'OPEN' AND ci.ticket_id = t.ticket_id
+        )
+        SELECT ` + ticketColumns + ` FROM cancelled
+    `
+	rows, err := r.db.QueryxContext(ctx, query, drawID, formatStatusesArray(entity.TransitionSources(entity.StatusCancelled)))
+	if err != nil {
+		return nil, fmt.Errorf("cancel draw tickets: %w", err)
+	}
+	return collectTickets(rows)
+}
+
+// holdTicket бронирует свободный билет активного тиража; бронь с ограниченным сроком того же пользователя
+// продлевается, пока не исчерпан лимит продлений
+func holdTicket(ctx context.Context, q sqlx.QueryerContext, ticketID, userID int32, heldUntil time.Time) (*entity.Ticket, error) {
+	const query = `
+        UPDATE ticket.tickets t
+        SET user_id = $2, status = 'HELD', held_until = $3,
+            hold_extensions = CASE WHEN t.status = 'AVAILABLE' THEN 0 ELSE t.hold_extensions + 1 END
+        FROM draw.draws d
+        WHERE t.ticket_id = $1
+          AND d.id = t.draw_id
+          AND d.status = 'ACTIVE'
+          AND (t.status = 'AVAILABLE' OR (t.status = 'HELD' AND t.user_id = $2 AND t.held_until IS NOT NULL
+                                          AND t.hold_extensions < $4))
+        RETURNING ` + qualifiedTicketColumns + `
+    `
+	t, err := scanTicket(q.QueryRowxContext(ctx, query, ticketID, userID, heldUntil, entity.MaxHoldExtensions))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("ticket %d: %w", ticketID, repository.ErrTicketUnavailable)
+		}
+		return nil, fmt.Errorf("hold ticket: %w", err)
+	}
+	return t, nil
+}
+
+func collectTickets(rows *sqlx.Rows) ([]*entity.Ticket, error) {
 	defer rows.Close()
 
 	var tickets []*entity.Ticket
 	for rows.Next() {
-		var (
-			t       entity.Ticket
-			uID     sql.NullInt64
-			numsArr string
-			st      string
-		)
-		if err := rows.Scan(
-			&t.ID, &uID, &t.DrawID, &numsArr, &st, &t.CreatedAt,
-		); err != nil {
-			return nil, fmt.Errorf("scan updated ticket: %w", err)
-		}
-		if uID.Valid {
-			u := int32(uID.Int64)
-			t.UserID = &u
+		t, err := scanTicket(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan ticket: %w", err)
 		}
-		t.Numbers = r.parseNumbersArray(numsArr)
-		t.Status = entity.Status(st)
-		tickets = append(tickets, &t)
+		tickets = append(tickets, t)
 	}
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("iterate updated tickets: %w", err)
+		return nil, fmt.Errorf("iterate tickets: %w", err)
 	}
 	return tickets, nil
 }
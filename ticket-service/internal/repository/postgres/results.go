@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+	"github.com/MaxFando/lms/ticket-service/internal/repository"
+)
+
+func (r *TicketRepository) GetDraw(ctx context.Context, drawID int32) (*entity.Draw, error) {
+	const query = `SELECT id, lottery_type, status, start_time, end_time FROM draw.draws WHERE id = $1`
+
+	var d entity.Draw
+	if err := r.db.QueryRowxContext(ctx, query, drawID).Scan(&d.ID, &d.LotteryType, &d.Status, &d.StartTime, &d.EndTime); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("draw %d: %w", drawID, repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("scan draw: %w", err)
+	}
+	return &d, nil
+}
+
+func (r *TicketRepository) CountSold(ctx context.Context, drawID int32) (int, error) {
+	const query = `
+        SELECT count(*)
+        FROM ticket.tickets
+        WHERE draw_id = $1 AND status IN ('PAID', 'WIN', 'LOSE')
+    `
+	var sold int
+	if err := r.db.QueryRowxContext(ctx, query, drawID).Scan(&sold); err != nil {
+		return 0, fmt.Errorf("count sold tickets: %w", err)
+	}
+	return sold, nil
+}
+
+func (r *TicketRepository) ListWinners(ctx context.Context, drawID int32) ([]*entity.Winner, error) {
+	const query = `
+        WITH result AS (
+            SELECT string_to_array(replace(winning_combination, ' ', ''), ',')::int[] AS nums
+            FROM draw.draw_results
+            WHERE draw_id = $1
+            ORDER BY result_time DESC
+            LIMIT 1
+        ), winners AS (
+            SELECT t.ticket_id, t.user_id, t.serial, t.numbers,
+                   (SELECT count(*) FROM unnest(t.numbers) n WHERE n::int = ANY(r.nums))::int AS matched
+            FROM ticket.tickets t
+            CROSS JOIN result r
+            WHERE t.draw_id = $1 AND t.status = 'WIN'
+        )
+        SELECT w.ticket_id, w.user_id, w.serial, w.numbers, w.matched, COALESCE(p.amount, 0), st.syndicate_id
+        FROM winners w
+        JOIN draw.draws d ON d.id = $1
+        LEFT JOIN ticket.syndicate_tickets st ON st.ticket_id = w.ticket_id
+        LEFT JOIN ticket.prize_tiers p ON p.lottery_type = d.lottery_type AND p.matched = w.matched
+        ORDER BY w.matched DESC, w.ticket_id
+    `
+	rows, err := r.db.QueryxContext(ctx, query, drawID)
+	if err != nil {
+		return nil, fmt.Errorf("query winners: %w", err)
+	}
+	defer rows.Close()
+
+	var out []*entity.Winner
+	for rows.Next() {
+		var (
+			w           entity.Winner
+			uID         sql.NullInt32
+			syndicateID sql.NullInt32
+			numsArr     string
+		)
+		if err := rows.Scan(&w.TicketID, &uID, &w.Serial, &numsArr, &w.Matched, &w.Prize, &syndicateID); err != nil {
+			return nil, fmt.Errorf("scan winner: %w", err)
+		}
+		if uID.Valid {
+			u := uID.Int32
+			w.UserID = &u
+		}
+		if syndicateID.Valid {
+			id := syndicateID.Int32
+			w.SyndicateID = &id
+		}
+		w.DrawID = drawID
+		w.Numbers = parseNumbersArray(numsArr)
+		out = append(out, &w)
+	}
+	return out, rows.Err()
+}
+
+func (r *TicketRepository) ListPrizes(ctx context.Context, ticketIDs []int32) ([]*entity.Winner, error) {
+	const query = `
+        SELECT t.ticket_id, t.draw_id, t.user_id, t.serial, t.numbers, m.matched, COALESCE(p.amount, 0)
+        FROM ticket.tickets t
+        JOIN draw.draws d ON d.id = t.draw_id
+        CROSS JOIN LATERAL (
+            SELECT string_to_array(replace(winning_combination, ' ', ''), ',')::int[] AS nums
+            FROM draw.draw_results
+            WHERE draw_id = t.draw_id
+            ORDER BY result_time DESC
+            LIMIT 1
+        ) res
+        CROSS JOIN LATERAL (
+            SELECT count(*)::int AS matched FROM unnest(t.numbers) n WHERE n::int = ANY(res.nums)
+        ) m
+        LEFT JOIN ticket.prize_tiers p ON p.lottery_type = d.lottery_type AND p.matched = m.matched
+        WHERE t.ticket_id = ANY($1::int[]) AND t.status = 'WIN'
+          AND NOT EXISTS (SELECT 1 FROM ticket.syndicate_tickets st WHERE st.ticket_id = t.ticket_id)
+        ORDER BY t.ticket_id
+    `
+	rows, err := r.db.QueryxContext(ctx, query, formatIDsArray(ticketIDs))
+	if err != nil {
+		return nil, fmt.Errorf("query prizes: %w", err)
+	}
+	defer rows.Close()
+
+	var out []*entity.Winner
+	for rows.Next() {
+		var (
+			w       entity.Winner
+			uID     sql.NullInt32
+			numsArr string
+		)
+		if err := rows.Scan(&w.TicketID, &w.DrawID, &uID, &w.Serial, &numsArr, &w.Matched, &w.Prize); err != nil {
+			return nil, fmt.Errorf("scan prize: %w", err)
+		}
+		if uID.Valid {
+			u := uID.Int32
+			w.UserID = &u
+		}
+		w.Numbers = parseNumbersArray(numsArr)
+		out = append(out, &w)
+	}
+	return out, rows.Err()
+}
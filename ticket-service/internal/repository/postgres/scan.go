@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"database/sql"
+	"strconv"
+	"strings"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+)
+
+const ticketColumns = `ticket_id, user_id, draw_id, numbers, status, held_until, created_at, serial`
+
+// qualifiedTicketColumns - ticketColumns для запросов, где таблица билетов идёт под алиасом t
+const qualifiedTicketColumns = `t.ticket_id, t.user_id, t.draw_id, t.numbers, t.status, t.held_until, t.created_at, t.serial`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTicket(row rowScanner) (*entity.Ticket, error) {
+	var (
+		t         entity.Ticket
+		uID       sql.NullInt32
+		numsArr   string
+		st        string
+		heldUntil sql.NullTime
+		code      sql.NullString
+	)
+	if err := row.Scan(&t.ID, &uID, &t.DrawID, &numsArr, &st, &heldUntil, &t.CreatedAt, &code); err != nil {
+		return nil, err
+	}
+	if uID.Valid {
+		u := uID.Int32
+		t.UserID = &u
+	}
+	if heldUntil.Valid {
+		t.HeldUntil = &heldUntil.Time
+	}
+	t.Serial = code.String
+	t.Numbers = parseNumbersArray(numsArr)
+	t.Status = entity.Status(st)
+	return &t, nil
+}
+
+const ticketWithDrawColumns = qualifiedTicketColumns + `, d.id, d.lottery_type, d.status, d.start_time, d.end_time`
+
+func scanTicketWithDraw(row rowScanner) (*entity.TicketWithDraw, error) {
+	var (
+		t         entity.TicketWithDraw
+		uID       sql.NullInt32
+		numsArr   string
+		st        string
+		heldUntil sql.NullTime
+		code      sql.NullString
+	)
+	if err := row.Scan(
+		&t.ID, &uID, &t.DrawID, &numsArr, &st, &heldUntil, &t.CreatedAt, &code,
+		&t.Draw.ID, &t.Draw.LotteryType, &t.Draw.Status, &t.Draw.StartTime, &t.Draw.EndTime,
+	); err != nil {
+		return nil, err
+	}
+	if uID.Valid {
+		u := uID.Int32
+		t.UserID = &u
+	}
+	if heldUntil.Valid {
+		t.HeldUntil = &heldUntil.Time
+	}
+	t.Serial = code.String
+	t.Numbers = parseNumbersArray(numsArr)
+	t.Status = entity.Status(st)
+	return &t, nil
+}
+
+func parseNumbersArray(arr string) []string {
+	arr = strings.Trim(arr, "{}")
+	if arr == "" {
+		return []string{}
+	}
+	parts := strings.Split(arr, ",")
+	for i := range parts {
+		parts[i] = strings.Trim(parts[i], `"`)
+	}
+	return parts
+}
+
+func formatNumbersArray(nums []string) string {
+	quoted := make([]string, len(nums))
+	for i, s := range nums {
+		quoted[i] = `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+	}
+	return "{" + strings.Join(quoted, ",") + "}"
+}
+
+func formatIntsArray(nums []int) string {
+	parts := make([]string, len(nums))
+	for i, n := range nums {
+		parts[i] = strconv.Itoa(n)
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+func formatIDsArray(ids []int32) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.Itoa(int(id))
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+func formatStatusesArray(statuses []entity.Status) string {
+	parts := make([]string, len(statuses))
+	for i, st := range statuses {
+		parts[i] = string(st)
+	}
+	return formatNumbersArray(parts)
+}
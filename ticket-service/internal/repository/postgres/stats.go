@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+	"github.com/MaxFando/lms/ticket-service/internal/repository"
+	"github.com/jmoiron/sqlx"
+)
+
+type StatsRepository struct {
+	db *sqlx.DB
+}
+
+func NewStatsRepository(db *sqlx.DB) repository.StatsRepository {
+	return &StatsRepository{
+		db: db,
+	}
+}
+
+func (r *StatsRepository) ListUnrecorded(ctx context.Context, limit int) ([]*entity.CompletedDraw, error) {
+	const query = `
+        SELECT d.id, d.lottery_type, r.result_time, r.winning_combination
+        FROM draw.draws d
+        JOIN LATERAL (
+            SELECT winning_combination, result_time
+            FROM draw.draw_results
+            WHERE draw_id = d.id
+            ORDER BY result_time DESC
+            LIMIT 1
+        ) r ON TRUE
+        WHERE d.status = 'COMPLETED'
+          AND NOT EXISTS (SELECT 1 FROM ticket.number_stats_draws s WHERE s.draw_id = d.id)
+        ORDER BY r.result_time, d.id
+        LIMIT $1
+    `
+	rows, err := r.db.QueryxContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query unrecorded draws: %w", err)
+	}
+	defer rows.Close()
+
+	var out []*entity.CompletedDraw
+	for rows.Next() {
+		var d entity.CompletedDraw
+		if err := rows.Scan(&d.ID, &d.LotteryType, &d.DrawnAt, &d.WinningCombination); err != nil {
+			return nil, fmt.Errorf("scan draw: %w", err)
+		}
+		out = append(out, &d)
+	}
+	return out, rows.Err()
+}
+
+func (r *StatsRepository) RecordDraw(ctx context.Context, d *entity.CompletedDraw, maxNum int, winning []int) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin: %w", err)
+	}
+	defer tx.Rollback()
+
+	const drawQuery = `
+        INSERT INTO ticket.number_stats_draws (draw_id, lottery_type, drawn_at)
+        VALUES ($1, $2, $3)
+        ON CONFLICT DO NOTHING
+    `
+	res, err := tx.ExecContext(ctx, drawQuery, d.ID, d.LotteryType, d.DrawnAt)
+	if err != nil {
+		return fmt.Errorf("insert stats draw: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil
+	}
+
+	const numbersQuery = `
+        INSERT INTO ticket.number_stats (draw_id, number, drawn, picks)
+        SELECT $1, n, n = ANY($3::int[]), COALESCE(p.picks, 0)
+        FROM generate_series(1, $2::int) n
+        LEFT JOIN (
+            SELECT num::int AS number, count(*) AS picks
+            FROM ticket.tickets t, unnest(t.numbers) num
+            WHERE t.draw_id = $1 AND t.status IN ('PAID', 'WIN', 'LOSE')
+            GROUP BY 1
+        ) p ON p.number = n
+    `
+	if _, err := tx.ExecContext(ctx, numbersQuery, d.ID, maxNum, formatIntsArray(winning)); err != nil {
+		return fmt.Errorf("insert number stats: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+	return nil
+}
+
+func (r *StatsRepository) ListNumberStats(ctx context.Context, lotteryType string, from, to time.Time) ([]entity.DrawNumberStat, error) {
+	const query = `
+        SELECT s.draw_id, s.number, s.drawn, s.picks
+        FROM ticket.number_stats_draws d
+        JOIN ticket.number_stats s ON s.draw_id = d.draw_id
+        WHERE d.lottery_type = $1 AND d.drawn_at >= $2 AND d.drawn_at < $3
+        ORDER BY d.drawn_at, d.draw_id, s.number
+    `
+	var out []entity.DrawNumberStat
+	rows, err := r.db.QueryxContext(ctx, query, lotteryType, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("query number stats: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var s entity.DrawNumberStat
+		if err := rows.Scan(&s.DrawID, &s.Number, &s.Drawn, &s.Picks); err != nil {
+			return nil, fmt.Errorf("scan number stat: %w", err)
+		}
+		out = append(out, s)
+	}
+	return out, rows.Err()
+}
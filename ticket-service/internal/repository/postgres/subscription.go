@@ -0,0 +1,242 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+	"github.com/MaxFando/lms/ticket-service/internal/repository"
+	"github.com/jmoiron/sqlx"
+)
+
+const subscriptionColumns = `subscription_id, user_id, lottery_type, numbers, draws_total, draws_remaining, status, created_at, updated_at`
+
+type SubscriptionRepository struct {
+	db *sqlx.DB
+}
+
+func NewSubscriptionRepository(db *sqlx.DB) repository.SubscriptionRepository {
+	return &SubscriptionRepository{
+		db: db,
+	}
+}
+
+func scanSubscription(row rowScanner) (*entity.Subscription, error) {
+	var (
+		s       entity.Subscription
+		numsArr string
+		st      string
+	)
+	if err := row.Scan(
+		&s.ID, &s.UserID, &s.LotteryType, &numsArr, &s.DrawsTotal, &s.DrawsRemaining, &st, &s.CreatedAt, &s.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	s.Numbers = parseNumbersArray(numsArr)
+	s.Status = entity.SubscriptionStatus(st)
+	return &s, nil
+}
+
+func (r *SubscriptionRepository) Create(ctx context.Context, s *entity.Subscription) (*entity.Subscription, error) {
+	const query = `
+        INSERT INTO ticket.subscriptions (user_id, lottery_type, numbers, draws_total, draws_remaining, status)
+        VALUES ($1, $2, $3::text[], $4, $4, $5)
+        RETURNING ` + subscriptionColumns
+
+	created, err := scanSubscription(r.db.QueryRowxContext(ctx, query,
+		s.UserID,
+		s.LotteryType,
+		formatNumbersArray(s.Numbers),
+		s.DrawsTotal,
+		string(entity.SubscriptionStatusActive),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("insert subscription: %w", err)
+	}
+	return created, nil
+}
+
+func (r *SubscriptionRepository) GetByID(ctx context.Context, id int32) (*entity.Subscription, error) {
+	const query = `SELECT ` + subscriptionColumns + ` FROM ticket.subscriptions WHERE subscription_id = $1`
+
+	s, err := scanSubscription(r.db.QueryRowxContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("subscription %d: %w", id, repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("scan subscription: %w", err)
+	}
+	return s, nil
+}
+
+func (r *SubscriptionRepository) ListByUser(ctx context.Context, userID int32) ([]*entity.Subscription, error) {
+	const query = `
+        SELECT ` + subscriptionColumns + `
+        FROM ticket.subscriptions
+        WHERE user_id = $1
+        ORDER BY created_at DESC
+    `
+	return r.list(ctx, query, userID)
+}
+
+func (r *SubscriptionRepository) ListActiveByLotteryType(ctx context.Context, lotteryType string) ([]*entity.Subscription, error) {
+	const query = `
+        SELECT ` + subscriptionColumns + `
+        FROM ticket.subscriptions
+        WHERE status = 'ACTIVE' AND lottery_type = $1 AND draws_remaining > 0
+        ORDER BY subscription_id
+    `
+	return r.list(ctx, query, lotteryType)
+}
+
+func (r *SubscriptionRepository) list(ctx context.Context, query string, args ...any) ([]*entity.Subscription, error) {
+	rows, err := r.db.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	var out []*entity.Subscription
+	for rows.Next() {
+		s, err := scanSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan subscription: %w", err)
+		}
+		out = append(out, s)
+	}
+	return out, rows.Err()
+}
+
+func (r *SubscriptionRepository) UpdateStatus(
+	ctx context.Context,
+	id int32,
+	from []entity.SubscriptionStatus,
+	to entity.SubscriptionStatus,
+) (*entity.Subscription, error) {
+	const query = `
+        UPDATE ticket.subscriptions
+        SET status = $1, updated_at = NOW()
+        WHERE subscription_id = $2
+          AND status::text = ANY($3::text[])
+        RETURNING ` + subscriptionColumns
+
+	allowed := make([]string, len(from))
+	for i, st := range from {
+		allowed[i] = string(st)
+	}
+
+	s, err := scanSubscription(r.db.QueryRowxContext(ctx, query, string(to), id, formatNumbersArray(allowed)))
+	if err == nil {
+		return s, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("update subscription status: %w", err)
+	}
+
+	current, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("subscription %d is %s: %w", id, current.Status, repository.ErrInvalidTransition)
+}
+
+func (r *SubscriptionRepository) IssueTicket(ctx context.Context, subscriptionID, drawID int32, heldUntil time.Time) (*entity.Ticket, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin: %w", err)
+	}
+	defer tx.Rollback()
+
+	const lockQuery = `
+        SELECT user_id, numbers
+        FROM ticket.subscriptions
+        WHERE subscription_id = $1 AND status = 'ACTIVE' AND draws_remaining > 0
+        FOR UPDATE
+    `
+	var (
+		userID  int32
+		numsArr string
+	)
+	if err := tx.QueryRowxContext(ctx, lockQuery, subscriptionID).Scan(&userID, &numsArr); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("active subscription %d: %w", subscriptionID, repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("lock subscription: %w", err)
+	}
+
+	const issuedQuery = `SELECT EXISTS (SELECT 1 FROM ticket.subscription_tickets WHERE subscription_id = $1 AND draw_id = $2)`
+	var issued bool
+	if err := tx.QueryRowxContext(ctx, issuedQuery, subscriptionID, drawID).Scan(&issued); err != nil {
+		return nil, fmt.Errorf("check issued ticket: %w", err)
+	}
+	if issued {
+		return nil, fmt.Errorf("subscription %d ticket for draw %d: %w", subscriptionID, drawID, repository.ErrAlreadyExists)
+	}
+
+	t := &entity.Ticket{
+		UserID:    &userID,
+		DrawID:    drawID,
+		Numbers:   parseNumbersArray(numsArr),
+		Status:    entity.StatusHeld,
+		HeldUntil: &heldUntil,
+	}
+	const insertTicket = `
+        INSERT INTO ticket.tickets (user_id, draw_id, numbers, status, held_until)
+        VALUES ($1, $2, $3::text[], $4, $5)
+        RETURNING ticket_id, created_at
+    `
+	row := tx.QueryRowxContext(ctx, insertTicket, userID, drawID, numsArr, string(t.Status), heldUntil)
+	if err := row.Scan(&t.ID, &t.CreatedAt); err != nil {
+		return nil, fmt.Errorf("insert ticket: %w", err)
+	}
+
+	const linkQuery = `INSERT INTO ticket.subscription_tickets (subscription_id, draw_id, ticket_id) VALUES ($1, $2, $3)`
+	if _, err := tx.ExecContext(ctx, linkQuery, subscriptionID, drawID, t.ID); err != nil {
+		return nil, fmt.Errorf("link subscription ticket: %w", err)
+	}
+
+	const spendQuery = `
+        UPDATE ticket.subscriptions
+        SET draws_remaining = draws_remaining - 1,
+            status = CASE WHEN draws_remaining = 1 THEN 'COMPLETED'::ticket.subscription_status ELSE status END,
+            updated_at = NOW()
+        WHERE subscription_id = $1
+    `
+	if _, err := tx.ExecContext(ctx, spendQuery, subscriptionID); err != nil {
+		return nil, fmt.Errorf("spend subscription draw: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+	return t, nil
+}
+
+func (r *SubscriptionRepository) ReturnTicket(ctx context.Context, ticketID int32) (*entity.Subscription, error) {
+	const query = `
+        WITH link AS (
+            DELETE FROM ticket.subscription_tickets
+            WHERE ticket_id = $1
+            RETURNING subscription_id
+        )
+        UPDATE ticket.subscriptions s
+        SET draws_remaining = s.draws_remaining + 1,
+            status = CASE WHEN s.status = 'CANCELLED' THEN s.status ELSE 'PAUSED'::ticket.subscription_status END,
+            updated_at = NOW()
+        FROM link
+        WHERE s.subscription_id = link.subscription_id
+        RETURNING s.subscription_id, s.user_id, s.lottery_type, s.numbers, s.draws_total, s.draws_remaining,
+                  s.status, s.created_at, s.updated_at
+    `
+	s, err := scanSubscription(r.db.QueryRowxContext(ctx, query, ticketID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("subscription of ticket %d: %w", ticketID, repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("return subscription ticket: %w", err)
+	}
+	return s, nil
+}
@@ -0,0 +1,401 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+	"github.com/MaxFando/lms/ticket-service/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/shopspring/decimal"
+)
+
+const syndicateColumns = `syndicate_id, name, owner_id, created_at`
+
+type SyndicateRepository struct {
+	db *sqlx.DB
+}
+
+func NewSyndicateRepository(db *sqlx.DB) repository.SyndicateRepository {
+	return &SyndicateRepository{
+		db: db,
+	}
+}
+
+func scanSyndicate(row rowScanner) (*entity.Syndicate, error) {
+	var s entity.Syndicate
+	if err := row.Scan(&s.ID, &s.Name, &s.OwnerID, &s.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *SyndicateRepository) Create(ctx context.Context, name string, ownerID, shares int32) (*entity.Syndicate, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin: %w", err)
+	}
+	defer tx.Rollback()
+
+	const insertQuery = `
+        INSERT INTO ticket.syndicates (name, owner_id)
+        VALUES ($1, $2)
+        RETURNING ` + syndicateColumns
+	s, err := scanSyndicate(tx.QueryRowxContext(ctx, insertQuery, name, ownerID))
+	if err != nil {
+		return nil, fmt.Errorf("insert syndicate: %w", err)
+	}
+
+	const ownerQuery = `
+        INSERT INTO ticket.syndicate_members (syndicate_id, user_id, shares, status, joined_at)
+        VALUES ($1, $2, $3, 'ACTIVE', NOW())
+    `
+	if _, err := tx.ExecContext(ctx, ownerQuery, s.ID, ownerID, shares); err != nil {
+		return nil, fmt.Errorf("insert syndicate owner: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+	return r.GetByID(ctx, s.ID)
+}
+
+func (r *SyndicateRepository) GetByID(ctx context.Context, id int32) (*entity.Syndicate, error) {
+	const query = `SELECT ` + syndicateColumns + ` FROM ticket.syndicates WHERE syndicate_id = $1`
+
+	s, err := scanSyndicate(r.db.QueryRowxContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("syndicate %d: %w", id, repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("scan syndicate: %w", err)
+	}
+	if err := r.loadDetails(ctx, []*entity.Syndicate{s}); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (r *SyndicateRepository) ListByUser(ctx context.Context, userID int32) ([]*entity.Syndicate, error) {
+	const query = `
+        SELECT ` + syndicateColumns + `
+        FROM ticket.syndicates s
+        WHERE EXISTS (
+            SELECT 1 FROM ticket.syndicate_members m
+            WHERE m.syndicate_id = s.syndicate_id AND m.user_id = $1 AND m.status <> 'DECLINED'
+        )
+        ORDER BY created_at DESC, syndicate_id DESC
+    `
+	rows, err := r.db.QueryxContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query syndicates: %w", err)
+	}
+	defer rows.Close()
+
+	var out []*entity.Syndicate
+	for rows.Next() {
+		s, err := scanSyndicate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan syndicate: %w", err)
+		}
+		out = append(out, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadDetails(ctx, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// loadDetails дополняет синдикаты участниками и билетами
+func (r *SyndicateRepository) loadDetails(ctx context.Context, syndicates []*entity.Syndicate) error {
+	if len(syndicates) == 0 {
+		return nil
+	}
+	byID := make(map[int32]*entity.Syndicate, len(syndicates))
+	ids := make([]int32, 0, len(syndicates))
+	for _, s := range syndicates {
+		byID[s.ID] = s
+		ids = append(ids, s.ID)
+	}
+
+	const membersQuery = `
+        SELECT syndicate_id, user_id, shares, status, invited_at, joined_at
+        FROM ticket.syndicate_members
+        WHERE syndicate_id = ANY($1::int[])
+        ORDER BY joined_at NULLS LAST, invited_at, user_id
+    `
+	rows, err := r.db.QueryxContext(ctx, membersQuery, formatIDsArray(ids))
+	if err != nil {
+		return fmt.Errorf("query syndicate members: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			syndicateID int32
+			m           entity.SyndicateMember
+			st          string
+			joinedAt    sql.NullTime
+		)
+		if err := rows.Scan(&syndicateID, &m.UserID, &m.Shares, &st, &m.InvitedAt, &joinedAt); err != nil {
+			return fmt.Errorf("scan syndicate member: %w", err)
+		}
+		m.Status = entity.MemberStatus(st)
+		if joinedAt.Valid {
+			m.JoinedAt = &joinedAt.Time
+		}
+		byID[syndicateID].Members = append(byID[syndicateID].Members, m)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	const ticketsQuery = `
+        SELECT syndicate_id, ticket_id
+        FROM ticket.syndicate_tickets
+        WHERE syndicate_id = ANY($1::int[])
+        ORDER BY added_at, ticket_id
+    `
+	ticketRows, err := r.db.QueryxContext(ctx, ticketsQuery, formatIDsArray(ids))
+	if err != nil {
+		return fmt.Errorf("query syndicate tickets: %w", err)
+	}
+	defer ticketRows.Close()
+
+	for ticketRows.Next() {
+		var syndicateID, ticketID int32
+		if err := ticketRows.Scan(&syndicateID, &ticketID); err != nil {
+			return fmt.Errorf("scan syndicate ticket: %w", err)
+		}
+		byID[syndicateID].TicketIDs = append(byID[syndicateID].TicketIDs, ticketID)
+	}
+	return ticketRows.Err()
+}
+
+func (r *SyndicateRepository) Invite(ctx context.Context, syndicateID, userID, shares int32) error {
+	const query = `
+        INSERT INTO ticket.syndicate_members (syndicate_id, user_id, shares)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (syndicate_id, user_id) DO UPDATE
+        SET shares = EXCLUDED.shares, status = 'INVITED', invited_at = NOW(), joined_at = NULL
+        WHERE ticket.syndicate_members.status = 'DECLINED'
+    `
+	res, err := r.db.ExecContext(ctx, query, syndicateID, userID, shares)
+	if err != nil {
+		return fmt.Errorf("invite syndicate member: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("member %d of syndicate %d: %w", userID, syndicateID, repository.ErrAlreadyExists)
+	}
+	return nil
+}
+
+func (r *SyndicateRepository) Respond(ctx context.Context, syndicateID, userID int32, accept bool) error {
+	to, joined := entity.MemberDeclined, "NULL"
+	if accept {
+		to, joined = entity.MemberActive, "NOW()"
+	}
+	query := `
+        UPDATE ticket.syndicate_members
+        SET status = $3, joined_at = ` + joined + `
+        WHERE syndicate_id = $1 AND user_id = $2 AND status = 'INVITED'
+    `
+	res, err := r.db.ExecContext(ctx, query, syndicateID, userID, string(to))
+	if err != nil {
+		return fmt.Errorf("respond to invitation: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		return nil
+	}
+
+	const statusQuery = `SELECT status FROM ticket.syndicate_members WHERE syndicate_id = $1 AND user_id = $2`
+	var st string
+	if err := r.db.QueryRowxContext(ctx, statusQuery, syndicateID, userID).Scan(&st); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("invitation of user %d to syndicate %d: %w", userID, syndicateID, repository.ErrNotFound)
+		}
+		return fmt.Errorf("scan member status: %w", err)
+	}
+	return fmt.Errorf("member %d of syndicate %d is %s: %w", userID, syndicateID, st, repository.ErrInvalidTransition)
+}
+
+func (r *SyndicateRepository) AddTicket(ctx context.Context, syndicateID, ownerID, ticketID int32) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockTransferable(ctx, tx, ticketID, ownerID); err != nil {
+		return err
+	}
+
+	const pendingQuery = `SELECT EXISTS (SELECT 1 FROM ticket.transfers WHERE ticket_id = $1 AND status = 'PENDING')`
+	var pending bool
+	if err := tx.QueryRowxContext(ctx, pendingQuery, ticketID).Scan(&pending); err != nil {
+		return fmt.Errorf("check pending transfer: %w", err)
+	}
+	if pending {
+		return fmt.Errorf("ticket %d has a pending transfer: %w", ticketID, repository.ErrTransferNotAllowed)
+	}
+
+	const insertQuery = `INSERT INTO ticket.syndicate_tickets (ticket_id, syndicate_id) VALUES ($1, $2)`
+	if _, err := tx.ExecContext(ctx, insertQuery, ticketID, syndicateID); err != nil {
+		return fmt.Errorf("insert syndicate ticket: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+	return nil
+}
+
+func (r *SyndicateRepository) ListUnsettled(ctx context.Context, limit int) ([]*entity.SyndicateWin, error) {
+	const query = `
+        SELECT st.syndicate_id, st.ticket_id, COALESCE(p.amount, 0)
+        FROM ticket.syndicate_tickets st
+        JOIN ticket.tickets t ON t.ticket_id = st.ticket_id
+        JOIN draw.draws d ON d.id = t.draw_id
+        CROSS JOIN LATERAL (
+            SELECT string_to_array(replace(winning_combination, ' ', ''), ',')::int[] AS nums
+            FROM draw.draw_results
+            WHERE draw_id = t.draw_id
+            ORDER BY result_time DESC
+            LIMIT 1
+        ) res
+        LEFT JOIN ticket.prize_tiers p ON p.lottery_type = d.lottery_type
+            AND p.matched = (SELECT count(*) FROM unnest(t.numbers) n WHERE n::int = ANY(res.nums))
+        WHERE st.settled_at IS NULL AND t.status = 'WIN'
+        ORDER BY st.ticket_id
+        LIMIT $1
+    `
+	rows, err := r.db.QueryxContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query unsettled syndicate tickets: %w", err)
+	}
+	defer rows.Close()
+
+	var out []*entity.SyndicateWin
+	for rows.Next() {
+		var w entity.SyndicateWin
+		if err := rows.Scan(&w.SyndicateID, &w.TicketID, &w.Prize); err != nil {
+			return nil, fmt.Errorf("scan syndicate win: %w", err)
+		}
+		out = append(out, &w)
+	}
+	return out, rows.Err()
+}
+
+func (r *SyndicateRepository) SavePayouts(ctx context.Context, win *entity.SyndicateWin, payouts []entity.SyndicatePayout) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin: %w", err)
+	}
+	defer tx.Rollback()
+
+	const settleQuery = `
+        UPDATE ticket.syndicate_tickets
+        SET settled_at = NOW()
+        WHERE ticket_id = $1 AND settled_at IS NULL
+    `
+	res, err := tx.ExecContext(ctx, settleQuery, win.TicketID)
+	if err != nil {
+		return fmt.Errorf("settle syndicate ticket: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("payouts of ticket %d: %w", win.TicketID, repository.ErrAlreadyExists)
+	}
+
+	const insertQuery = `
+        INSERT INTO ticket.syndicate_payouts (ticket_id, user_id, syndicate_id, amount)
+        VALUES ($1, $2, $3, $4)
+    `
+	for _, p := range payouts {
+		if _, err := tx.ExecContext(ctx, insertQuery, win.TicketID, p.UserID, win.SyndicateID, p.Amount); err != nil {
+			return fmt.Errorf("insert syndicate payout: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+	return nil
+}
+
+func (r *SyndicateRepository) SharesByTickets(ctx context.Context, userID int32, ticketIDs []int32) (map[int32]*entity.SyndicateShare, error) {
+	out := make(map[int32]*entity.SyndicateShare)
+	if len(ticketIDs) == 0 {
+		return out, nil
+	}
+
+	const query = `
+        SELECT st.ticket_id, st.syndicate_id, m.shares,
+               (SELECT COALESCE(sum(a.shares), 0)
+                FROM ticket.syndicate_members a
+                WHERE a.syndicate_id = st.syndicate_id AND a.status = 'ACTIVE')::int,
+               p.amount
+        FROM ticket.syndicate_tickets st
+        JOIN ticket.syndicate_members m
+            ON m.syndicate_id = st.syndicate_id AND m.user_id = $1 AND m.status = 'ACTIVE'
+        LEFT JOIN ticket.syndicate_payouts p ON p.ticket_id = st.ticket_id AND p.user_id = $1
+        WHERE st.ticket_id = ANY($2::int[])
+    `
+	rows, err := r.db.QueryxContext(ctx, query, userID, formatIDsArray(ticketIDs))
+	if err != nil {
+		return nil, fmt.Errorf("query syndicate shares: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			ticketID int32
+			s        entity.SyndicateShare
+			payout   decimal.NullDecimal
+		)
+		if err := rows.Scan(&ticketID, &s.SyndicateID, &s.Shares, &s.TotalShares, &payout); err != nil {
+			return nil, fmt.Errorf("scan syndicate share: %w", err)
+		}
+		if payout.Valid {
+			s.Payout = &payout.Decimal
+		}
+		out[ticketID] = &s
+	}
+	return out, rows.Err()
+}
+
+func (r *SyndicateRepository) PayoutsByTickets(ctx context.Context, ticketIDs []int32) (map[int32][]entity.SyndicatePayout, error) {
+	out := make(map[int32][]entity.SyndicatePayout)
+	if len(ticketIDs) == 0 {
+		return out, nil
+	}
+
+	const query = `
+        SELECT ticket_id, user_id, amount
+        FROM ticket.syndicate_payouts
+        WHERE ticket_id = ANY($1::int[])
+        ORDER BY ticket_id, user_id
+    `
+	rows, err := r.db.QueryxContext(ctx, query, formatIDsArray(ticketIDs))
+	if err != nil {
+		return nil, fmt.Errorf("query syndicate payouts: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			ticketID int32
+			p        entity.SyndicatePayout
+		)
+		if err := rows.Scan(&ticketID, &p.UserID, &p.Amount); err != nil {
+			return nil, fmt.Errorf("scan syndicate payout: %w", err)
+		}
+		out[ticketID] = append(out[ticketID], p)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,214 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+	"github.com/MaxFando/lms/ticket-service/internal/repository"
+	"github.com/jmoiron/sqlx"
+)
+
+const transferColumns = `transfer_id, ticket_id, from_user_id, to_user_id, status, created_at, resolved_at`
+
+type TransferRepository struct {
+	db *sqlx.DB
+}
+
+func NewTransferRepository(db *sqlx.DB) repository.TransferRepository {
+	return &TransferRepository{
+		db: db,
+	}
+}
+
+func scanTransfer(row rowScanner) (*entity.Transfer, error) {
+	var (
+		t          entity.Transfer
+		st         string
+		resolvedAt sql.NullTime
+	)
+	if err := row.Scan(&t.ID, &t.TicketID, &t.FromUserID, &t.ToUserID, &st, &t.CreatedAt, &resolvedAt); err != nil {
+		return nil, err
+	}
+	if resolvedAt.Valid {
+		t.ResolvedAt = &resolvedAt.Time
+	}
+	t.Status = entity.TransferStatus(st)
+	return &t, nil
+}
+
+func (r *TransferRepository) Create(ctx context.Context, ticketID, fromUserID, toUserID int32) (*entity.Transfer, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockTransferable(ctx, tx, ticketID, fromUserID); err != nil {
+		return nil, err
+	}
+
+	const pendingQuery = `SELECT EXISTS (SELECT 1 FROM ticket.transfers WHERE ticket_id = $1 AND status = 'PENDING')`
+	var pending bool
+	if err := tx.QueryRowxContext(ctx, pendingQuery, ticketID).Scan(&pending); err != nil {
+		return nil, fmt.Errorf("check pending transfer: %w", err)
+	}
+	if pending {
+		return nil, fmt.Errorf("pending transfer of ticket %d: %w", ticketID, repository.ErrAlreadyExists)
+	}
+
+	const insertQuery = `
+        INSERT INTO ticket.transfers (ticket_id, from_user_id, to_user_id)
+        VALUES ($1, $2, $3)
+        RETURNING ` + transferColumns
+	t, err := scanTransfer(tx.QueryRowxContext(ctx, insertQuery, ticketID, fromUserID, toUserID))
+	if err != nil {
+		return nil, fmt.Errorf("insert transfer: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+	return t, nil
+}
+
+func (r *TransferRepository) GetByID(ctx context.Context, id int32) (*entity.Transfer, error) {
+	const query = `SELECT ` + transferColumns + ` FROM ticket.transfers WHERE transfer_id = $1`
+
+	t, err := scanTransfer(r.db.QueryRowxContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("transfer %d: %w", id, repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("scan transfer: %w", err)
+	}
+	return t, nil
+}
+
+func (r *TransferRepository) ListByUser(ctx context.Context, userID int32) ([]*entity.Transfer, error) {
+	const query = `
+        SELECT ` + transferColumns + `
+        FROM ticket.transfers
+        WHERE from_user_id = $1 OR to_user_id = $1
+        ORDER BY created_at DESC, transfer_id DESC
+    `
+	rows, err := r.db.QueryxContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query transfers: %w", err)
+	}
+	defer rows.Close()
+
+	var out []*entity.Transfer
+	for rows.Next() {
+		t, err := scanTransfer(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan transfer: %w", err)
+		}
+		out = append(out, t)
+	}
+	return out, rows.Err()
+}
+
+func (r *TransferRepository) Accept(ctx context.Context, id int32) (*entity.Transfer, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin: %w", err)
+	}
+	defer tx.Rollback()
+
+	const lockQuery = `SELECT ` + transferColumns + ` FROM ticket.transfers WHERE transfer_id = $1 FOR UPDATE`
+	t, err := scanTransfer(tx.QueryRowxContext(ctx, lockQuery, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("transfer %d: %w", id, repository.ErrNotFound)
+		}
+		return nil, fmt.Errorf("lock transfer: %w", err)
+	}
+	if t.Status != entity.TransferStatusPending {
+		return nil, fmt.Errorf("transfer %d is %s: %w", id, t.Status, repository.ErrInvalidTransition)
+	}
+
+	if err := lockTransferable(ctx, tx, t.TicketID, t.FromUserID); err != nil {
+		return nil, err
+	}
+
+	const ownerQuery = `UPDATE ticket.tickets SET user_id = $2 WHERE ticket_id = $1`
+	if _, err := tx.ExecContext(ctx, ownerQuery, t.TicketID, t.ToUserID); err != nil {
+		return nil, fmt.Errorf("change ticket owner: %w", err)
+	}
+
+	const acceptQuery = `
+        UPDATE ticket.transfers
+        SET status = 'ACCEPTED', resolved_at = NOW()
+        WHERE transfer_id = $1
+        RETURNING ` + transferColumns
+	if t, err = scanTransfer(tx.QueryRowxContext(ctx, acceptQuery, id)); err != nil {
+		return nil, fmt.Errorf("accept transfer: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+	return t, nil
+}
+
+func (r *TransferRepository) Resolve(ctx context.Context, id int32, to entity.TransferStatus) (*entity.Transfer, error) {
+	const query = `
+        UPDATE ticket.transfers
+        SET status = $2, resolved_at = NOW()
+        WHERE transfer_id = $1 AND status = 'PENDING'
+        RETURNING ` + transferColumns
+
+	t, err := scanTransfer(r.db.QueryRowxContext(ctx, query, id, string(to)))
+	if err == nil {
+		return t, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("resolve transfer: %w", err)
+	}
+
+	current, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("transfer %d is %s: %w", id, current.Status, repository.ErrInvalidTransition)
+}
+
+// lockTransferable блокирует билет и проверяет, что он оплачен fromUserID, не отдан синдикату,
+// а продажи в тираже ещё идут
+func lockTransferable(ctx context.Context, tx *sqlx.Tx, ticketID, fromUserID int32) error {
+	const query = `
+        SELECT t.user_id, t.status, d.status,
+               EXISTS (SELECT 1 FROM ticket.syndicate_tickets st WHERE st.ticket_id = t.ticket_id)
+        FROM ticket.tickets t
+        JOIN draw.draws d ON d.id = t.draw_id
+        WHERE t.ticket_id = $1
+        FOR UPDATE OF t
+    `
+	var (
+		owner      sql.NullInt32
+		st, drawSt string
+		shared     bool
+	)
+	if err := tx.QueryRowxContext(ctx, query, ticketID).Scan(&owner, &st, &drawSt, &shared); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("ticket %d: %w", ticketID, repository.ErrNotFound)
+		}
+		return fmt.Errorf("lock ticket: %w", err)
+	}
+	if !owner.Valid || owner.Int32 != fromUserID {
+		return fmt.Errorf("ticket %d: %w", ticketID, repository.ErrNotTicketOwner)
+	}
+	if entity.Status(st) != entity.StatusPaid {
+		return fmt.Errorf("ticket %d is %s: %w", ticketID, st, repository.ErrTransferNotAllowed)
+	}
+	if drawSt != "ACTIVE" {
+		return fmt.Errorf("draw of ticket %d is %s: %w", ticketID, drawSt, repository.ErrTransferNotAllowed)
+	}
+	if shared {
+		return fmt.Errorf("ticket %d belongs to a syndicate: %w", ticketID, repository.ErrTransferNotAllowed)
+	}
+	return nil
+}
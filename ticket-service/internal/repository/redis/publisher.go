@@ -0,0 +1,140 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+	"github.com/redis/go-redis/v9"
+)
+
+type Publisher struct {
+	client  *redis.Client
+	channel string
+}
+
+func NewPublisher(client *redis.Client, channel string) *Publisher {
+	return &Publisher{
+		client:  client,
+		channel: channel,
+	}
+}
+
+type transferEvent struct {
+	Type       string `json:"type"`
+	TransferID int32  `json:"transfer_id"`
+	TicketID   int32  `json:"ticket_id"`
+	FromUserID int32  `json:"from_user_id"`
+	ToUserID   int32  `json:"to_user_id"`
+}
+
+func (p *Publisher) PublishTransfer(ctx context.Context, t *entity.Transfer, eventType string) error {
+	data, err := json.Marshal(transferEvent{
+		Type:       eventType,
+		TransferID: t.ID,
+		TicketID:   t.TicketID,
+		FromUserID: t.FromUserID,
+		ToUserID:   t.ToUserID,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal transfer event: %w", err)
+	}
+
+	if err := p.client.Publish(ctx, p.channel, data).Err(); err != nil {
+		return fmt.Errorf("publish transfer event: %w", err)
+	}
+	return nil
+}
+
+type syndicateEvent struct {
+	Type        string `json:"type"`
+	SyndicateID int32  `json:"syndicate_id"`
+	UserID      int32  `json:"user_id"`
+	TicketID    int32  `json:"ticket_id,omitempty"`
+	Amount      string `json:"amount,omitempty"`
+}
+
+func (p *Publisher) PublishSyndicateInvite(ctx context.Context, syndicateID, userID int32) error {
+	return p.publishSyndicate(ctx, syndicateEvent{
+		Type:        entity.EventTypeSyndicateInvited,
+		SyndicateID: syndicateID,
+		UserID:      userID,
+	})
+}
+
+func (p *Publisher) PublishSyndicatePayout(ctx context.Context, win *entity.SyndicateWin, payout entity.SyndicatePayout) error {
+	return p.publishSyndicate(ctx, syndicateEvent{
+		Type:        entity.EventTypeSyndicatePayout,
+		SyndicateID: win.SyndicateID,
+		UserID:      payout.UserID,
+		TicketID:    win.TicketID,
+		Amount:      payout.Amount.StringFixed(2),
+	})
+}
+
+func (p *Publisher) publishSyndicate(ctx context.Context, ev syndicateEvent) error {
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("marshal syndicate event: %w", err)
+	}
+
+	if err := p.client.Publish(ctx, p.channel, data).Err(); err != nil {
+		return fmt.Errorf("publish syndicate event: %w", err)
+	}
+	return nil
+}
+
+type ticketWonEvent struct {
+	Type     string `json:"type"`
+	TicketID int32  `json:"ticket_id"`
+	DrawID   int32  `json:"draw_id"`
+	UserID   int32  `json:"user_id"`
+	Amount   string `json:"amount"`
+}
+
+func (p *Publisher) PublishTicketWon(ctx context.Context, w *entity.Winner) error {
+	if w.UserID == nil {
+		return fmt.Errorf("ticket %d has no owner", w.TicketID)
+	}
+
+	data, err := json.Marshal(ticketWonEvent{
+		Type:     entity.EventTypeTicketWon,
+		TicketID: w.TicketID,
+		DrawID:   w.DrawID,
+		UserID:   *w.UserID,
+		Amount:   w.Prize.StringFixed(2),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal ticket won event: %w", err)
+	}
+
+	if err := p.client.Publish(ctx, p.channel, data).Err(); err != nil {
+		return fmt.Errorf("publish ticket won event: %w", err)
+	}
+	return nil
+}
+
+type undeliveredEvent struct {
+	Type      string  `json:"type"`
+	InvoiceID int64   `json:"invoice_id"`
+	UserID    int32   `json:"user_id"`
+	TicketIDs []int32 `json:"ticket_ids"`
+}
+
+func (p *Publisher) PublishUndelivered(ctx context.Context, invoiceID int64, userID int32, ticketIDs []int32) error {
+	data, err := json.Marshal(undeliveredEvent{
+		Type:      entity.EventTypeTicketsUndelivered,
+		InvoiceID: invoiceID,
+		UserID:    userID,
+		TicketIDs: ticketIDs,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal undelivered tickets event: %w", err)
+	}
+
+	if err := p.client.Publish(ctx, p.channel, data).Err(); err != nil {
+		return fmt.Errorf("publish undelivered tickets event: %w", err)
+	}
+	return nil
+}
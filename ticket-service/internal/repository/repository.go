@@ -2,18 +2,152 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
+
 	"github.com/MaxFando/lms/ticket-service/internal/entity"
 )
 
+var (
+	ErrNotFound           = errors.New("not found")
+	ErrAlreadyExists      = errors.New("already exists")
+	ErrInvalidTransition  = errors.New("invalid status transition")
+	ErrTicketUnavailable  = errors.New("ticket is already booked or not on sale")
+	ErrNoFreeTickets      = errors.New("no free tickets left in draw")
+	ErrNotTicketOwner     = errors.New("ticket is not owned by user")
+	ErrTransferNotAllowed = errors.New("ticket cannot be transferred")
+	ErrHoldLimit          = errors.New("hold extension limit reached")
+	ErrLimitExceeded      = errors.New("booking limit exceeded")
+)
+
 type TicketRepository interface {
 	GetByID(ctx context.Context, id int32) (*entity.Ticket, error)
+	// Create сохраняет билет и выдаёт ему серийный номер
 	Create(ctx context.Context, t *entity.Ticket) (*entity.Ticket, error)
-	UpdateStatus(ctx context.Context, id int32, status entity.Status) (*entity.Ticket, error)
-	ListByUser(ctx context.Context, userID int32) ([]*entity.TicketWithDraw, error)
+	// GetBySerial находит билет по каноничному серийному номеру
+	GetBySerial(ctx context.Context, code string) (*entity.TicketWithDraw, error)
+	// ListByUser возвращает билеты пользователя и билеты синдикатов, в которых он участвует
+	ListByUser(ctx context.Context, userID int32, f entity.TicketFilter) ([]*entity.TicketWithDraw, error)
 	IsDrawActive(ctx context.Context, drawID int32) (bool, error)
 	GetDrawLotteryType(ctx context.Context, drawID int32) (count int, maxNum int, err error)
-	BookTicket(ctx context.Context, ticketID, userID int32) (*entity.Ticket, error)
-	ClearBooking(ctx context.Context, ticketID int32) error
-	ListFreeByActiveDraw(ctx context.Context) ([]*entity.Ticket, error)
-	BulkUpdateStatus(ctx context.Context, ids []int32, status entity.Status) ([]*entity.Ticket, error)
+	// Hold бронирует свободный билет активного тиража за пользователем до heldUntil
+	Hold(ctx context.Context, ticketID, userID int32, heldUntil time.Time) (*entity.Ticket, error)
+	// HoldAny бронирует случайный свободный билет тиража, не дожидаясь билетов, которые бронируют параллельно;
+	// билеты с большим числом совпадений с preferred выбираются первыми
+	HoldAny(ctx context.Context, drawID, userID int32, preferred []string, heldUntil time.Time) (*entity.Ticket, error)
+	// ExtendHold продлевает бронь пользователя, срок которой ещё не снят выставленным счётом,
+	// не больше entity.MaxHoldExtensions раз
+	ExtendHold(ctx context.Context, ticketID, userID int32, heldUntil time.Time) (*entity.Ticket, error)
+	// PinHolds снимает срок брони с билетов, по которым выставлен счёт: дальше их судьбу решает оплата
+	PinHolds(ctx context.Context, ticketIDs []int32) error
+	// ReleaseHolds возвращает забронированные билеты в продажу
+	ReleaseHolds(ctx context.Context, ticketIDs []int32) error
+	// ExpireHolds возвращает в продажу билеты с истёкшей бронью и убирает их из открытых корзин
+	ExpireHolds(ctx context.Context, now time.Time) ([]int32, error)
+	// ListAvailable возвращает свободные билеты активных тиражей
+	ListAvailable(ctx context.Context, f entity.TicketFilter) ([]*entity.Ticket, error)
+	// Transition переводит билеты в статус to; билеты, для которых переход недопустим, не меняются
+	Transition(ctx context.Context, ids []int32, to entity.Status) ([]*entity.Ticket, error)
+	// ConfirmPaid переводит забронированные пользователем билеты в PAID и возвращает подтверждённые
+	ConfirmPaid(ctx context.Context, userID int32, ticketIDs []int32) ([]*entity.Ticket, error)
+	// CancelDraw отменяет все ещё не разыгранные билеты тиража
+	CancelDraw(ctx context.Context, drawID int32) ([]*entity.Ticket, error)
+	GetDraw(ctx context.Context, drawID int32) (*entity.Draw, error)
+	// CountSold считает оплаченные билеты тиража, включая уже разыгранные
+	CountSold(ctx context.Context, drawID int32) (int, error)
+	// ListWinners возвращает выигрышные билеты тиража с числом совпадений и призом по таблице разрядов
+	ListWinners(ctx context.Context, drawID int32) ([]*entity.Winner, error)
+	// ListPrizes возвращает призы выигравших билетов из ticketIDs; билеты синдикатов делит расчёт синдиката, их здесь нет
+	ListPrizes(ctx context.Context, ticketIDs []int32) ([]*entity.Winner, error)
+}
+
+type SubscriptionRepository interface {
+	Create(ctx context.Context, s *entity.Subscription) (*entity.Subscription, error)
+	GetByID(ctx context.Context, id int32) (*entity.Subscription, error)
+	ListByUser(ctx context.Context, userID int32) ([]*entity.Subscription, error)
+	ListActiveByLotteryType(ctx context.Context, lotteryType string) ([]*entity.Subscription, error)
+	// UpdateStatus переводит подписку в статус to, если текущий статус входит в from
+	UpdateStatus(ctx context.Context, id int32, from []entity.SubscriptionStatus, to entity.SubscriptionStatus) (*entity.Subscription, error)
+	// IssueTicket создаёт билет подписки на тираж и списывает один тираж из остатка
+	IssueTicket(ctx context.Context, subscriptionID, drawID int32, heldUntil time.Time) (*entity.Ticket, error)
+	// ReturnTicket отвязывает неоплаченный билет от подписки, возвращает тираж в остаток и ставит подписку на паузу
+	ReturnTicket(ctx context.Context, ticketID int32) (*entity.Subscription, error)
+}
+
+type TransferRepository interface {
+	// Create открывает передачу оплаченного билета, если он принадлежит fromUserID и продажи в тираже ещё идут
+	Create(ctx context.Context, ticketID, fromUserID, toUserID int32) (*entity.Transfer, error)
+	GetByID(ctx context.Context, id int32) (*entity.Transfer, error)
+	// ListByUser возвращает отправленные и полученные пользователем передачи
+	ListByUser(ctx context.Context, userID int32) ([]*entity.Transfer, error)
+	// Accept переписывает билет на получателя, перепроверив владельца и статус тиража
+	Accept(ctx context.Context, id int32) (*entity.Transfer, error)
+	// Resolve закрывает ожидающую передачу со статусом to, не меняя владельца билета
+	Resolve(ctx context.Context, id int32, to entity.TransferStatus) (*entity.Transfer, error)
+}
+
+type StatsRepository interface {
+	// ListUnrecorded возвращает разыгранные тиражи с результатом, ещё не учтённые в статистике чисел
+	ListUnrecorded(ctx context.Context, limit int) ([]*entity.CompletedDraw, error)
+	// RecordDraw сохраняет итоги тиража по каждому числу; повторная запись тиража ничего не меняет
+	RecordDraw(ctx context.Context, d *entity.CompletedDraw, maxNum int, winning []int) error
+	// ListNumberStats возвращает итоги тиражей типа lotteryType, разыгранных в [from, to), по порядку розыгрыша
+	ListNumberStats(ctx context.Context, lotteryType string, from, to time.Time) ([]entity.DrawNumberStat, error)
+}
+
+type FavouriteRepository interface {
+	Create(ctx context.Context, f *entity.Favourite) (*entity.Favourite, error)
+	GetByID(ctx context.Context, id int32) (*entity.Favourite, error)
+	ListByUser(ctx context.Context, userID int32) ([]*entity.Favourite, error)
+	// Delete удаляет набор, только если он принадлежит userID
+	Delete(ctx context.Context, id, userID int32) error
+}
+
+type SyndicateRepository interface {
+	// Create создаёт синдикат, владелец сразу становится его участником
+	Create(ctx context.Context, name string, ownerID, shares int32) (*entity.Syndicate, error)
+	GetByID(ctx context.Context, id int32) (*entity.Syndicate, error)
+	// ListByUser возвращает синдикаты, куда пользователь вступил или приглашён
+	ListByUser(ctx context.Context, userID int32) ([]*entity.Syndicate, error)
+	// Invite приглашает пользователя; отклонившего приглашение можно пригласить повторно
+	Invite(ctx context.Context, syndicateID, userID, shares int32) error
+	// Respond принимает или отклоняет приглашение
+	Respond(ctx context.Context, syndicateID, userID int32, accept bool) error
+	// AddTicket передаёт синдикату оплаченный билет владельца синдиката
+	AddTicket(ctx context.Context, syndicateID, ownerID, ticketID int32) error
+	// ListUnsettled возвращает выигрышные билеты синдикатов, приз по которым ещё не распределён
+	ListUnsettled(ctx context.Context, limit int) ([]*entity.SyndicateWin, error)
+	// SavePayouts сохраняет доли приза и отмечает билет рассчитанным; повторный расчёт - ErrAlreadyExists
+	SavePayouts(ctx context.Context, win *entity.SyndicateWin, payouts []entity.SyndicatePayout) error
+	// SharesByTickets возвращает доли пользователя в переданных билетах синдикатов
+	SharesByTickets(ctx context.Context, userID int32, ticketIDs []int32) (map[int32]*entity.SyndicateShare, error)
+	// PayoutsByTickets возвращает сохранённые доли приза по билетам синдикатов
+	PayoutsByTickets(ctx context.Context, ticketIDs []int32) (map[int32][]entity.SyndicatePayout, error)
+}
+
+type LimitRepository interface {
+	// Usage считает билеты пользователя в тираже и его просроченные счета начиная с overdueSince
+	Usage(ctx context.Context, userID, drawID int32, overdueSince time.Time) (entity.BookingUsage, error)
+	// RecordOverdue запоминает просроченный счёт пользователя по каждому тиражу его билетов
+	RecordOverdue(ctx context.Context, userID int32, invoiceID int64, ticketIDs []int32) error
+}
+
+type CartRepository interface {
+	// GetOpen возвращает открытую корзину пользователя вместе с билетами, создавая её при необходимости
+	GetOpen(ctx context.Context, userID int32) (*entity.Cart, error)
+	// AddTicket бронирует свободный билет активного тиража за пользователем и кладёт его в открытую корзину.
+	// Лимиты проверяются в той же транзакции с учётом новой брони; превышение - ErrLimitExceeded
+	AddTicket(ctx context.Context, userID, ticketID int32, heldUntil time.Time, limits entity.BookingLimits) error
+	// RemoveTicket убирает билет из открытой корзины и снимает с него бронь
+	RemoveTicket(ctx context.Context, userID, ticketID int32) error
+	// StartCheckout закрывает открытую корзину для изменений до выставления счёта и снимает срок брони с её билетов
+	StartCheckout(ctx context.Context, userID int32) (*entity.Cart, error)
+	// CompleteCheckout привязывает к оформляемой корзине выставленный счёт
+	CompleteCheckout(ctx context.Context, cartID int32, invoiceID int64) (*entity.Cart, error)
+	// AbortCheckout возвращает корзину в работу, если счёт выставить не удалось, и восстанавливает срок брони
+	AbortCheckout(ctx context.Context, cartID int32, heldUntil time.Time) error
+	// ReleaseByInvoice отмечает корзину неоплаченного счёта как освобождённую
+	ReleaseByInvoice(ctx context.Context, invoiceID int64) error
+	// MarkPaidByInvoice отмечает корзину оплаченного счёта как оплаченную
+	MarkPaidByInvoice(ctx context.Context, invoiceID int64) error
 }
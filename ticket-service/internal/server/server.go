@@ -7,6 +7,7 @@ import (
 
 	ticketservicev1 "github.com/MaxFando/lms/ticket-service/api/grpc/gen/go/ticket-service/v1"
 
+	"github.com/MaxFando/lms/platform/auth"
 	"github.com/MaxFando/lms/platform/logger"
 
 	"github.com/MaxFando/lms/ticket-service/internal/server/interceptor"
@@ -35,14 +36,14 @@ type Server struct {
 	errors chan error
 }
 
-func NewServer(logger logger.Logger, serviceServer *v1.Server) *Server {
+func NewServer(logger logger.Logger, serviceServer *v1.Server, jwtSecret string) *Server {
 	srv := new(Server)
 
 	srv.grpcPort = defaultGRPCPort
 	srv.errors = make(chan error, 1)
 	srv.logger = logger
 
-	srv.grpcServer = initGRPCServer(logger, serviceServer)
+	srv.grpcServer = initGRPCServer(logger, serviceServer, jwtSecret)
 
 	return srv
 }
@@ -103,10 +104,11 @@ func (s *Server) serveGRPC(ctx context.Context) {
 	}
 }
 
-func initGRPCServer(logger logger.Logger, serviceServer *v1.Server) *grpc.Server {
+func initGRPCServer(logger logger.Logger, serviceServer *v1.Server, jwtSecret string) *grpc.Server {
 	server := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			interceptor.PanicRecoveryUnaryInterceptor(logger),
+			auth.UnaryServerInterceptor(jwtSecret),
 		),
 		grpc.MaxRecvMsgSize(defaultMaxRecvMsgSize),
 		grpc.MaxSendMsgSize(defaultMaxSendMsgSize),
@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	ticketservicev1 "github.com/MaxFando/lms/ticket-service/api/grpc/gen/go/ticket-service/v1"
+	"github.com/MaxFando/lms/ticket-service/internal/converter"
+	"github.com/MaxFando/lms/ticket-service/internal/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) GetCart(ctx context.Context, req *ticketservicev1.GetCartRequest) (*ticketservicev1.Cart, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.carts.GetCart(ctx, caller.UserID)
+	if err != nil {
+		return nil, cartError("GetCart", err)
+	}
+
+	return converter.ToCartServiceFromEntity(c), nil
+}
+
+func (s *Server) AddToCart(ctx context.Context, req *ticketservicev1.AddToCartRequest) (*ticketservicev1.Cart, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.carts.AddToCart(ctx, caller.UserID, req.TicketId)
+	if err != nil {
+		return nil, cartError("AddToCart", err)
+	}
+
+	return converter.ToCartServiceFromEntity(c), nil
+}
+
+func (s *Server) RemoveFromCart(ctx context.Context, req *ticketservicev1.RemoveFromCartRequest) (*ticketservicev1.Cart, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.carts.RemoveFromCart(ctx, caller.UserID, req.TicketId)
+	if err != nil {
+		return nil, cartError("RemoveFromCart", err)
+	}
+
+	return converter.ToCartServiceFromEntity(c), nil
+}
+
+func (s *Server) CheckoutCart(ctx context.Context, req *ticketservicev1.CheckoutCartRequest) (*ticketservicev1.Cart, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.carts.Checkout(ctx, caller.UserID)
+	if err != nil {
+		return nil, cartError("CheckoutCart", err)
+	}
+
+	return converter.ToCartServiceFromEntity(c), nil
+}
+
+func cartError(method string, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, usecase.ErrTicketUnavailable),
+		errors.Is(err, usecase.ErrCartEmpty):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, usecase.ErrLimitExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", method, err)
+	}
+}
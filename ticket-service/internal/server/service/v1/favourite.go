@@ -0,0 +1,73 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	ticketservicev1 "github.com/MaxFando/lms/ticket-service/api/grpc/gen/go/ticket-service/v1"
+	"github.com/MaxFando/lms/ticket-service/internal/converter"
+	"github.com/MaxFando/lms/ticket-service/internal/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *Server) SaveFavourite(ctx context.Context, req *ticketservicev1.SaveFavouriteRequest) (*ticketservicev1.Favourite, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := s.favourites.SaveFavourite(ctx, caller.UserID, req.Name, req.LotteryType, req.Numbers)
+	if err != nil {
+		return nil, favouriteError("SaveFavourite", err)
+	}
+
+	return converter.ToFavouriteServiceFromEntity(f), nil
+}
+
+func (s *Server) ListFavourites(ctx context.Context, _ *emptypb.Empty) (*ticketservicev1.ListFavouritesResponse, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	favourites, err := s.favourites.ListFavourites(ctx, caller.UserID)
+	if err != nil {
+		return nil, favouriteError("ListFavourites", err)
+	}
+
+	resp := &ticketservicev1.ListFavouritesResponse{}
+	for _, f := range favourites {
+		resp.Favourites = append(resp.Favourites, converter.ToFavouriteServiceFromEntity(f))
+	}
+	return resp, nil
+}
+
+func (s *Server) DeleteFavourite(ctx context.Context, req *ticketservicev1.DeleteFavouriteRequest) (*emptypb.Empty, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.favourites.DeleteFavourite(ctx, caller.UserID, req.FavouriteId); err != nil {
+		return nil, favouriteError("DeleteFavourite", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func favouriteError(method string, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, usecase.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, usecase.ErrInvalidFavouriteName),
+		errors.Is(err, usecase.ErrInvalidLotteryType),
+		errors.Is(err, usecase.ErrInvalidNumbers),
+		errors.Is(err, usecase.ErrFavouriteLotteryMismatch):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", method, err)
+	}
+}
@@ -3,33 +3,97 @@ package v1
 import (
 	"context"
 	"errors"
+	"fmt"
+	"github.com/MaxFando/lms/platform/auth"
 	ticketservicev1 "github.com/MaxFando/lms/ticket-service/api/grpc/gen/go/ticket-service/v1"
 	"github.com/MaxFando/lms/ticket-service/internal/converter"
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
 	"github.com/MaxFando/lms/ticket-service/internal/usecase"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"math"
 	"strconv"
+	"time"
 )
 
 type Server struct {
 	ticketservicev1.UnimplementedTicketServiceServer
-	uc *usecase.TicketUsecase
+	uc            *usecase.TicketUsecase
+	subscriptions *usecase.SubscriptionUsecase
+	carts         *usecase.CartUsecase
+	transfers     *usecase.TransferUsecase
+	stats         *usecase.StatsUsecase
+	favourites    *usecase.FavouriteUsecase
+	syndicates    *usecase.SyndicateUsecase
 }
 
-func NewServer(uc *usecase.TicketUsecase) *Server {
-	return &Server{uc: uc}
+func NewServer(
+	uc *usecase.TicketUsecase,
+	subscriptions *usecase.SubscriptionUsecase,
+	carts *usecase.CartUsecase,
+	transfers *usecase.TransferUsecase,
+	stats *usecase.StatsUsecase,
+	favourites *usecase.FavouriteUsecase,
+	syndicates *usecase.SyndicateUsecase,
+) *Server {
+	return &Server{
+		uc:            uc,
+		subscriptions: subscriptions,
+		carts:         carts,
+		transfers:     transfers,
+		favourites:    favourites,
+		syndicates:    syndicates,
+		stats:         stats,
+	}
 }
 
 func (s *Server) GetTicket(ctx context.Context, req *ticketservicev1.GetTicketRequest) (*ticketservicev1.Ticket, error) {
-	t, err := s.uc.GetTicket(ctx, req.TicketId)
+	t, err := s.ownedTicket(ctx, "GetTicket", req.TicketId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "GetTicket: %v", err)
+		return nil, err
 	}
 
 	return converter.ToTicketServiceFromEntity(t), nil
 }
 
+func (s *Server) VerifyTicket(ctx context.Context, req *ticketservicev1.VerifyTicketRequest) (*ticketservicev1.TicketVerification, error) {
+	t, err := s.uc.VerifyTicket(ctx, req.Serial)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidSerial):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, usecase.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "ticket not found")
+		default:
+			return nil, status.Errorf(codes.Internal, "VerifyTicket: %v", err)
+		}
+	}
+
+	return converter.ToTicketVerificationServiceFromEntity(t), nil
+}
+
 func (s *Server) CreateTicket(ctx context.Context, req *ticketservicev1.CreateTicketRequest) (*ticketservicev1.Ticket, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.FavouriteId != nil {
+		if len(req.Numbers) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "numbers and favourite_id are mutually exclusive")
+		}
+		f, err := s.favourites.Resolve(ctx, caller.UserID, req.FavouriteId.GetValue())
+		if err != nil {
+			return nil, favouriteError("CreateTicket", err)
+		}
+		if err := s.uc.CheckFavourite(ctx, req.DrawId, f); err != nil {
+			return nil, favouriteError("CreateTicket", err)
+		}
+		req.Numbers = f.Numbers
+	}
+
 	for i, sNum := range req.Numbers {
 		_, err := strconv.Atoi(sNum)
 		if err != nil {
@@ -37,37 +101,137 @@ func (s *Server) CreateTicket(ctx context.Context, req *ticketservicev1.CreateTi
 		}
 	}
 
-	t, err := s.uc.CreateTicket(ctx, req.UserId, req.DrawId, req.Numbers)
+	t, invoiceID, err := s.uc.CreateTicket(ctx, caller.UserID, req.DrawId, req.Numbers)
 	if err != nil {
 		switch {
 		case errors.Is(err, usecase.ErrDrawNotActive):
 			return nil, status.Error(codes.FailedPrecondition, err.Error())
 		case errors.Is(err, usecase.ErrInvalidNumbers):
 			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, usecase.ErrLimitExceeded):
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
 		default:
 			return nil, status.Errorf(codes.Internal, "CreateTicket: %v", err)
 		}
 	}
 
-	return converter.ToTicketServiceFromEntity(t), nil
+	return converter.ToPurchasedTicketServiceFromEntity(t, invoiceID), nil
 }
 
 func (s *Server) ReserveTicket(ctx context.Context, req *ticketservicev1.ReserveTicketRequest) (*ticketservicev1.Ticket, error) {
-	t, err := s.uc.ReserveTicket(ctx, req.TicketId)
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.uc.ReserveTicket(ctx, caller.UserID, req.TicketId)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, usecase.ErrNotTicketOwner):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, usecase.ErrHoldLimit):
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		case errors.Is(err, usecase.ErrInvalidTransition):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, "ReserveTicket: %v", err)
+		}
+	}
+
+	return converter.ToTicketServiceFromEntity(t), nil
+}
+
+func (s *Server) BookTicket(ctx context.Context, req *ticketservicev1.BookTicketRequest) (*ticketservicev1.Ticket, error) {
+	caller, err := callerIdentity(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "ReserveTicket: %v", err)
+		return nil, err
+	}
+
+	t, invoiceID, err := s.uc.BookTicket(ctx, caller.UserID, req.TicketId)
+	if err != nil {
+		return nil, bookingError("BookTicket", err)
+	}
+
+	return converter.ToPurchasedTicketServiceFromEntity(t, invoiceID), nil
+}
+
+func (s *Server) BookAnyTicket(ctx context.Context, req *ticketservicev1.BookAnyTicketRequest) (*ticketservicev1.Ticket, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t, invoiceID, err := s.uc.BookAnyTicket(ctx, caller.UserID, req.DrawId, req.PreferredNumbers)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidNumbers):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, usecase.ErrNoFreeTickets):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, usecase.ErrLimitExceeded):
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, "BookAnyTicket: %v", err)
+		}
+	}
+
+	return converter.ToPurchasedTicketServiceFromEntity(t, invoiceID), nil
+}
+
+func (s *Server) BookTicketInternal(ctx context.Context, req *ticketservicev1.BookTicketRequest) (*ticketservicev1.Ticket, error) {
+	if err := internalCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	t, err := s.uc.HoldForInvoice(ctx, req.UserId, req.TicketId)
+	if err != nil {
+		return nil, bookingError("BookTicketInternal", err)
 	}
 
 	return converter.ToTicketServiceFromEntity(t), nil
 }
 
+func (s *Server) ReleaseTicketsInternal(ctx context.Context, req *ticketservicev1.ReleaseTicketsRequest) (*emptypb.Empty, error) {
+	if err := internalCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.ReleaseBookings(ctx, req.TicketIds); err != nil {
+		return nil, status.Errorf(codes.Internal, "ReleaseTicketsInternal: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *Server) ListUserTickets(ctx context.Context, req *ticketservicev1.ListUserTicketsRequest) (*ticketservicev1.ListUserTicketsResponse, error) {
-	tickets, err := s.uc.ListUserTickets(ctx, req.UserId)
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID := req.UserId
+	if userID == 0 {
+		userID = caller.UserID
+	}
+	if !caller.CanAccess(userID) {
+		return nil, status.Error(codes.PermissionDenied, "tickets of another user")
+	}
+
+	f, err := ticketFilter(req.DrawId, req.Statuses, req.CreatedFrom, req.CreatedTo, req.Order)
 	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tickets, next, err := s.uc.ListUserTickets(ctx, userID, f, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, listError("ListUserTickets", err)
+	}
+	if err := s.syndicates.AttachShares(ctx, userID, tickets); err != nil {
 		return nil, status.Errorf(codes.Internal, "ListUserTickets: %v", err)
 	}
 
-	resp := &ticketservicev1.ListUserTicketsResponse{}
+	resp := &ticketservicev1.ListUserTicketsResponse{NextPageToken: next}
 	for _, t := range tickets {
 		resp.Tickets = append(resp.Tickets, converter.ToTicketWithDrawServiceFromEntity(t))
 	}
@@ -75,11 +239,16 @@ func (s *Server) ListUserTickets(ctx context.Context, req *ticketservicev1.ListU
 }
 
 func (s *Server) ListAvailableTickets(ctx context.Context, req *ticketservicev1.ListAvailableTicketsRequest) (*ticketservicev1.ListAvailableTicketsResponse, error) {
-	tickets, err := s.uc.ListAvailableTickets(ctx)
+	f, err := ticketFilter(req.DrawId, nil, req.CreatedFrom, req.CreatedTo, req.Order)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "ListAvailableTickets: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	resp := &ticketservicev1.ListAvailableTicketsResponse{}
+
+	tickets, next, err := s.uc.ListAvailableTickets(ctx, f, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, listError("ListAvailableTickets", err)
+	}
+	resp := &ticketservicev1.ListAvailableTicketsResponse{NextPageToken: next}
 	for _, t := range tickets {
 		resp.Tickets = append(resp.Tickets, converter.ToTicketServiceFromEntity(t))
 	}
@@ -99,11 +268,156 @@ func (s *Server) SetWinningTickets(ctx context.Context, req *ticketservicev1.Set
 }
 
 func (s *Server) CheckResult(ctx context.Context, req *ticketservicev1.CheckResultRequest) (*ticketservicev1.CheckResultResponse, error) {
-	st, err := s.uc.CheckResult(ctx, req.TicketId)
+	t, err := s.ownedTicket(ctx, "CheckResult", req.TicketId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "CheckResult: %v", err)
+		return nil, err
 	}
 	return &ticketservicev1.CheckResultResponse{
-		Status: st,
+		Status: string(t.Status),
 	}, nil
 }
+
+func (s *Server) GetDrawSummary(ctx context.Context, req *ticketservicev1.GetDrawSummaryRequest) (*ticketservicev1.DrawSummary, error) {
+	summary, err := s.uc.GetDrawSummary(ctx, req.DrawId)
+	if err != nil {
+		if errors.Is(err, usecase.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "GetDrawSummary: %v", err)
+	}
+
+	return converter.ToDrawSummaryServiceFromEntity(summary), nil
+}
+
+func (s *Server) ListDrawWinners(ctx context.Context, req *ticketservicev1.ListDrawWinnersRequest) (*ticketservicev1.ListDrawWinnersResponse, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// сервис платежей сверяет по списку выплаты выигрышей
+	if !caller.IsAdmin() && !caller.IsService() {
+		return nil, status.Error(codes.PermissionDenied, "winners list is available to admins only")
+	}
+
+	winners, err := s.uc.ListDrawWinners(ctx, req.DrawId)
+	if err != nil {
+		if errors.Is(err, usecase.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "ListDrawWinners: %v", err)
+	}
+	if err := s.syndicates.AttachPayouts(ctx, winners); err != nil {
+		return nil, status.Errorf(codes.Internal, "ListDrawWinners: %v", err)
+	}
+
+	resp := &ticketservicev1.ListDrawWinnersResponse{}
+	for _, w := range winners {
+		resp.Winners = append(resp.Winners, converter.ToWinnerServiceFromEntity(w))
+	}
+	return resp, nil
+}
+
+// ownedTicket возвращает билет, если его владелец - вызывающий пользователь; свободные билеты видны всем
+func (s *Server) ownedTicket(ctx context.Context, method string, ticketID int32) (*entity.Ticket, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.uc.GetTicket(ctx, ticketID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "%s: %v", method, err)
+	}
+	if t.UserID != nil && !caller.CanAccess(*t.UserID) {
+		return nil, status.Error(codes.PermissionDenied, "ticket belongs to another user")
+	}
+	return t, nil
+}
+
+// caller - пользователь из access-токена; в сервисе билетов идентификаторы пользователей 32-битные
+type caller struct {
+	auth.Identity
+	UserID int32
+}
+
+func (c caller) CanAccess(userID int32) bool {
+	return c.Identity.CanAccess(int64(userID))
+}
+
+func callerIdentity(ctx context.Context) (caller, error) {
+	id, ok := auth.FromContext(ctx)
+	if !ok {
+		return caller{}, status.Error(codes.Unauthenticated, "access token required")
+	}
+	if id.UserID > math.MaxInt32 {
+		return caller{}, status.Error(codes.Unauthenticated, "user id out of range")
+	}
+	return caller{Identity: id, UserID: int32(id.UserID)}, nil
+}
+
+// internalCaller пропускает к внутренним методам только другие сервисы и администраторов
+func internalCaller(ctx context.Context) error {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return err
+	}
+	if !caller.IsService() && !caller.IsAdmin() {
+		return status.Error(codes.PermissionDenied, "service credentials required")
+	}
+	return nil
+}
+
+func bookingError(method string, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrTicketUnavailable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, usecase.ErrLimitExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", method, err)
+	}
+}
+
+func ticketFilter(
+	drawID *wrapperspb.Int32Value,
+	statuses []string,
+	createdFrom, createdTo string,
+	order ticketservicev1.SortOrder,
+) (entity.TicketFilter, error) {
+	f := entity.TicketFilter{Order: entity.SortNewestFirst}
+	if order == ticketservicev1.SortOrder_SORT_ORDER_OLDEST_FIRST {
+		f.Order = entity.SortOldestFirst
+	}
+	if drawID != nil {
+		id := drawID.GetValue()
+		f.DrawID = &id
+	}
+	for _, st := range statuses {
+		f.Statuses = append(f.Statuses, entity.Status(st))
+	}
+	if createdFrom != "" {
+		t, err := time.Parse(time.RFC3339, createdFrom)
+		if err != nil {
+			return f, fmt.Errorf("created_from invalid: %w", err)
+		}
+		f.CreatedFrom = &t
+	}
+	if createdTo != "" {
+		t, err := time.Parse(time.RFC3339, createdTo)
+		if err != nil {
+			return f, fmt.Errorf("created_to invalid: %w", err)
+		}
+		f.CreatedTo = &t
+	}
+	return f, nil
+}
+
+func listError(method string, err error) error {
+	if errors.Is(err, usecase.ErrInvalidFilter) || errors.Is(err, usecase.ErrInvalidPageToken) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Errorf(codes.Internal, "%s: %v", method, err)
+}
@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	ticketservicev1 "github.com/MaxFando/lms/ticket-service/api/grpc/gen/go/ticket-service/v1"
+	"github.com/MaxFando/lms/ticket-service/internal/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) GetNumberStats(ctx context.Context, req *ticketservicev1.GetNumberStatsRequest) (*ticketservicev1.GetNumberStatsResponse, error) {
+	var from time.Time
+	to := time.Now()
+	if req.From != "" {
+		t, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "from invalid: %v", err)
+		}
+		from = t
+	}
+	if req.To != "" {
+		t, err := time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "to invalid: %v", err)
+		}
+		to = t
+	}
+
+	stats, draws, err := s.stats.NumberStats(ctx, req.LotteryType, from, to)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidLotteryType) || errors.Is(err, usecase.ErrInvalidPeriod) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "GetNumberStats: %v", err)
+	}
+
+	resp := &ticketservicev1.GetNumberStatsResponse{
+		LotteryType: req.LotteryType,
+		Draws:       int32(draws),
+	}
+	for _, st := range stats {
+		resp.Numbers = append(resp.Numbers, &ticketservicev1.NumberStat{
+			Number:     int32(st.Number),
+			Drawn:      int32(st.Drawn),
+			Picks:      int32(st.Picks),
+			CurrentGap: int32(st.CurrentGap),
+			LongestGap: int32(st.LongestGap),
+		})
+	}
+	return resp, nil
+}
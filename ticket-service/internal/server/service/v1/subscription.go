@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	ticketservicev1 "github.com/MaxFando/lms/ticket-service/api/grpc/gen/go/ticket-service/v1"
+	"github.com/MaxFando/lms/ticket-service/internal/converter"
+	"github.com/MaxFando/lms/ticket-service/internal/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) CreateSubscription(ctx context.Context, req *ticketservicev1.CreateSubscriptionRequest) (*ticketservicev1.Subscription, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := s.subscriptions.CreateSubscription(ctx, caller.UserID, req.LotteryType, req.Numbers, req.DrawsCount)
+	if err != nil {
+		return nil, subscriptionError("CreateSubscription", err)
+	}
+
+	return converter.ToSubscriptionServiceFromEntity(sub), nil
+}
+
+func (s *Server) ListUserSubscriptions(ctx context.Context, req *ticketservicev1.ListUserSubscriptionsRequest) (*ticketservicev1.ListUserSubscriptionsResponse, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID := req.UserId
+	if userID == 0 {
+		userID = caller.UserID
+	}
+	if !caller.CanAccess(userID) {
+		return nil, status.Error(codes.PermissionDenied, "subscriptions of another user")
+	}
+
+	subs, err := s.subscriptions.ListUserSubscriptions(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "ListUserSubscriptions: %v", err)
+	}
+
+	resp := &ticketservicev1.ListUserSubscriptionsResponse{}
+	for _, sub := range subs {
+		resp.Subscriptions = append(resp.Subscriptions, converter.ToSubscriptionServiceFromEntity(sub))
+	}
+	return resp, nil
+}
+
+func (s *Server) CancelSubscription(ctx context.Context, req *ticketservicev1.CancelSubscriptionRequest) (*ticketservicev1.Subscription, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := s.subscriptions.CancelSubscription(ctx, caller.UserID, req.SubscriptionId)
+	if err != nil {
+		return nil, subscriptionError("CancelSubscription", err)
+	}
+
+	return converter.ToSubscriptionServiceFromEntity(sub), nil
+}
+
+func (s *Server) ResumeSubscription(ctx context.Context, req *ticketservicev1.ResumeSubscriptionRequest) (*ticketservicev1.Subscription, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := s.subscriptions.ResumeSubscription(ctx, caller.UserID, req.SubscriptionId)
+	if err != nil {
+		return nil, subscriptionError("ResumeSubscription", err)
+	}
+
+	return converter.ToSubscriptionServiceFromEntity(sub), nil
+}
+
+func subscriptionError(method string, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, usecase.ErrInvalidTransition):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, usecase.ErrInvalidLotteryType),
+		errors.Is(err, usecase.ErrInvalidNumbers),
+		errors.Is(err, usecase.ErrInvalidDrawsCount):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", method, err)
+	}
+}
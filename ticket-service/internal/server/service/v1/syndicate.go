@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	ticketservicev1 "github.com/MaxFando/lms/ticket-service/api/grpc/gen/go/ticket-service/v1"
+	"github.com/MaxFando/lms/ticket-service/internal/converter"
+	"github.com/MaxFando/lms/ticket-service/internal/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *Server) CreateSyndicate(ctx context.Context, req *ticketservicev1.CreateSyndicateRequest) (*ticketservicev1.Syndicate, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sd, err := s.syndicates.CreateSyndicate(ctx, caller.UserID, req.Name, req.Shares)
+	if err != nil {
+		return nil, syndicateError("CreateSyndicate", err)
+	}
+
+	return converter.ToSyndicateServiceFromEntity(sd), nil
+}
+
+func (s *Server) GetSyndicate(ctx context.Context, req *ticketservicev1.SyndicateRequest) (*ticketservicev1.Syndicate, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sd, err := s.syndicates.GetSyndicate(ctx, caller.UserID, req.SyndicateId)
+	if err != nil {
+		return nil, syndicateError("GetSyndicate", err)
+	}
+
+	return converter.ToSyndicateServiceFromEntity(sd), nil
+}
+
+func (s *Server) ListSyndicates(ctx context.Context, _ *emptypb.Empty) (*ticketservicev1.ListSyndicatesResponse, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	syndicates, err := s.syndicates.ListSyndicates(ctx, caller.UserID)
+	if err != nil {
+		return nil, syndicateError("ListSyndicates", err)
+	}
+
+	resp := &ticketservicev1.ListSyndicatesResponse{}
+	for _, sd := range syndicates {
+		resp.Syndicates = append(resp.Syndicates, converter.ToSyndicateServiceFromEntity(sd))
+	}
+	return resp, nil
+}
+
+func (s *Server) InviteToSyndicate(ctx context.Context, req *ticketservicev1.InviteToSyndicateRequest) (*ticketservicev1.Syndicate, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sd, err := s.syndicates.Invite(ctx, caller.UserID, req.SyndicateId, req.UserId, req.Shares)
+	if err != nil {
+		return nil, syndicateError("InviteToSyndicate", err)
+	}
+
+	return converter.ToSyndicateServiceFromEntity(sd), nil
+}
+
+func (s *Server) AcceptSyndicateInvite(ctx context.Context, req *ticketservicev1.SyndicateRequest) (*ticketservicev1.Syndicate, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sd, err := s.syndicates.AcceptInvite(ctx, caller.UserID, req.SyndicateId)
+	if err != nil {
+		return nil, syndicateError("AcceptSyndicateInvite", err)
+	}
+
+	return converter.ToSyndicateServiceFromEntity(sd), nil
+}
+
+func (s *Server) DeclineSyndicateInvite(ctx context.Context, req *ticketservicev1.SyndicateRequest) (*ticketservicev1.Syndicate, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sd, err := s.syndicates.DeclineInvite(ctx, caller.UserID, req.SyndicateId)
+	if err != nil {
+		return nil, syndicateError("DeclineSyndicateInvite", err)
+	}
+
+	return converter.ToSyndicateServiceFromEntity(sd), nil
+}
+
+func (s *Server) AddSyndicateTicket(ctx context.Context, req *ticketservicev1.AddSyndicateTicketRequest) (*ticketservicev1.Syndicate, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sd, err := s.syndicates.AddTicket(ctx, caller.UserID, req.SyndicateId, req.TicketId)
+	if err != nil {
+		return nil, syndicateError("AddSyndicateTicket", err)
+	}
+
+	return converter.ToSyndicateServiceFromEntity(sd), nil
+}
+
+func syndicateError(method string, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, usecase.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, usecase.ErrInvalidSyndicateName),
+		errors.Is(err, usecase.ErrInvalidShares):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrNotSyndicateOwner),
+		errors.Is(err, usecase.ErrNotTicketOwner):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, usecase.ErrInvalidTransition),
+		errors.Is(err, usecase.ErrTransferNotAllowed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", method, err)
+	}
+}
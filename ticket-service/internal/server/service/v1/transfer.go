@@ -0,0 +1,111 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	ticketservicev1 "github.com/MaxFando/lms/ticket-service/api/grpc/gen/go/ticket-service/v1"
+	"github.com/MaxFando/lms/ticket-service/internal/converter"
+	"github.com/MaxFando/lms/ticket-service/internal/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) TransferTicket(ctx context.Context, req *ticketservicev1.TransferTicketRequest) (*ticketservicev1.Transfer, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.transfers.TransferTicket(ctx, caller.UserID, req.TicketId, req.ToUserId)
+	if err != nil {
+		return nil, transferError("TransferTicket", err)
+	}
+
+	return converter.ToTransferServiceFromEntity(t), nil
+}
+
+func (s *Server) AcceptTransfer(ctx context.Context, req *ticketservicev1.TransferActionRequest) (*ticketservicev1.Transfer, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.transfers.AcceptTransfer(ctx, caller.UserID, req.TransferId)
+	if err != nil {
+		return nil, transferError("AcceptTransfer", err)
+	}
+
+	return converter.ToTransferServiceFromEntity(t), nil
+}
+
+func (s *Server) DeclineTransfer(ctx context.Context, req *ticketservicev1.TransferActionRequest) (*ticketservicev1.Transfer, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.transfers.DeclineTransfer(ctx, caller.UserID, req.TransferId)
+	if err != nil {
+		return nil, transferError("DeclineTransfer", err)
+	}
+
+	return converter.ToTransferServiceFromEntity(t), nil
+}
+
+func (s *Server) CancelTransfer(ctx context.Context, req *ticketservicev1.TransferActionRequest) (*ticketservicev1.Transfer, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.transfers.CancelTransfer(ctx, caller.UserID, req.TransferId)
+	if err != nil {
+		return nil, transferError("CancelTransfer", err)
+	}
+
+	return converter.ToTransferServiceFromEntity(t), nil
+}
+
+func (s *Server) ListTransfers(ctx context.Context, req *ticketservicev1.ListTransfersRequest) (*ticketservicev1.ListTransfersResponse, error) {
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID := req.UserId
+	if userID == 0 {
+		userID = caller.UserID
+	}
+	if !caller.CanAccess(userID) {
+		return nil, status.Error(codes.PermissionDenied, "transfers of another user")
+	}
+
+	transfers, err := s.transfers.ListTransfers(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "ListTransfers: %v", err)
+	}
+
+	resp := &ticketservicev1.ListTransfersResponse{}
+	for _, t := range transfers {
+		resp.Transfers = append(resp.Transfers, converter.ToTransferServiceFromEntity(t))
+	}
+	return resp, nil
+}
+
+func transferError(method string, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, usecase.ErrSelfTransfer):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrNotTicketOwner):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, usecase.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, usecase.ErrTransferNotAllowed),
+		errors.Is(err, usecase.ErrInvalidTransition):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", method, err)
+	}
+}
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"encoding/json"
+
+	"github.com/MaxFando/lms/platform/logger"
 	"github.com/MaxFando/lms/ticket-service/internal/entity"
 	"github.com/MaxFando/lms/ticket-service/internal/usecase"
 	"github.com/redis/go-redis/v9"
@@ -11,16 +13,29 @@ import (
 type DrawEventHandler struct {
 	redisClient    *redis.Client
 	ticketUsecase  *usecase.TicketUsecase
+	subscriptions  *usecase.SubscriptionUsecase
+	stats          *usecase.StatsUsecase
 	ticketsPerDraw int
 	channel        string
+	log            logger.Logger
 }
 
-func NewDrawEventHandler(rdb *redis.Client, uc *usecase.TicketUsecase, channel string, ticketsPerDraw int) *DrawEventHandler {
+func NewDrawEventHandler(
+	rdb *redis.Client,
+	uc *usecase.TicketUsecase,
+	subscriptions *usecase.SubscriptionUsecase,
+	stats *usecase.StatsUsecase,
+	channel string,
+	ticketsPerDraw int,
+) *DrawEventHandler {
 	return &DrawEventHandler{
 		redisClient:    rdb,
 		ticketUsecase:  uc,
+		subscriptions:  subscriptions,
+		stats:          stats,
 		channel:        channel,
 		ticketsPerDraw: ticketsPerDraw,
+		log:            logger.NewLogger().With("app", "lms", "component", "ticket-service", "layer", "draw_events"),
 	}
 }
 
@@ -37,17 +52,32 @@ func (h *DrawEventHandler) Run(ctx context.Context) error {
 				Draw entity.Draw `json:"draw"`
 			}
 			if err := json.Unmarshal([]byte(msg.Payload), &ev); err != nil {
-				// todo log
-				continue
-			}
-			if ev.Type != entity.EventTypeDrawActivated {
-				// todo log
+				h.log.Error(ctx, "failed to decode draw event", "error", err)
 				continue
 			}
-			err := h.ticketUsecase.GenerateTickets(ctx, ev.Draw.ID, h.ticketsPerDraw)
-			if err != nil {
-				// todo log
+			switch ev.Type {
+			case entity.EventTypeDrawActivated:
+				h.handleActivated(ctx, &ev.Draw)
+			case entity.EventTypeDrawCancelled:
+				if err := h.ticketUsecase.CancelDraw(ctx, ev.Draw.ID); err != nil {
+					h.log.Error(ctx, "failed to cancel draw tickets", "draw_id", ev.Draw.ID, "error", err)
+				}
+			case entity.EventTypeDrawCompleted:
+				if err := h.stats.Sync(ctx); err != nil {
+					h.log.Error(ctx, "failed to sync number stats", "draw_id", ev.Draw.ID, "error", err)
+				}
+			default:
+				h.log.Debug(ctx, "skipping draw event", "type", ev.Type, "draw_id", ev.Draw.ID)
 			}
 		}
 	}
 }
+
+func (h *DrawEventHandler) handleActivated(ctx context.Context, draw *entity.Draw) {
+	if err := h.ticketUsecase.GenerateTickets(ctx, draw.ID, h.ticketsPerDraw); err != nil {
+		h.log.Error(ctx, "failed to generate tickets", "draw_id", draw.ID, "error", err)
+	}
+	if err := h.subscriptions.ProcessDrawActivated(ctx, draw); err != nil {
+		h.log.Error(ctx, "failed to process subscriptions", "draw_id", draw.ID, "error", err)
+	}
+}
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"encoding/json"
+
+	"github.com/MaxFando/lms/platform/logger"
 	"github.com/MaxFando/lms/ticket-service/internal/usecase"
 	"github.com/redis/go-redis/v9"
 )
@@ -10,14 +12,29 @@ import (
 type InvoiceEventHandler struct {
 	redisClient   *redis.Client
 	ticketUsecase *usecase.TicketUsecase
+	subscriptions *usecase.SubscriptionUsecase
+	carts         *usecase.CartUsecase
+	limiter       *usecase.BookingLimiter
 	channel       string
+	log           logger.Logger
 }
 
-func NewInvoiceEventHandler(rdb *redis.Client, uc *usecase.TicketUsecase, channel string) *InvoiceEventHandler {
+func NewInvoiceEventHandler(
+	rdb *redis.Client,
+	uc *usecase.TicketUsecase,
+	subscriptions *usecase.SubscriptionUsecase,
+	carts *usecase.CartUsecase,
+	limiter *usecase.BookingLimiter,
+	channel string,
+) *InvoiceEventHandler {
 	return &InvoiceEventHandler{
 		redisClient:   rdb,
 		ticketUsecase: uc,
+		subscriptions: subscriptions,
+		carts:         carts,
+		limiter:       limiter,
 		channel:       channel,
+		log:           logger.NewLogger().With("app", "lms", "component", "ticket-service", "layer", "invoice_events"),
 	}
 }
 
@@ -30,20 +47,60 @@ func (h *InvoiceEventHandler) Run(ctx context.Context) error {
 			return ctx.Err()
 		case msg := <-pubsub.Channel():
 			var ev struct {
-				Type     string `json:"type"`
-				TicketID int32  `json:"ticket_id"`
+				Type      string  `json:"type"`
+				InvoiceID int64   `json:"invoice_id"`
+				TicketIDs []int32 `json:"ticket_ids"`
+				UserID    int32   `json:"user_id"`
 			}
 			if err := json.Unmarshal([]byte(msg.Payload), &ev); err != nil {
-				// todo log
+				h.log.Error(ctx, "failed to decode invoice event", "error", err)
 				continue
 			}
-			if ev.Type == "invoice_overdue" || ev.Type == "invoice_failure" {
-				if err := h.ticketUsecase.ReleaseBooking(ctx, ev.TicketID); err != nil {
-					// todo log
+			switch ev.Type {
+			case "invoice_overdue":
+				if err := h.limiter.RecordOverdue(ctx, ev.UserID, ev.InvoiceID, ev.TicketIDs); err != nil {
+					h.log.Error(ctx, "failed to record overdue invoice", "invoice_id", ev.InvoiceID, "error", err)
+				}
+				h.release(ctx, ev.InvoiceID, ev.TicketIDs)
+			case "invoice_failure":
+				h.release(ctx, ev.InvoiceID, ev.TicketIDs)
+			case "invoice_paid":
+				h.confirm(ctx, ev.InvoiceID, ev.UserID, ev.TicketIDs)
+			case "invoice_refunded":
+				if err := h.ticketUsecase.RefundTickets(ctx, ev.TicketIDs); err != nil {
+					h.log.Error(ctx, "failed to refund tickets", "invoice_id", ev.InvoiceID, "ticket_ids", ev.TicketIDs, "error", err)
 				}
-			} else {
-				// todo log
+			default:
+				h.log.Debug(ctx, "skipping invoice event", "type", ev.Type, "invoice_id", ev.InvoiceID)
 			}
 		}
 	}
 }
+
+// release снимает бронь со всех билетов неоплаченного счёта
+func (h *InvoiceEventHandler) release(ctx context.Context, invoiceID int64, ticketIDs []int32) {
+	if err := h.ticketUsecase.ReleaseBookings(ctx, ticketIDs); err != nil {
+		h.log.Error(ctx, "failed to release bookings", "invoice_id", invoiceID, "ticket_ids", ticketIDs, "error", err)
+	}
+	for _, id := range ticketIDs {
+		if err := h.subscriptions.HandlePaymentFailure(ctx, id); err != nil {
+			h.log.Error(ctx, "failed to pause subscription", "ticket_id", id, "error", err)
+		}
+	}
+	if invoiceID == 0 {
+		return
+	}
+	if err := h.carts.HandleInvoiceReleased(ctx, invoiceID); err != nil {
+		h.log.Error(ctx, "failed to release cart", "invoice_id", invoiceID, "error", err)
+	}
+}
+
+// confirm закрепляет за пользователем билеты оплаченного счёта
+func (h *InvoiceEventHandler) confirm(ctx context.Context, invoiceID int64, userID int32, ticketIDs []int32) {
+	if err := h.ticketUsecase.ConfirmPayment(ctx, invoiceID, userID, ticketIDs); err != nil {
+		h.log.Error(ctx, "failed to confirm payment", "invoice_id", invoiceID, "ticket_ids", ticketIDs, "error", err)
+	}
+	if err := h.carts.HandleInvoicePaid(ctx, invoiceID); err != nil {
+		h.log.Error(ctx, "failed to mark cart paid", "invoice_id", invoiceID, "error", err)
+	}
+}
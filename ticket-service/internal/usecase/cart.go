@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MaxFando/lms/platform/logger"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+	"github.com/MaxFando/lms/ticket-service/internal/repository"
+)
+
+var (
+	ErrCartEmpty         = errors.New("cart is empty")
+	ErrTicketUnavailable = repository.ErrTicketUnavailable
+)
+
+type CartUsecase struct {
+	repo     repository.CartRepository
+	payments invoiceService
+	limiter  *BookingLimiter
+	holdTTL  time.Duration
+	log      logger.Logger
+}
+
+func NewCartUsecase(
+	repo repository.CartRepository,
+	payments invoiceService,
+	limiter *BookingLimiter,
+	holdTTL time.Duration,
+) *CartUsecase {
+	return &CartUsecase{
+		repo:     repo,
+		payments: payments,
+		limiter:  limiter,
+		holdTTL:  holdTTL,
+		log:      logger.NewLogger().With("app", "lms", "component", "ticket-service", "layer", "usecase"),
+	}
+}
+
+func (u *CartUsecase) GetCart(ctx context.Context, userID int32) (*entity.Cart, error) {
+	c, err := u.repo.GetOpen(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get cart: %w", err)
+	}
+	return c, nil
+}
+
+func (u *CartUsecase) AddToCart(ctx context.Context, userID, ticketID int32) (*entity.Cart, error) {
+	if err := u.repo.AddTicket(ctx, userID, ticketID, time.Now().Add(u.holdTTL), u.limiter.Limits()); err != nil {
+		return nil, fmt.Errorf("add to cart: %w", err)
+	}
+	return u.GetCart(ctx, userID)
+}
+
+func (u *CartUsecase) RemoveFromCart(ctx context.Context, userID, ticketID int32) (*entity.Cart, error) {
+	if err := u.repo.RemoveTicket(ctx, userID, ticketID); err != nil {
+		return nil, fmt.Errorf("remove from cart: %w", err)
+	}
+	return u.GetCart(ctx, userID)
+}
+
+// Checkout выставляет один счёт на все билеты открытой корзины
+func (u *CartUsecase) Checkout(ctx context.Context, userID int32) (*entity.Cart, error) {
+	c, err := u.repo.StartCheckout(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("start checkout: %w", err)
+	}
+
+	if len(c.Tickets) == 0 {
+		u.abort(ctx, c.ID)
+		return nil, ErrCartEmpty
+	}
+
+	invoiceID, err := u.payments.CreateInvoice(ctx, userID, c.TicketIDs()...)
+	if err != nil {
+		u.abort(ctx, c.ID)
+		return nil, fmt.Errorf("checkout cart: %w", err)
+	}
+
+	cartID := c.ID
+	c, err = u.repo.CompleteCheckout(ctx, cartID, invoiceID)
+	if err != nil {
+		if cErr := u.payments.CancelInvoice(ctx, invoiceID); cErr != nil {
+			u.log.Error(ctx, "failed to cancel cart invoice", "cart_id", cartID, "invoice_id", invoiceID, "error", cErr)
+		}
+		u.abort(ctx, cartID)
+		return nil, fmt.Errorf("complete checkout: %w", err)
+	}
+	return c, nil
+}
+
+// HandleInvoiceReleased закрывает корзину, счёт по которой не был оплачен
+func (u *CartUsecase) HandleInvoiceReleased(ctx context.Context, invoiceID int64) error {
+	if err := u.repo.ReleaseByInvoice(ctx, invoiceID); err != nil {
+		return fmt.Errorf("release cart: %w", err)
+	}
+	return nil
+}
+
+// HandleInvoicePaid закрывает корзину оплаченного счёта
+func (u *CartUsecase) HandleInvoicePaid(ctx context.Context, invoiceID int64) error {
+	if err := u.repo.MarkPaidByInvoice(ctx, invoiceID); err != nil {
+		return fmt.Errorf("mark cart paid: %w", err)
+	}
+	return nil
+}
+
+func (u *CartUsecase) abort(ctx context.Context, cartID int32) {
+	if err := u.repo.AbortCheckout(ctx, cartID, time.Now().Add(u.holdTTL)); err != nil {
+		u.log.Error(ctx, "failed to abort cart checkout", "cart_id", cartID, "error", err)
+	}
+}
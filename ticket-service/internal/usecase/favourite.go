@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+	"github.com/MaxFando/lms/ticket-service/internal/repository"
+	"github.com/MaxFando/lms/ticket-service/pkg/lottery"
+)
+
+const maxFavouriteNameLen = 100
+
+var (
+	ErrInvalidFavouriteName = errors.New("invalid favourite name")
+	// ErrFavouriteLotteryMismatch - набор сохранён для другой лотереи, чем у тиража
+	ErrFavouriteLotteryMismatch = errors.New("favourite is saved for another lottery")
+)
+
+type FavouriteUsecase struct {
+	repo repository.FavouriteRepository
+}
+
+func NewFavouriteUsecase(repo repository.FavouriteRepository) *FavouriteUsecase {
+	return &FavouriteUsecase{
+		repo: repo,
+	}
+}
+
+func (u *FavouriteUsecase) SaveFavourite(
+	ctx context.Context,
+	userID int32,
+	name string,
+	lotteryType string,
+	numbers []string,
+) (*entity.Favourite, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > maxFavouriteNameLen {
+		return nil, ErrInvalidFavouriteName
+	}
+	count, maxNum, err := lottery.ParseType(lotteryType)
+	if err != nil {
+		return nil, ErrInvalidLotteryType
+	}
+	if err := validateNumbers(numbers, count, maxNum); err != nil {
+		return nil, err
+	}
+
+	f, err := u.repo.Create(ctx, &entity.Favourite{
+		UserID:      userID,
+		Name:        name,
+		LotteryType: lotteryType,
+		Numbers:     numbers,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("save favourite: %w", err)
+	}
+	return f, nil
+}
+
+func (u *FavouriteUsecase) ListFavourites(ctx context.Context, userID int32) ([]*entity.Favourite, error) {
+	favourites, err := u.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list favourites: %w", err)
+	}
+	return favourites, nil
+}
+
+func (u *FavouriteUsecase) DeleteFavourite(ctx context.Context, userID, id int32) error {
+	if err := u.repo.Delete(ctx, id, userID); err != nil {
+		return fmt.Errorf("delete favourite: %w", err)
+	}
+	return nil
+}
+
+// Resolve возвращает сохранённый набор пользователя; чужие наборы не видны
+func (u *FavouriteUsecase) Resolve(ctx context.Context, userID, id int32) (*entity.Favourite, error) {
+	f, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get favourite: %w", err)
+	}
+	if f.UserID != userID {
+		return nil, fmt.Errorf("favourite %d of user %d: %w", id, userID, ErrNotFound)
+	}
+	return f, nil
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+	"github.com/MaxFando/lms/ticket-service/internal/repository"
+)
+
+var ErrLimitExceeded = repository.ErrLimitExceeded
+
+// BookingLimiter следит, чтобы пользователь не скупал и не держал под бронью больше билетов тиража, чем разрешено
+type BookingLimiter struct {
+	repo   repository.LimitRepository
+	limits entity.BookingLimits
+}
+
+func NewBookingLimiter(repo repository.LimitRepository, limits entity.BookingLimits) *BookingLimiter {
+	return &BookingLimiter{
+		repo:   repo,
+		limits: limits,
+	}
+}
+
+// Check проверяет, что пользователь может взять ещё pending билетов тиража.
+// После бронирования вызывается с pending = 0: так ловятся параллельные брони сверх лимита
+func (l *BookingLimiter) Check(ctx context.Context, userID, drawID int32, pending int) error {
+	usage, err := l.repo.Usage(ctx, userID, drawID, time.Now().Add(-l.limits.Cooldown))
+	if err != nil {
+		return fmt.Errorf("booking usage: %w", err)
+	}
+	if reason := l.limits.Violation(usage, pending); reason != "" {
+		return fmt.Errorf("%w: %s", ErrLimitExceeded, reason)
+	}
+	return nil
+}
+
+func (l *BookingLimiter) Limits() entity.BookingLimits {
+	return l.limits
+}
+
+// RecordOverdue учитывает просроченный счёт пользователя
+func (l *BookingLimiter) RecordOverdue(ctx context.Context, userID int32, invoiceID int64, ticketIDs []int32) error {
+	if err := l.repo.RecordOverdue(ctx, userID, invoiceID, ticketIDs); err != nil {
+		return fmt.Errorf("record overdue: %w", err)
+	}
+	return nil
+}
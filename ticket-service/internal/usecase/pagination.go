@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+var (
+	ErrInvalidPageToken = errors.New("invalid page token")
+	ErrInvalidFilter    = errors.New("invalid ticket filter")
+)
+
+// preparePage проверяет фильтр и дополняет его размером страницы и курсором из токена.
+// Лимит запрашивается на один больше, чтобы узнать, есть ли следующая страница.
+func preparePage(f *entity.TicketFilter, pageSize int32, pageToken string) (int, error) {
+	for _, st := range f.Statuses {
+		if !st.Valid() {
+			return 0, ErrInvalidFilter
+		}
+	}
+	if f.CreatedFrom != nil && f.CreatedTo != nil && !f.CreatedFrom.Before(*f.CreatedTo) {
+		return 0, ErrInvalidFilter
+	}
+
+	size := int(pageSize)
+	switch {
+	case size <= 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+	f.Limit = size + 1
+
+	if pageToken != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil {
+			return 0, ErrInvalidPageToken
+		}
+		var c entity.TicketCursor
+		if err := json.Unmarshal(raw, &c); err != nil || c.ID == 0 {
+			return 0, ErrInvalidPageToken
+		}
+		f.After = &c
+	}
+	return size, nil
+}
+
+// cutPage обрезает выборку до размера страницы и возвращает токен следующей страницы
+func cutPage[T any](items []T, size int, cursor func(T) entity.TicketCursor) ([]T, string) {
+	if len(items) <= size {
+		return items, ""
+	}
+	items = items[:size]
+	raw, _ := json.Marshal(cursor(items[size-1]))
+	return items, base64.RawURLEncoding.EncodeToString(raw)
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MaxFando/lms/platform/logger"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+	"github.com/MaxFando/lms/ticket-service/internal/repository"
+	"github.com/MaxFando/lms/ticket-service/pkg/lottery"
+)
+
+// statsSyncBatch - сколько тиражей учитывается за один проход синхронизации
+const statsSyncBatch = 100
+
+var ErrInvalidPeriod = errors.New("invalid stats period")
+
+type StatsUsecase struct {
+	repo repository.StatsRepository
+	log  logger.Logger
+}
+
+func NewStatsUsecase(repo repository.StatsRepository) *StatsUsecase {
+	return &StatsUsecase{
+		repo: repo,
+		log:  logger.NewLogger().With("app", "lms", "component", "ticket-service", "layer", "usecase"),
+	}
+}
+
+// Sync учитывает в статистике чисел тиражи, разыгранные с прошлого прохода
+func (u *StatsUsecase) Sync(ctx context.Context) error {
+	draws, err := u.repo.ListUnrecorded(ctx, statsSyncBatch)
+	if err != nil {
+		u.log.Error(ctx, "failed to list draws for number stats", "error", err)
+		return nil
+	}
+
+	for _, d := range draws {
+		if err := u.record(ctx, d); err != nil {
+			u.log.Error(ctx, "failed to record number stats", "draw_id", d.ID, "error", err)
+		}
+	}
+	return nil
+}
+
+func (u *StatsUsecase) record(ctx context.Context, d *entity.CompletedDraw) error {
+	_, maxNum, err := lottery.ParseType(d.LotteryType)
+	if err != nil {
+		return err
+	}
+
+	var winning []int
+	for _, s := range strings.Split(d.WinningCombination, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil {
+			return fmt.Errorf("parse winning combination %q: %w", d.WinningCombination, err)
+		}
+		winning = append(winning, n)
+	}
+
+	return u.repo.RecordDraw(ctx, d, maxNum, winning)
+}
+
+// NumberStats возвращает частоту выпадения и выбора чисел в тиражах за период [from, to)
+func (u *StatsUsecase) NumberStats(ctx context.Context, lotteryType string, from, to time.Time) ([]entity.NumberStat, int, error) {
+	_, maxNum, err := lottery.ParseType(lotteryType)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", ErrInvalidLotteryType, err)
+	}
+	if !from.Before(to) {
+		return nil, 0, ErrInvalidPeriod
+	}
+
+	rows, err := u.repo.ListNumberStats(ctx, lotteryType, from, to)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list number stats: %w", err)
+	}
+
+	stats, draws := entity.AggregateNumberStats(maxNum, rows)
+	return stats, draws, nil
+}
@@ -0,0 +1,211 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MaxFando/lms/platform/logger"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+	"github.com/MaxFando/lms/ticket-service/internal/repository"
+	"github.com/MaxFando/lms/ticket-service/pkg/lottery"
+)
+
+const maxSubscriptionDraws = 100
+
+var (
+	ErrNotFound           = repository.ErrNotFound
+	ErrInvalidTransition  = repository.ErrInvalidTransition
+	ErrInvalidLotteryType = errors.New("invalid lottery type")
+	ErrInvalidDrawsCount  = errors.New("invalid draws count")
+)
+
+type invoiceService interface {
+	CreateInvoice(ctx context.Context, userID int32, ticketIDs ...int32) (int64, error)
+	CancelInvoice(ctx context.Context, invoiceID int64) error
+}
+
+type SubscriptionUsecase struct {
+	repo     repository.SubscriptionRepository
+	tickets  repository.TicketRepository
+	payments invoiceService
+	holdTTL  time.Duration
+	log      logger.Logger
+}
+
+func NewSubscriptionUsecase(
+	repo repository.SubscriptionRepository,
+	tickets repository.TicketRepository,
+	payments invoiceService,
+	holdTTL time.Duration,
+) *SubscriptionUsecase {
+	return &SubscriptionUsecase{
+		repo:     repo,
+		tickets:  tickets,
+		payments: payments,
+		holdTTL:  holdTTL,
+		log:      logger.NewLogger().With("app", "lms", "component", "ticket-service", "layer", "usecase"),
+	}
+}
+
+func (u *SubscriptionUsecase) CreateSubscription(
+	ctx context.Context,
+	userID int32,
+	lotteryType string,
+	numbers []string,
+	drawsCount int32,
+) (*entity.Subscription, error) {
+	count, maxNum, err := lottery.ParseType(lotteryType)
+	if err != nil {
+		return nil, ErrInvalidLotteryType
+	}
+	if err := validateNumbers(numbers, count, maxNum); err != nil {
+		return nil, err
+	}
+	if drawsCount < 1 || drawsCount > maxSubscriptionDraws {
+		return nil, ErrInvalidDrawsCount
+	}
+
+	s, err := u.repo.Create(ctx, &entity.Subscription{
+		UserID:      userID,
+		LotteryType: lotteryType,
+		Numbers:     numbers,
+		DrawsTotal:  drawsCount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create subscription: %w", err)
+	}
+	return s, nil
+}
+
+func (u *SubscriptionUsecase) ListUserSubscriptions(ctx context.Context, userID int32) ([]*entity.Subscription, error) {
+	subs, err := u.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list subscriptions: %w", err)
+	}
+	return subs, nil
+}
+
+func (u *SubscriptionUsecase) CancelSubscription(ctx context.Context, userID, id int32) (*entity.Subscription, error) {
+	if _, err := u.owned(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	s, err := u.repo.UpdateStatus(ctx, id,
+		[]entity.SubscriptionStatus{entity.SubscriptionStatusActive, entity.SubscriptionStatusPaused},
+		entity.SubscriptionStatusCancelled,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cancel subscription: %w", err)
+	}
+	return s, nil
+}
+
+func (u *SubscriptionUsecase) ResumeSubscription(ctx context.Context, userID, id int32) (*entity.Subscription, error) {
+	if _, err := u.owned(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	s, err := u.repo.UpdateStatus(ctx, id,
+		[]entity.SubscriptionStatus{entity.SubscriptionStatusPaused},
+		entity.SubscriptionStatusActive,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("resume subscription: %w", err)
+	}
+	return s, nil
+}
+
+// owned возвращает подписку пользователя; чужие подписки не видны
+func (u *SubscriptionUsecase) owned(ctx context.Context, userID, id int32) (*entity.Subscription, error) {
+	s, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get subscription: %w", err)
+	}
+	if s.UserID != userID {
+		return nil, fmt.Errorf("subscription %d of user %d: %w", id, userID, ErrNotFound)
+	}
+	return s, nil
+}
+
+// ProcessDrawActivated выпускает билеты по всем активным подпискам на тип лотереи тиража и выставляет по ним счета
+func (u *SubscriptionUsecase) ProcessDrawActivated(ctx context.Context, draw *entity.Draw) error {
+	log := u.log.With("method", "ProcessDrawActivated", "draw_id", draw.ID)
+
+	if draw.LotteryType == "" {
+		return fmt.Errorf("draw %d has no lottery type", draw.ID)
+	}
+
+	subs, err := u.repo.ListActiveByLotteryType(ctx, draw.LotteryType)
+	if err != nil {
+		return fmt.Errorf("list active subscriptions: %w", err)
+	}
+
+	issued := 0
+	for _, s := range subs {
+		ok, err := u.issueTicket(ctx, s, draw.ID)
+		if err != nil {
+			log.Error(ctx, "failed to issue subscription ticket", "subscription_id", s.ID, "error", err)
+			continue
+		}
+		if ok {
+			issued++
+		}
+	}
+
+	log.Info(ctx, "subscription tickets issued", "subscriptions", len(subs), "issued", issued)
+	return nil
+}
+
+func (u *SubscriptionUsecase) issueTicket(ctx context.Context, s *entity.Subscription, drawID int32) (bool, error) {
+	t, err := u.repo.IssueTicket(ctx, s.ID, drawID, time.Now().Add(u.holdTTL))
+	if err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return false, nil
+		}
+		return false, fmt.Errorf("issue ticket: %w", err)
+	}
+
+	invoiceID, err := u.payments.CreateInvoice(ctx, s.UserID, t.ID)
+	if err != nil {
+		u.log.Error(ctx, "failed to charge subscription", "subscription_id", s.ID, "ticket_id", t.ID, "error", err)
+		return false, u.returnTicket(ctx, t.ID)
+	}
+
+	if err := u.tickets.PinHolds(ctx, []int32{t.ID}); err != nil {
+		if cErr := u.payments.CancelInvoice(ctx, invoiceID); cErr != nil {
+			u.log.Error(ctx, "failed to cancel subscription invoice", "invoice_id", invoiceID, "error", cErr)
+		}
+		if rErr := u.returnTicket(ctx, t.ID); rErr != nil {
+			u.log.Error(ctx, "failed to return subscription ticket", "ticket_id", t.ID, "error", rErr)
+		}
+		return false, fmt.Errorf("pin ticket hold: %w", err)
+	}
+
+	return true, nil
+}
+
+// returnTicket снимает бронь с неоплаченного билета подписки и возвращает тираж в остаток
+func (u *SubscriptionUsecase) returnTicket(ctx context.Context, ticketID int32) error {
+	if err := u.HandlePaymentFailure(ctx, ticketID); err != nil {
+		return err
+	}
+	if err := u.tickets.ReleaseHolds(ctx, []int32{ticketID}); err != nil {
+		return fmt.Errorf("release ticket: %w", err)
+	}
+	return nil
+}
+
+// HandlePaymentFailure ставит подписку на паузу, если неоплаченный билет был выпущен по подписке
+func (u *SubscriptionUsecase) HandlePaymentFailure(ctx context.Context, ticketID int32) error {
+	s, err := u.repo.ReturnTicket(ctx, ticketID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("return subscription ticket: %w", err)
+	}
+
+	u.log.Info(ctx, "subscription paused after payment failure", "subscription_id", s.ID, "ticket_id", ticketID)
+	return nil
+}
@@ -0,0 +1,236 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MaxFando/lms/platform/logger"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+	"github.com/MaxFando/lms/ticket-service/internal/repository"
+)
+
+const (
+	maxSyndicateNameLen = 100
+	// syndicateSettleBatch - сколько выигрышных билетов синдикатов рассчитывается за один проход
+	syndicateSettleBatch = 100
+)
+
+var (
+	ErrInvalidSyndicateName = errors.New("invalid syndicate name")
+	ErrInvalidShares        = errors.New("shares must be positive")
+	ErrNotSyndicateOwner    = errors.New("only syndicate owner can manage it")
+)
+
+type syndicateNotifier interface {
+	PublishSyndicateInvite(ctx context.Context, syndicateID, userID int32) error
+	PublishSyndicatePayout(ctx context.Context, win *entity.SyndicateWin, payout entity.SyndicatePayout) error
+}
+
+type SyndicateUsecase struct {
+	repo     repository.SyndicateRepository
+	users    userDirectory
+	notifier syndicateNotifier
+	log      logger.Logger
+}
+
+func NewSyndicateUsecase(repo repository.SyndicateRepository, users userDirectory, notifier syndicateNotifier) *SyndicateUsecase {
+	return &SyndicateUsecase{
+		repo:     repo,
+		users:    users,
+		notifier: notifier,
+		log:      logger.NewLogger().With("app", "lms", "component", "ticket-service", "layer", "usecase"),
+	}
+}
+
+func (u *SyndicateUsecase) CreateSyndicate(ctx context.Context, ownerID int32, name string, shares int32) (*entity.Syndicate, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > maxSyndicateNameLen {
+		return nil, ErrInvalidSyndicateName
+	}
+	if shares <= 0 {
+		return nil, ErrInvalidShares
+	}
+
+	s, err := u.repo.Create(ctx, name, ownerID, shares)
+	if err != nil {
+		return nil, fmt.Errorf("create syndicate: %w", err)
+	}
+	return s, nil
+}
+
+// GetSyndicate возвращает синдикат его участнику или приглашённому
+func (u *SyndicateUsecase) GetSyndicate(ctx context.Context, userID, id int32) (*entity.Syndicate, error) {
+	s, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get syndicate: %w", err)
+	}
+	if m, ok := s.Member(userID); !ok || m.Status == entity.MemberDeclined {
+		return nil, fmt.Errorf("syndicate %d of user %d: %w", id, userID, ErrNotFound)
+	}
+	return s, nil
+}
+
+func (u *SyndicateUsecase) ListSyndicates(ctx context.Context, userID int32) ([]*entity.Syndicate, error) {
+	syndicates, err := u.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list syndicates: %w", err)
+	}
+	return syndicates, nil
+}
+
+func (u *SyndicateUsecase) Invite(ctx context.Context, ownerID, syndicateID, userID, shares int32) (*entity.Syndicate, error) {
+	if shares <= 0 {
+		return nil, ErrInvalidShares
+	}
+	if _, err := u.ownedBy(ctx, ownerID, syndicateID); err != nil {
+		return nil, err
+	}
+
+	registered, err := u.users.Exists(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("check invitee: %w", err)
+	}
+	if !registered {
+		return nil, fmt.Errorf("user %d: %w", userID, ErrNotFound)
+	}
+
+	if err := u.repo.Invite(ctx, syndicateID, userID, shares); err != nil {
+		return nil, fmt.Errorf("invite to syndicate: %w", err)
+	}
+	if err := u.notifier.PublishSyndicateInvite(ctx, syndicateID, userID); err != nil {
+		u.log.Error(ctx, "failed to publish syndicate invite", "syndicate_id", syndicateID, "user_id", userID, "error", err)
+	}
+	return u.repo.GetByID(ctx, syndicateID)
+}
+
+func (u *SyndicateUsecase) AcceptInvite(ctx context.Context, userID, syndicateID int32) (*entity.Syndicate, error) {
+	return u.respond(ctx, userID, syndicateID, true)
+}
+
+func (u *SyndicateUsecase) DeclineInvite(ctx context.Context, userID, syndicateID int32) (*entity.Syndicate, error) {
+	return u.respond(ctx, userID, syndicateID, false)
+}
+
+func (u *SyndicateUsecase) respond(ctx context.Context, userID, syndicateID int32, accept bool) (*entity.Syndicate, error) {
+	if err := u.repo.Respond(ctx, syndicateID, userID, accept); err != nil {
+		return nil, fmt.Errorf("respond to syndicate invite: %w", err)
+	}
+	return u.repo.GetByID(ctx, syndicateID)
+}
+
+// AddTicket отдаёт синдикату оплаченный билет его владельца; после этого билет нельзя передать
+func (u *SyndicateUsecase) AddTicket(ctx context.Context, ownerID, syndicateID, ticketID int32) (*entity.Syndicate, error) {
+	if _, err := u.ownedBy(ctx, ownerID, syndicateID); err != nil {
+		return nil, err
+	}
+
+	if err := u.repo.AddTicket(ctx, syndicateID, ownerID, ticketID); err != nil {
+		return nil, fmt.Errorf("add syndicate ticket: %w", err)
+	}
+	return u.repo.GetByID(ctx, syndicateID)
+}
+
+// Settle распределяет призы выигравших билетов синдикатов между участниками,
+// состоящими в синдикате на момент расчёта, пропорционально их долям
+func (u *SyndicateUsecase) Settle(ctx context.Context) error {
+	wins, err := u.repo.ListUnsettled(ctx, syndicateSettleBatch)
+	if err != nil {
+		u.log.Error(ctx, "failed to list unsettled syndicate tickets", "error", err)
+		return nil
+	}
+
+	for _, w := range wins {
+		if err := u.settle(ctx, w); err != nil {
+			u.log.Error(ctx, "failed to settle syndicate ticket", "syndicate_id", w.SyndicateID, "ticket_id", w.TicketID, "error", err)
+		}
+	}
+	return nil
+}
+
+func (u *SyndicateUsecase) settle(ctx context.Context, w *entity.SyndicateWin) error {
+	s, err := u.repo.GetByID(ctx, w.SyndicateID)
+	if err != nil {
+		return err
+	}
+
+	members := s.ActiveMembers()
+	shares := make([]int32, len(members))
+	for i, m := range members {
+		shares[i] = m.Shares
+	}
+	amounts := entity.Apportion(w.Prize, shares)
+
+	payouts := make([]entity.SyndicatePayout, len(members))
+	for i, m := range members {
+		payouts[i] = entity.SyndicatePayout{UserID: m.UserID, Amount: amounts[i]}
+	}
+
+	if err := u.repo.SavePayouts(ctx, w, payouts); err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil
+		}
+		return err
+	}
+
+	for _, p := range payouts {
+		// доля меньше копейки округлилась до нуля - выплачивать нечего
+		if !p.Amount.IsPositive() {
+			continue
+		}
+		if err := u.notifier.PublishSyndicatePayout(ctx, w, p); err != nil {
+			u.log.Error(ctx, "failed to publish syndicate payout", "ticket_id", w.TicketID, "user_id", p.UserID, "error", err)
+		}
+	}
+	u.log.Info(ctx, "syndicate ticket settled", "syndicate_id", w.SyndicateID, "ticket_id", w.TicketID, "prize", w.Prize.String())
+	return nil
+}
+
+// AttachShares дополняет билеты из списка пользователя его долями в синдикатах
+func (u *SyndicateUsecase) AttachShares(ctx context.Context, userID int32, tickets []*entity.TicketWithDraw) error {
+	ids := make([]int32, len(tickets))
+	for i, t := range tickets {
+		ids[i] = t.ID
+	}
+
+	shares, err := u.repo.SharesByTickets(ctx, userID, ids)
+	if err != nil {
+		return fmt.Errorf("get syndicate shares: %w", err)
+	}
+	for _, t := range tickets {
+		t.Syndicate = shares[t.ID]
+	}
+	return nil
+}
+
+// AttachPayouts дополняет выигравшие билеты синдикатов распределёнными долями приза
+func (u *SyndicateUsecase) AttachPayouts(ctx context.Context, winners []*entity.Winner) error {
+	var ids []int32
+	for _, w := range winners {
+		if w.SyndicateID != nil {
+			ids = append(ids, w.TicketID)
+		}
+	}
+
+	payouts, err := u.repo.PayoutsByTickets(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("get syndicate payouts: %w", err)
+	}
+	for _, w := range winners {
+		w.Payouts = payouts[w.TicketID]
+	}
+	return nil
+}
+
+func (u *SyndicateUsecase) ownedBy(ctx context.Context, ownerID, syndicateID int32) (*entity.Syndicate, error) {
+	s, err := u.GetSyndicate(ctx, ownerID, syndicateID)
+	if err != nil {
+		return nil, err
+	}
+	if s.OwnerID != ownerID {
+		return nil, fmt.Errorf("syndicate %d: %w", syndicateID, ErrNotSyndicateOwner)
+	}
+	return s, nil
+}
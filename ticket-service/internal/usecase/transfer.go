@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MaxFando/lms/platform/logger"
+
+	"github.com/MaxFando/lms/ticket-service/internal/entity"
+	"github.com/MaxFando/lms/ticket-service/internal/repository"
+)
+
+var (
+	ErrSelfTransfer       = errors.New("cannot transfer ticket to yourself")
+	ErrNotTicketOwner     = repository.ErrNotTicketOwner
+	ErrTransferNotAllowed = repository.ErrTransferNotAllowed
+	ErrAlreadyExists      = repository.ErrAlreadyExists
+)
+
+// userDirectory - сервис пользователей, которому принадлежат их учётные записи
+type userDirectory interface {
+	Exists(ctx context.Context, userID int32) (bool, error)
+}
+
+type transferNotifier interface {
+	PublishTransfer(ctx context.Context, t *entity.Transfer, eventType string) error
+}
+
+type TransferUsecase struct {
+	repo     repository.TransferRepository
+	users    userDirectory
+	notifier transferNotifier
+	log      logger.Logger
+}
+
+func NewTransferUsecase(repo repository.TransferRepository, users userDirectory, notifier transferNotifier) *TransferUsecase {
+	return &TransferUsecase{
+		repo:     repo,
+		users:    users,
+		notifier: notifier,
+		log:      logger.NewLogger().With("app", "lms", "component", "ticket-service", "layer", "usecase"),
+	}
+}
+
+// TransferTicket предлагает оплаченный билет другому пользователю; билет остаётся у отправителя до принятия
+func (u *TransferUsecase) TransferTicket(ctx context.Context, fromUserID, ticketID, toUserID int32) (*entity.Transfer, error) {
+	if fromUserID == toUserID {
+		return nil, ErrSelfTransfer
+	}
+
+	registered, err := u.users.Exists(ctx, toUserID)
+	if err != nil {
+		return nil, fmt.Errorf("check recipient: %w", err)
+	}
+	if !registered {
+		return nil, fmt.Errorf("user %d: %w", toUserID, ErrNotFound)
+	}
+
+	t, err := u.repo.Create(ctx, ticketID, fromUserID, toUserID)
+	if err != nil {
+		return nil, fmt.Errorf("create transfer: %w", err)
+	}
+	u.notify(ctx, t, entity.EventTypeTransferRequested)
+	return t, nil
+}
+
+func (u *TransferUsecase) AcceptTransfer(ctx context.Context, userID, transferID int32) (*entity.Transfer, error) {
+	if _, err := u.addressedTo(ctx, userID, transferID); err != nil {
+		return nil, err
+	}
+
+	t, err := u.repo.Accept(ctx, transferID)
+	if err != nil {
+		return nil, fmt.Errorf("accept transfer: %w", err)
+	}
+	u.notify(ctx, t, entity.EventTypeTransferAccepted)
+	return t, nil
+}
+
+func (u *TransferUsecase) DeclineTransfer(ctx context.Context, userID, transferID int32) (*entity.Transfer, error) {
+	if _, err := u.addressedTo(ctx, userID, transferID); err != nil {
+		return nil, err
+	}
+
+	t, err := u.repo.Resolve(ctx, transferID, entity.TransferStatusDeclined)
+	if err != nil {
+		return nil, fmt.Errorf("decline transfer: %w", err)
+	}
+	u.notify(ctx, t, entity.EventTypeTransferDeclined)
+	return t, nil
+}
+
+func (u *TransferUsecase) CancelTransfer(ctx context.Context, userID, transferID int32) (*entity.Transfer, error) {
+	t, err := u.repo.GetByID(ctx, transferID)
+	if err != nil {
+		return nil, fmt.Errorf("get transfer: %w", err)
+	}
+	if t.FromUserID != userID {
+		return nil, fmt.Errorf("transfer %d sent by user %d: %w", transferID, userID, ErrNotFound)
+	}
+
+	if t, err = u.repo.Resolve(ctx, transferID, entity.TransferStatusCancelled); err != nil {
+		return nil, fmt.Errorf("cancel transfer: %w", err)
+	}
+	u.notify(ctx, t, entity.EventTypeTransferCancelled)
+	return t, nil
+}
+
+// ListTransfers возвращает историю передач, в которых участвовал пользователь
+func (u *TransferUsecase) ListTransfers(ctx context.Context, userID int32) ([]*entity.Transfer, error) {
+	transfers, err := u.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list transfers: %w", err)
+	}
+	return transfers, nil
+}
+
+// addressedTo возвращает передачу, если её получатель - userID; чужие передачи не видны
+func (u *TransferUsecase) addressedTo(ctx context.Context, userID, transferID int32) (*entity.Transfer, error) {
+	t, err := u.repo.GetByID(ctx, transferID)
+	if err != nil {
+		return nil, fmt.Errorf("get transfer: %w", err)
+	}
+	if t.ToUserID != userID {
+		return nil, fmt.Errorf("transfer %d to user %d: %w", transferID, userID, ErrNotFound)
+	}
+	return t, nil
+}
+
+func (u *TransferUsecase) notify(ctx context.Context, t *entity.Transfer, eventType string) {
+	if err := u.notifier.PublishTransfer(ctx, t, eventType); err != nil {
+		u.log.Error(ctx, "failed to publish transfer event", "transfer_id", t.ID, "type", eventType, "error", err)
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/MaxFando/lms/platform/logger"
 	"github.com/MaxFando/lms/ticket-service/pkg/lottery"
+	"github.com/MaxFando/lms/ticket-service/pkg/serial"
 	"strconv"
 	"time"
 
@@ -15,15 +17,41 @@ import (
 var (
 	ErrDrawNotActive  = errors.New("draw not active")
 	ErrInvalidNumbers = errors.New("invalid ticket numbers")
+	ErrNoFreeTickets  = repository.ErrNoFreeTickets
+	ErrHoldLimit      = repository.ErrHoldLimit
+	ErrInvalidSerial  = serial.ErrInvalid
 )
 
+// paymentNotifier сообщает сервису платежей о выигрышах, чтобы тот выплатил приз, и об оплаченных
+// билетах, которые не удалось выдать, чтобы тот вернул за них деньги
+type paymentNotifier interface {
+	PublishTicketWon(ctx context.Context, w *entity.Winner) error
+	PublishUndelivered(ctx context.Context, invoiceID int64, userID int32, ticketIDs []int32) error
+}
+
 type TicketUsecase struct {
-	repo repository.TicketRepository
+	repo     repository.TicketRepository
+	payments invoiceService
+	notifier paymentNotifier
+	limiter  *BookingLimiter
+	holdTTL  time.Duration
+	log      logger.Logger
 }
 
-func NewTicketUsecase(repo repository.TicketRepository) *TicketUsecase {
+func NewTicketUsecase(
+	repo repository.TicketRepository,
+	payments invoiceService,
+	notifier paymentNotifier,
+	limiter *BookingLimiter,
+	holdTTL time.Duration,
+) *TicketUsecase {
 	return &TicketUsecase{
-		repo: repo,
+		repo:     repo,
+		payments: payments,
+		notifier: notifier,
+		limiter:  limiter,
+		holdTTL:  holdTTL,
+		log:      logger.NewLogger().With("app", "lms", "component", "ticket-service", "layer", "usecase"),
 	}
 }
 
@@ -35,78 +63,239 @@ func (u *TicketUsecase) GetTicket(ctx context.Context, id int32) (*entity.Ticket
 	return t, nil
 }
 
-func (u *TicketUsecase) CreateTicket(ctx context.Context, userID, drawID int32, numbers []string) (*entity.Ticket, error) {
+// VerifyTicket находит билет по серийному номеру, введённому или считанному с QR-кода
+func (u *TicketUsecase) VerifyTicket(ctx context.Context, code string) (*entity.TicketWithDraw, error) {
+	canonical, err := serial.Normalize(code)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := u.repo.GetBySerial(ctx, canonical)
+	if err != nil {
+		return nil, fmt.Errorf("verify ticket: %w", err)
+	}
+	return t, nil
+}
+
+// CreateTicket создаёт билет с выбранными числами и выставляет на него счёт
+func (u *TicketUsecase) CreateTicket(ctx context.Context, userID, drawID int32, numbers []string) (*entity.Ticket, int64, error) {
 	active, err := u.repo.IsDrawActive(ctx, drawID)
 	if err != nil {
-		return nil, fmt.Errorf("check draw active: %w", err)
+		return nil, 0, fmt.Errorf("check draw active: %w", err)
 	}
 	if !active {
-		return nil, ErrDrawNotActive
+		return nil, 0, ErrDrawNotActive
 	}
 
 	count, maxNum, err := u.repo.GetDrawLotteryType(ctx, drawID)
 	if err != nil {
-		return nil, fmt.Errorf("get draw lottery type: %w", err)
+		return nil, 0, fmt.Errorf("get draw lottery type: %w", err)
 	}
 
-	if len(numbers) != count {
-		return nil, ErrInvalidNumbers
+	if err := validateNumbers(numbers, count, maxNum); err != nil {
+		return nil, 0, err
 	}
-	seen := make(map[string]struct{}, count)
-	for _, s := range numbers {
-		if _, dup := seen[s]; dup {
-			return nil, ErrInvalidNumbers
-		}
-		n, err := strconv.Atoi(s)
-		if err != nil || n < 1 || n > maxNum {
-			return nil, ErrInvalidNumbers
-		}
-		seen[s] = struct{}{}
+
+	if err := u.limiter.Check(ctx, userID, drawID, 1); err != nil {
+		return nil, 0, err
 	}
 
+	now := time.Now()
+	heldUntil := now.Add(u.holdTTL)
 	ticket := &entity.Ticket{
 		UserID:    &userID,
 		DrawID:    drawID,
 		Numbers:   numbers,
-		Status:    entity.StatusPending,
-		CreatedAt: time.Now(),
+		Status:    entity.StatusHeld,
+		HeldUntil: &heldUntil,
+		CreatedAt: now,
 	}
 	saved, err := u.repo.Create(ctx, ticket)
 	if err != nil {
-		return nil, fmt.Errorf("create ticket: %w", err)
+		return nil, 0, fmt.Errorf("create ticket: %w", err)
+	}
+
+	rollback := func(ctx context.Context) error {
+		_, err := u.repo.Transition(ctx, []int32{saved.ID}, entity.StatusCancelled)
+		return err
+	}
+	if err := u.enforceLimits(ctx, userID, saved, rollback); err != nil {
+		return nil, 0, err
 	}
 
-	// todo invoice
+	invoiceID, err := u.openInvoice(ctx, userID, saved, rollback)
+	if err != nil {
+		return nil, 0, err
+	}
 
-	return saved, nil
+	return saved, invoiceID, nil
 }
 
-func (u *TicketUsecase) ReserveTicket(ctx context.Context, id int32) (*entity.Ticket, error) {
-	t, err := u.repo.UpdateStatus(ctx, id, entity.StatusPending)
+// ReserveTicket продлевает бронь билета пользователя ещё на holdTTL, не больше entity.MaxHoldExtensions раз
+func (u *TicketUsecase) ReserveTicket(ctx context.Context, userID, id int32) (*entity.Ticket, error) {
+	t, err := u.repo.ExtendHold(ctx, id, userID, time.Now().Add(u.holdTTL))
 	if err != nil {
-		return nil, fmt.Errorf("reserve update ticket: %w", err)
+		return nil, fmt.Errorf("reserve ticket: %w", err)
 	}
 	return t, nil
 }
 
-func (u *TicketUsecase) ListUserTickets(ctx context.Context, userID int32) ([]*entity.TicketWithDraw, error) {
-	tickets, err := u.repo.ListByUser(ctx, userID)
+func (u *TicketUsecase) ListUserTickets(
+	ctx context.Context,
+	userID int32,
+	f entity.TicketFilter,
+	pageSize int32,
+	pageToken string,
+) ([]*entity.TicketWithDraw, string, error) {
+	size, err := preparePage(&f, pageSize, pageToken)
 	if err != nil {
-		return nil, fmt.Errorf("list repo: %w", err)
+		return nil, "", err
 	}
-	return tickets, nil
+
+	tickets, err := u.repo.ListByUser(ctx, userID, f)
+	if err != nil {
+		return nil, "", fmt.Errorf("list repo: %w", err)
+	}
+
+	tickets, next := cutPage(tickets, size, func(t *entity.TicketWithDraw) entity.TicketCursor {
+		return entity.TicketCursor{CreatedAt: t.CreatedAt, ID: t.ID}
+	})
+	return tickets, next, nil
+}
+
+// BookTicket бронирует свободный билет за пользователем и выставляет на него счёт
+func (u *TicketUsecase) BookTicket(ctx context.Context, userID, ticketID int32) (*entity.Ticket, int64, error) {
+	booked, err := u.repo.Hold(ctx, ticketID, userID, time.Now().Add(u.holdTTL))
+	if err != nil {
+		return nil, 0, fmt.Errorf("usecase book ticket: %w", err)
+	}
+
+	rollback := func(ctx context.Context) error {
+		return u.repo.ReleaseHolds(ctx, []int32{booked.ID})
+	}
+	if err := u.enforceLimits(ctx, userID, booked, rollback); err != nil {
+		return nil, 0, err
+	}
+
+	invoiceID, err := u.openInvoice(ctx, userID, booked, rollback)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return booked, invoiceID, nil
 }
 
-func (u *TicketUsecase) BookTicket(ctx context.Context, userID, ticketID int32) (*entity.Ticket, error) {
-	booked, err := u.repo.BookTicket(ctx, ticketID, userID)
+// BookAnyTicket бронирует любой свободный билет тиража, предпочитая билеты с числами preferred, и выставляет на него счёт
+func (u *TicketUsecase) BookAnyTicket(ctx context.Context, userID, drawID int32, preferred []string) (*entity.Ticket, int64, error) {
+	if len(preferred) > 0 {
+		count, maxNum, err := u.repo.GetDrawLotteryType(ctx, drawID)
+		if err != nil {
+			return nil, 0, fmt.Errorf("get draw lottery type: %w", err)
+		}
+		if len(preferred) > count {
+			return nil, 0, ErrInvalidNumbers
+		}
+		if err := validateNumbers(preferred, len(preferred), maxNum); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if err := u.limiter.Check(ctx, userID, drawID, 1); err != nil {
+		return nil, 0, err
+	}
+
+	booked, err := u.repo.HoldAny(ctx, drawID, userID, preferred, time.Now().Add(u.holdTTL))
+	if err != nil {
+		return nil, 0, fmt.Errorf("usecase book any ticket: %w", err)
+	}
+
+	rollback := func(ctx context.Context) error {
+		return u.repo.ReleaseHolds(ctx, []int32{booked.ID})
+	}
+	if err := u.enforceLimits(ctx, userID, booked, rollback); err != nil {
+		return nil, 0, err
+	}
+
+	invoiceID, err := u.openInvoice(ctx, userID, booked, rollback)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return booked, invoiceID, nil
+}
+
+// HoldForInvoice бронирует билет под счёт, который выставляет сам сервис платежей
+func (u *TicketUsecase) HoldForInvoice(ctx context.Context, userID, ticketID int32) (*entity.Ticket, error) {
+	booked, err := u.repo.Hold(ctx, ticketID, userID, time.Now().Add(u.holdTTL))
 	if err != nil {
 		return nil, fmt.Errorf("usecase book ticket: %w", err)
 	}
+
+	rollback := func(ctx context.Context) error {
+		return u.repo.ReleaseHolds(ctx, []int32{booked.ID})
+	}
+	if err := u.enforceLimits(ctx, userID, booked, rollback); err != nil {
+		return nil, err
+	}
+
+	if err := u.repo.PinHolds(ctx, []int32{booked.ID}); err != nil {
+		if rErr := rollback(ctx); rErr != nil {
+			u.log.Error(ctx, "failed to release booking", "ticket_id", booked.ID, "error", rErr)
+		}
+		return nil, fmt.Errorf("pin ticket hold: %w", err)
+	}
+	booked.HeldUntil = nil
 	return booked, nil
 }
 
-func (u *TicketUsecase) ReleaseBooking(ctx context.Context, ticketID int32) error {
-	if err := u.repo.ClearBooking(ctx, ticketID); err != nil {
+// enforceLimits перепроверяет лимиты пользователя после брони; при превышении бронь откатывается через rollback
+func (u *TicketUsecase) enforceLimits(
+	ctx context.Context,
+	userID int32,
+	t *entity.Ticket,
+	rollback func(ctx context.Context) error,
+) error {
+	err := u.limiter.Check(ctx, userID, t.DrawID, 0)
+	if err == nil {
+		return nil
+	}
+	if rErr := rollback(ctx); rErr != nil {
+		u.log.Error(ctx, "failed to roll back ticket", "ticket_id", t.ID, "error", rErr)
+	}
+	return err
+}
+
+// openInvoice выставляет счёт на забронированный билет; при ошибке счёт отменяется, а билет откатывается через rollback
+func (u *TicketUsecase) openInvoice(
+	ctx context.Context,
+	userID int32,
+	t *entity.Ticket,
+	rollback func(ctx context.Context) error,
+) (int64, error) {
+	invoiceID, err := u.payments.CreateInvoice(ctx, userID, t.ID)
+	if err != nil {
+		if rErr := rollback(ctx); rErr != nil {
+			u.log.Error(ctx, "failed to roll back ticket", "ticket_id", t.ID, "error", rErr)
+		}
+		return 0, fmt.Errorf("create invoice: %w", err)
+	}
+
+	if err := u.repo.PinHolds(ctx, []int32{t.ID}); err != nil {
+		if cErr := u.payments.CancelInvoice(ctx, invoiceID); cErr != nil {
+			u.log.Error(ctx, "failed to cancel invoice", "invoice_id", invoiceID, "error", cErr)
+		}
+		if rErr := rollback(ctx); rErr != nil {
+			u.log.Error(ctx, "failed to roll back ticket", "ticket_id", t.ID, "error", rErr)
+		}
+		return 0, fmt.Errorf("pin ticket hold: %w", err)
+	}
+
+	t.HeldUntil = nil
+	return invoiceID, nil
+}
+
+func (u *TicketUsecase) ReleaseBookings(ctx context.Context, ticketIDs []int32) error {
+	if err := u.repo.ReleaseHolds(ctx, ticketIDs); err != nil {
 		return fmt.Errorf("usecase release booking: %w", err)
 	}
 	return nil
@@ -128,7 +317,7 @@ func (u *TicketUsecase) GenerateTickets(ctx context.Context, drawID int32, count
 			UserID:    nil,
 			DrawID:    drawID,
 			Numbers:   nums,
-			Status:    entity.StatusPending,
+			Status:    entity.StatusAvailable,
 			CreatedAt: now,
 		}
 		if _, err := u.repo.Create(ctx, t); err != nil {
@@ -138,21 +327,185 @@ func (u *TicketUsecase) GenerateTickets(ctx context.Context, drawID int32, count
 	return nil
 }
 
-func (u *TicketUsecase) ListAvailableTickets(ctx context.Context) ([]*entity.Ticket, error) {
-	return u.repo.ListFreeByActiveDraw(ctx)
+func (u *TicketUsecase) ListAvailableTickets(
+	ctx context.Context,
+	f entity.TicketFilter,
+	pageSize int32,
+	pageToken string,
+) ([]*entity.Ticket, string, error) {
+	f.Statuses = nil
+	size, err := preparePage(&f, pageSize, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	tickets, err := u.repo.ListAvailable(ctx, f)
+	if err != nil {
+		return nil, "", fmt.Errorf("list available: %w", err)
+	}
+
+	tickets, next := cutPage(tickets, size, func(t *entity.Ticket) entity.TicketCursor {
+		return entity.TicketCursor{CreatedAt: t.CreatedAt, ID: t.ID}
+	})
+	return tickets, next, nil
 }
 
 func (u *TicketUsecase) SetWinningTickets(ctx context.Context, ids []int32) ([]*entity.Ticket, error) {
 	if len(ids) == 0 {
 		return nil, ErrInvalidNumbers
 	}
-	return u.repo.BulkUpdateStatus(ctx, ids, entity.StatusWin)
+	tickets, err := u.repo.Transition(ctx, ids, entity.StatusWin)
+	if err != nil {
+		return nil, err
+	}
+
+	u.notifyWins(ctx, tickets)
+	return tickets, nil
+}
+
+// notifyWins публикует выигрыши билетов, только что переведённых в WIN
+func (u *TicketUsecase) notifyWins(ctx context.Context, tickets []*entity.Ticket) {
+	if len(tickets) == 0 {
+		return
+	}
+	ids := make([]int32, len(tickets))
+	for i, t := range tickets {
+		ids[i] = t.ID
+	}
+
+	prizes, err := u.repo.ListPrizes(ctx, ids)
+	if err != nil {
+		u.log.Error(ctx, "failed to list prizes of winning tickets", "error", err)
+		return
+	}
+	for _, w := range prizes {
+		if w.UserID == nil || !w.Prize.IsPositive() {
+			continue
+		}
+		if err := u.notifier.PublishTicketWon(ctx, w); err != nil {
+			u.log.Error(ctx, "failed to publish ticket win", "ticket_id", w.TicketID, "error", err)
+		}
+	}
+}
+
+// ConfirmPayment закрепляет оплаченные билеты за пользователем. Билеты, которые уже не забронированы
+// за ним, выдать нельзя - о них сообщается сервису платежей, чтобы он вернул деньги
+func (u *TicketUsecase) ConfirmPayment(ctx context.Context, invoiceID int64, userID int32, ticketIDs []int32) error {
+	paid, err := u.repo.ConfirmPaid(ctx, userID, ticketIDs)
+	if err != nil {
+		return fmt.Errorf("confirm payment: %w", err)
+	}
+	if len(paid) != len(ticketIDs) {
+		confirmed := make(map[int32]struct{}, len(paid))
+		for _, t := range paid {
+			confirmed[t.ID] = struct{}{}
+		}
+		var missed []int32
+		for _, id := range ticketIDs {
+			if _, ok := confirmed[id]; !ok {
+				missed = append(missed, id)
+			}
+		}
+		u.log.Error(ctx, "paid tickets are not held by payer", "invoice_id", invoiceID, "user_id", userID, "ticket_ids", missed)
+		if err := u.notifier.PublishUndelivered(ctx, invoiceID, userID, missed); err != nil {
+			return fmt.Errorf("report undelivered tickets: %w", err)
+		}
+	}
+	return nil
+}
+
+// RefundTickets снимает с розыгрыша билеты счёта, по которому вернули деньги
+func (u *TicketUsecase) RefundTickets(ctx context.Context, ticketIDs []int32) error {
+	refunded, err := u.repo.Transition(ctx, ticketIDs, entity.StatusRefunded)
+	if err != nil {
+		return fmt.Errorf("refund tickets: %w", err)
+	}
+	if len(refunded) != len(ticketIDs) {
+		u.log.Error(ctx, "refunded tickets are not paid", "ticket_ids", ticketIDs, "refunded", len(refunded))
+	}
+	return nil
+}
+
+// ExpireHolds возвращает в продажу билеты, бронь которых истекла
+func (u *TicketUsecase) ExpireHolds(ctx context.Context) error {
+	ids, err := u.repo.ExpireHolds(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("expire holds: %w", err)
+	}
+	if len(ids) > 0 {
+		u.log.Info(ctx, "expired ticket holds released", "ticket_ids", ids)
+	}
+	return nil
+}
+
+// CancelDraw отменяет билеты отменённого тиража
+func (u *TicketUsecase) CancelDraw(ctx context.Context, drawID int32) error {
+	tickets, err := u.repo.CancelDraw(ctx, drawID)
+	if err != nil {
+		return fmt.Errorf("cancel draw tickets: %w", err)
+	}
+	u.log.Info(ctx, "draw tickets cancelled", "draw_id", drawID, "tickets", len(tickets))
+	return nil
+}
+
+// GetDrawSummary собирает итоги продаж и розыгрыша тиража
+func (u *TicketUsecase) GetDrawSummary(ctx context.Context, drawID int32) (*entity.DrawSummary, error) {
+	d, err := u.repo.GetDraw(ctx, drawID)
+	if err != nil {
+		return nil, fmt.Errorf("get draw: %w", err)
+	}
+	sold, err := u.repo.CountSold(ctx, drawID)
+	if err != nil {
+		return nil, err
+	}
+	winners, err := u.repo.ListWinners(ctx, drawID)
+	if err != nil {
+		return nil, err
+	}
+
+	tiers, total := entity.SummarizeWinners(winners)
+	return &entity.DrawSummary{
+		Draw:        *d,
+		TicketsSold: sold,
+		Winners:     len(winners),
+		Tiers:       tiers,
+		TotalPrize:  total,
+	}, nil
 }
 
-func (u *TicketUsecase) CheckResult(ctx context.Context, ticketID int32) (string, error) {
-	t, err := u.repo.GetByID(ctx, ticketID)
+// CheckFavourite проверяет, что набор сохранён для лотереи тиража drawID
+func (u *TicketUsecase) CheckFavourite(ctx context.Context, drawID int32, f *entity.Favourite) error {
+	d, err := u.repo.GetDraw(ctx, drawID)
 	if err != nil {
-		return "", err
+		return fmt.Errorf("get draw: %w", err)
 	}
-	return string(t.Status), nil
+	if d.LotteryType != f.LotteryType {
+		return fmt.Errorf("favourite %d is %s, draw %d is %s: %w", f.ID, f.LotteryType, drawID, d.LotteryType, ErrFavouriteLotteryMismatch)
+	}
+	return nil
+}
+
+func (u *TicketUsecase) ListDrawWinners(ctx context.Context, drawID int32) ([]*entity.Winner, error) {
+	if _, err := u.repo.GetDraw(ctx, drawID); err != nil {
+		return nil, fmt.Errorf("get draw: %w", err)
+	}
+	return u.repo.ListWinners(ctx, drawID)
+}
+
+func validateNumbers(numbers []string, count, maxNum int) error {
+	if len(numbers) != count {
+		return ErrInvalidNumbers
+	}
+	seen := make(map[string]struct{}, count)
+	for _, s := range numbers {
+		if _, dup := seen[s]; dup {
+			return ErrInvalidNumbers
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 1 || n > maxNum {
+			return ErrInvalidNumbers
+		}
+		seen[s] = struct{}{}
+	}
+	return nil
 }
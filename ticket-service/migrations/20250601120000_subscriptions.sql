@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE ticket.subscription_status AS ENUM ('ACTIVE', 'PAUSED', 'COMPLETED', 'CANCELLED');
+
+CREATE TABLE IF NOT EXISTS ticket.subscriptions (
+    subscription_id SERIAL PRIMARY KEY,
+    user_id         INT         NOT NULL,
+    lottery_type    VARCHAR(50) NOT NULL,
+    numbers         TEXT[]      NOT NULL,
+    draws_total     INT         NOT NULL CHECK (draws_total > 0),
+    draws_remaining INT         NOT NULL CHECK (draws_remaining >= 0),
+    status          ticket.subscription_status NOT NULL DEFAULT 'ACTIVE',
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_subscriptions_user ON ticket.subscriptions(user_id);
+CREATE INDEX idx_subscriptions_active_type ON ticket.subscriptions(lottery_type) WHERE status = 'ACTIVE';
+
+CREATE TABLE IF NOT EXISTS ticket.subscription_tickets (
+    subscription_id INT NOT NULL REFERENCES ticket.subscriptions(subscription_id) ON DELETE CASCADE,
+    draw_id         INT NOT NULL,
+    ticket_id       INT NOT NULL UNIQUE REFERENCES ticket.tickets(ticket_id) ON DELETE CASCADE,
+    PRIMARY KEY (subscription_id, draw_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS ticket.subscription_tickets;
+DROP TABLE IF EXISTS ticket.subscriptions;
+DROP TYPE IF EXISTS ticket.subscription_status;
+-- +goose StatementEnd
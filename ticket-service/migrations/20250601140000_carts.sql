@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE ticket.cart_status AS ENUM ('OPEN', 'CHECKED_OUT', 'RELEASED');
+
+CREATE TABLE IF NOT EXISTS ticket.carts (
+    cart_id     SERIAL PRIMARY KEY,
+    user_id     INT         NOT NULL,
+    status      ticket.cart_status NOT NULL DEFAULT 'OPEN',
+    invoice_id  BIGINT      NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_carts_user_open ON ticket.carts(user_id) WHERE status = 'OPEN';
+CREATE INDEX idx_carts_invoice ON ticket.carts(invoice_id) WHERE invoice_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS ticket.cart_items (
+    cart_id   INT         NOT NULL REFERENCES ticket.carts(cart_id) ON DELETE CASCADE,
+    ticket_id INT         NOT NULL REFERENCES ticket.tickets(ticket_id) ON DELETE CASCADE,
+    added_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (cart_id, ticket_id)
+);
+
+CREATE INDEX idx_cart_items_ticket ON ticket.cart_items(ticket_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS ticket.cart_items;
+DROP TABLE IF EXISTS ticket.carts;
+DROP TYPE IF EXISTS ticket.cart_status;
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE ticket.tickets
+    ALTER COLUMN status DROP DEFAULT,
+    ALTER COLUMN status TYPE TEXT,
+    ADD COLUMN held_until TIMESTAMPTZ NULL;
+
+DROP TYPE ticket.ticket_status;
+CREATE TYPE ticket.ticket_status AS ENUM ('AVAILABLE', 'HELD', 'PAID', 'CANCELLED', 'WIN', 'LOSE', 'REFUNDED');
+
+-- забронированные билеты ждут решения по уже выставленным счетам, поэтому срок брони не ставим
+UPDATE ticket.tickets
+SET status = CASE WHEN user_id IS NULL THEN 'AVAILABLE' ELSE 'HELD' END
+WHERE status = 'PENDING';
+
+ALTER TABLE ticket.tickets
+    ALTER COLUMN status TYPE ticket.ticket_status USING status::ticket.ticket_status,
+    ALTER COLUMN status SET DEFAULT 'AVAILABLE';
+
+CREATE INDEX idx_tickets_held_until ON ticket.tickets(held_until) WHERE status = 'HELD';
+CREATE INDEX idx_tickets_draw_status ON ticket.tickets(draw_id, status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS ticket.idx_tickets_draw_status;
+DROP INDEX IF EXISTS ticket.idx_tickets_held_until;
+
+ALTER TABLE ticket.tickets
+    ALTER COLUMN status DROP DEFAULT,
+    ALTER COLUMN status TYPE TEXT;
+
+DROP TYPE ticket.ticket_status;
+CREATE TYPE ticket.ticket_status AS ENUM ('PENDING', 'WIN', 'LOSE');
+
+UPDATE ticket.tickets
+SET status = 'PENDING'
+WHERE status NOT IN ('WIN', 'LOSE');
+
+ALTER TABLE ticket.tickets
+    ALTER COLUMN status TYPE ticket.ticket_status USING status::ticket.ticket_status,
+    ALTER COLUMN status SET DEFAULT 'PENDING',
+    DROP COLUMN held_until;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE ticket.cart_status ADD VALUE IF NOT EXISTS 'PAID';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE ticket.carts SET status = 'CHECKED_OUT' WHERE status = 'PAID';
+
+ALTER TABLE ticket.carts ALTER COLUMN status DROP DEFAULT;
+DROP INDEX IF EXISTS ticket.idx_carts_user_open;
+ALTER TABLE ticket.carts ALTER COLUMN status TYPE TEXT;
+DROP TYPE ticket.cart_status;
+CREATE TYPE ticket.cart_status AS ENUM ('OPEN', 'CHECKED_OUT', 'RELEASED');
+ALTER TABLE ticket.carts
+    ALTER COLUMN status TYPE ticket.cart_status USING status::ticket.cart_status,
+    ALTER COLUMN status SET DEFAULT 'OPEN';
+CREATE UNIQUE INDEX idx_carts_user_open ON ticket.carts(user_id) WHERE status = 'OPEN';
+-- +goose StatementEnd
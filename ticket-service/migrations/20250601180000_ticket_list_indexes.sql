@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX idx_tickets_user_created ON ticket.tickets(user_id, created_at DESC, ticket_id DESC);
+CREATE INDEX idx_tickets_user_draw_created ON ticket.tickets(user_id, draw_id, created_at DESC, ticket_id DESC);
+CREATE INDEX idx_tickets_available_created ON ticket.tickets(created_at DESC, ticket_id DESC) WHERE status = 'AVAILABLE';
+CREATE INDEX idx_tickets_available_draw_created ON ticket.tickets(draw_id, created_at DESC, ticket_id DESC) WHERE status = 'AVAILABLE';
+
+DROP INDEX IF EXISTS ticket.idx_tickets_user_draw;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_tickets_user_draw ON ticket.tickets(user_id, draw_id);
+
+DROP INDEX IF EXISTS ticket.idx_tickets_available_draw_created;
+DROP INDEX IF EXISTS ticket.idx_tickets_available_created;
+DROP INDEX IF EXISTS ticket.idx_tickets_user_draw_created;
+DROP INDEX IF EXISTS ticket.idx_tickets_user_created;
+-- +goose StatementEnd
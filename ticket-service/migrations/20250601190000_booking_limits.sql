@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS ticket.invoice_overdues (
+    invoice_id  BIGINT      NOT NULL,
+    draw_id     INT         NOT NULL,
+    user_id     INT         NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (invoice_id, draw_id)
+);
+
+CREATE INDEX idx_invoice_overdues_user_draw ON ticket.invoice_overdues(user_id, draw_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS ticket.invoice_overdues;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE ticket.transfer_status AS ENUM ('PENDING', 'ACCEPTED', 'DECLINED', 'CANCELLED');
+
+CREATE TABLE IF NOT EXISTS ticket.transfers (
+    transfer_id  SERIAL PRIMARY KEY,
+    ticket_id    INT         NOT NULL REFERENCES ticket.tickets(ticket_id) ON DELETE CASCADE,
+    from_user_id INT         NOT NULL,
+    to_user_id   INT         NOT NULL,
+    status       ticket.transfer_status NOT NULL DEFAULT 'PENDING',
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resolved_at  TIMESTAMPTZ NULL
+);
+
+CREATE UNIQUE INDEX idx_transfers_ticket_pending ON ticket.transfers(ticket_id) WHERE status = 'PENDING';
+CREATE INDEX idx_transfers_from_user ON ticket.transfers(from_user_id, created_at DESC);
+CREATE INDEX idx_transfers_to_user ON ticket.transfers(to_user_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS ticket.transfers;
+DROP TYPE IF EXISTS ticket.transfer_status;
+-- +goose StatementEnd
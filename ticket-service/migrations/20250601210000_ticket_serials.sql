@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE ticket.tickets ADD COLUMN serial VARCHAR(16) NULL;
+
+-- Серийные номера уже выпущенных билетов: тот же формат, что у pkg/serial (Crockford Base32 + Luhn mod 32)
+CREATE FUNCTION ticket.tmp_new_serial() RETURNS TEXT AS $$
+DECLARE
+    alphabet CONSTANT TEXT := '0123456789ABCDEFGHJKMNPQRSTVWXYZ';
+    rnd      BYTEA := uuid_send(gen_random_uuid()) || uuid_send(gen_random_uuid());
+    body     TEXT := '';
+    factor   INT := 2;
+    total    INT := 0;
+    addend   INT;
+    pos      INT := 0;
+BEGIN
+    WHILE length(body) < 15 LOOP
+        -- в байтах 6 и 8 UUID лежат версия и вариант, они не случайны
+        IF pos % 16 NOT IN (6, 8) THEN
+            body := body || substr(alphabet, get_byte(rnd, pos) % 32 + 1, 1);
+        END IF;
+        pos := pos + 1;
+    END LOOP;
+
+    FOR i IN REVERSE 15..1 LOOP
+        addend := factor * (strpos(alphabet, substr(body, i, 1)) - 1);
+        factor := CASE WHEN factor = 2 THEN 1 ELSE 2 END;
+        total := total + addend / 32 + addend % 32;
+    END LOOP;
+
+    RETURN body || substr(alphabet, (32 - total % 32) % 32 + 1, 1);
+END;
+$$ LANGUAGE plpgsql VOLATILE;
+
+UPDATE ticket.tickets SET serial = ticket.tmp_new_serial() WHERE serial IS NULL;
+
+DROP FUNCTION ticket.tmp_new_serial();
+
+ALTER TABLE ticket.tickets ALTER COLUMN serial SET NOT NULL;
+CREATE UNIQUE INDEX idx_tickets_serial ON ticket.tickets(serial);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS ticket.idx_tickets_serial;
+ALTER TABLE ticket.tickets DROP COLUMN IF EXISTS serial;
+-- +goose StatementEnd
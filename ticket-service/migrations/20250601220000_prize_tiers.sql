@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS ticket.prize_tiers (
+    lottery_type VARCHAR(50)    NOT NULL,
+    matched      INT            NOT NULL CHECK (matched > 0),
+    amount       NUMERIC(14, 2) NOT NULL CHECK (amount >= 0),
+    PRIMARY KEY (lottery_type, matched)
+);
+
+INSERT INTO ticket.prize_tiers (lottery_type, matched, amount) VALUES
+    ('5 from 36', 2, 200),
+    ('5 from 36', 3, 2000),
+    ('5 from 36', 4, 50000),
+    ('5 from 36', 5, 1000000)
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS ticket.prize_tiers;
+-- +goose StatementEnd
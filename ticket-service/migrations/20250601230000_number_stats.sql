@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS ticket.number_stats_draws (
+    draw_id      INT         PRIMARY KEY,
+    lottery_type VARCHAR(50) NOT NULL,
+    drawn_at     TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_number_stats_draws_type_drawn ON ticket.number_stats_draws(lottery_type, drawn_at);
+
+CREATE TABLE IF NOT EXISTS ticket.number_stats (
+    draw_id INT     NOT NULL REFERENCES ticket.number_stats_draws(draw_id) ON DELETE CASCADE,
+    number  INT     NOT NULL,
+    drawn   BOOLEAN NOT NULL,
+    picks   INT     NOT NULL DEFAULT 0,
+    PRIMARY KEY (draw_id, number)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS ticket.number_stats;
+DROP TABLE IF EXISTS ticket.number_stats_draws;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS ticket.favourites (
+    favourite_id SERIAL PRIMARY KEY,
+    user_id      INT          NOT NULL,
+    name         VARCHAR(100) NOT NULL,
+    lottery_type VARCHAR(50)  NOT NULL,
+    numbers      TEXT[]       NOT NULL,
+    created_at   TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_favourites_user_name ON ticket.favourites(user_id, name);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS ticket.favourites;
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS ticket.syndicates (
+    syndicate_id SERIAL PRIMARY KEY,
+    name         VARCHAR(100) NOT NULL,
+    owner_id     INT          NOT NULL,
+    created_at   TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS ticket.syndicate_members (
+    syndicate_id INT         NOT NULL REFERENCES ticket.syndicates(syndicate_id) ON DELETE CASCADE,
+    user_id      INT         NOT NULL,
+    shares       INT         NOT NULL CHECK (shares > 0),
+    status       VARCHAR(20) NOT NULL DEFAULT 'INVITED',
+    invited_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    joined_at    TIMESTAMPTZ,
+    PRIMARY KEY (syndicate_id, user_id)
+);
+
+CREATE INDEX idx_syndicate_members_user ON ticket.syndicate_members(user_id);
+
+CREATE TABLE IF NOT EXISTS ticket.syndicate_tickets (
+    ticket_id    INT PRIMARY KEY REFERENCES ticket.tickets(ticket_id),
+    syndicate_id INT NOT NULL REFERENCES ticket.syndicates(syndicate_id) ON DELETE CASCADE,
+    added_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    settled_at   TIMESTAMPTZ
+);
+
+CREATE INDEX idx_syndicate_tickets_syndicate ON ticket.syndicate_tickets(syndicate_id);
+
+CREATE TABLE IF NOT EXISTS ticket.syndicate_payouts (
+    ticket_id    INT            NOT NULL REFERENCES ticket.syndicate_tickets(ticket_id),
+    user_id      INT            NOT NULL,
+    syndicate_id INT            NOT NULL,
+    amount       NUMERIC(14, 2) NOT NULL CHECK (amount >= 0),
+    created_at   TIMESTAMPTZ    NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (ticket_id, user_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS ticket.syndicate_payouts;
+DROP TABLE IF EXISTS ticket.syndicate_tickets;
+DROP TABLE IF EXISTS ticket.syndicate_members;
+DROP TABLE IF EXISTS ticket.syndicates;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Серийный номер выдаётся при оплате билета: номера непроданных билетов видны в открытом списке
+-- и не должны позволять проверять чужой билет после продажи
+ALTER TABLE ticket.tickets ALTER COLUMN serial DROP NOT NULL;
+
+UPDATE ticket.tickets SET serial = NULL WHERE status IN ('AVAILABLE', 'HELD');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Сброшенные номера не восстанавливаются, поэтому NOT NULL не возвращается
+SELECT 1;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Число продлений текущей брони: сбрасывается, когда билет бронируют заново из продажи
+ALTER TABLE ticket.tickets ADD COLUMN hold_extensions INT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE ticket.tickets DROP COLUMN hold_extensions;
+-- +goose StatementEnd
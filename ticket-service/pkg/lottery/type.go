@@ -0,0 +1,25 @@
+package lottery
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseType разбирает тип лотереи вида "5 from 36" на количество чисел в билете и максимальное число
+func ParseType(lotteryType string) (count int, maxNum int, err error) {
+	parts := strings.Fields(lotteryType)
+	if len(parts) != 3 || parts[1] != "from" {
+		return 0, 0, fmt.Errorf("invalid lottery type format: %q", lotteryType)
+	}
+	if count, err = strconv.Atoi(parts[0]); err != nil {
+		return 0, 0, fmt.Errorf("parse count: %w", err)
+	}
+	if maxNum, err = strconv.Atoi(parts[2]); err != nil {
+		return 0, 0, fmt.Errorf("parse max: %w", err)
+	}
+	if count < 1 || count > maxNum {
+		return 0, 0, fmt.Errorf("invalid lottery type: %q", lotteryType)
+	}
+	return count, maxNum, nil
+}
@@ -0,0 +1,93 @@
+// Package serial генерирует и проверяет серийные номера билетов.
+//
+// Серийный номер - 15 случайных символов алфавита Crockford Base32 и контрольный символ Luhn mod 32.
+// Алфавит без I, L, O и U, поэтому номер удобно диктовать и печатать в QR-коде в алфанумерическом режиме.
+package serial
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	alphabet  = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	bodyLen   = 15
+	Length    = bodyLen + 1
+	groupSize = 4
+)
+
+var ErrInvalid = errors.New("invalid ticket serial")
+
+// New возвращает новый серийный номер без разделителей
+func New() (string, error) {
+	buf := make([]byte, bodyLen)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("read random: %w", err)
+	}
+
+	code := make([]byte, bodyLen, Length)
+	for i, b := range buf {
+		code[i] = alphabet[b%byte(len(alphabet))]
+	}
+	return string(append(code, checkChar(string(code)))), nil
+}
+
+// Normalize приводит введённый номер к каноничному виду и проверяет контрольный символ.
+// Разделители и регистр игнорируются, похожие символы O, I и L читаются как 0 и 1
+func Normalize(s string) (string, error) {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(s) {
+		switch r {
+		case '-', ' ':
+			continue
+		case 'O':
+			r = '0'
+		case 'I', 'L':
+			r = '1'
+		}
+		if !strings.ContainsRune(alphabet, r) {
+			return "", fmt.Errorf("%w: unexpected symbol %q", ErrInvalid, r)
+		}
+		b.WriteRune(r)
+	}
+
+	code := b.String()
+	if len(code) != Length {
+		return "", fmt.Errorf("%w: want %d symbols, got %d", ErrInvalid, Length, len(code))
+	}
+	if checkChar(code[:bodyLen]) != code[bodyLen] {
+		return "", fmt.Errorf("%w: checksum mismatch", ErrInvalid)
+	}
+	return code, nil
+}
+
+// Format разбивает каноничный номер на группы по четыре символа для печати
+func Format(code string) string {
+	var b strings.Builder
+	for i := 0; i < len(code); i += groupSize {
+		if i > 0 {
+			b.WriteByte('-')
+		}
+		b.WriteString(code[i:min(i+groupSize, len(code))])
+	}
+	return b.String()
+}
+
+// checkChar считает контрольный символ Luhn mod 32: он ловит любую одиночную опечатку
+// и перестановку соседних символов, кроме пары 0 и Z
+func checkChar(body string) byte {
+	n := len(alphabet)
+	factor, sum := 2, 0
+	for i := len(body) - 1; i >= 0; i-- {
+		addend := factor * strings.IndexByte(alphabet, body[i])
+		if factor == 2 {
+			factor = 1
+		} else {
+			factor = 2
+		}
+		sum += addend/n + addend%n
+	}
+	return alphabet[(n-sum%n)%n]
+}
@@ -0,0 +1,88 @@
+package serial
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNewIsValid(t *testing.T) {
+	seen := make(map[string]struct{})
+	for i := 0; i < 1000; i++ {
+		code, err := New()
+		if err != nil {
+			t.Fatalf("New: %v", err)
+		}
+		if len(code) != Length {
+			t.Fatalf("len(%q) = %d, want %d", code, len(code), Length)
+		}
+		got, err := Normalize(code)
+		if err != nil || got != code {
+			t.Fatalf("Normalize(%q) = %q, %v", code, got, err)
+		}
+		if _, dup := seen[code]; dup {
+			t.Fatalf("duplicate serial %q", code)
+		}
+		seen[code] = struct{}{}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	code, err := New()
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	printed := strings.ToLower(Format(code))
+	got, err := Normalize(" " + printed + " ")
+	if err != nil || got != code {
+		t.Fatalf("Normalize(%q) = %q, %v; want %q", printed, got, err, code)
+	}
+
+	confusable := strings.NewReplacer("0", "O", "1", "I").Replace(code)
+	if got, err := Normalize(confusable); err != nil || got != code {
+		t.Fatalf("Normalize(%q) = %q, %v; want %q", confusable, got, err, code)
+	}
+}
+
+func TestNormalizeRejectsTypos(t *testing.T) {
+	code, err := New()
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	for i := 0; i < Length; i++ {
+		for _, r := range alphabet {
+			if byte(r) == code[i] {
+				continue
+			}
+			typo := code[:i] + string(r) + code[i+1:]
+			if _, err := Normalize(typo); !errors.Is(err, ErrInvalid) {
+				t.Fatalf("single typo %q accepted", typo)
+			}
+		}
+	}
+
+	for i := 0; i+1 < Length; i++ {
+		pair := code[i : i+2]
+		if code[i] == code[i+1] || pair == "0Z" || pair == "Z0" {
+			continue
+		}
+		swapped := code[:i] + string(code[i+1]) + string(code[i]) + code[i+2:]
+		if _, err := Normalize(swapped); !errors.Is(err, ErrInvalid) {
+			t.Fatalf("transposition %q accepted", swapped)
+		}
+	}
+
+	for _, s := range []string{"", "ABC", code + "0", "U" + code[1:]} {
+		if _, err := Normalize(s); !errors.Is(err, ErrInvalid) {
+			t.Fatalf("Normalize(%q) accepted", s)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	if got := Format("0123456789ABCDEF"); got != "0123-4567-89AB-CDEF" {
+		t.Fatalf("Format = %q", got)
+	}
+}
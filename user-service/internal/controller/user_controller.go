@@ -5,6 +5,8 @@ import (
 	"github.com/MaxFando/lms/user-service/internal/service"
 
 	userservicev1 "github.com/MaxFando/lms/user-service/api/grpc/gen/go/user-service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type UserController struct {
@@ -50,9 +52,12 @@ func (c *UserController) Login(ctx context.Context, req *userservicev1.LoginRequ
 
 func (c *UserController) GetUser(ctx context.Context, req *userservicev1.GetUserRequest) (*userservicev1.GetUserResponse, error) {
 	user, err := c.svc.GetUser(ctx, req.Id)
-	if err != nil || user == nil {
+	if err != nil {
 		return nil, err
 	}
+	if user == nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
 	return &userservicev1.GetUserResponse{
 		User: &userservicev1.User{
 			Id:   user.ID,